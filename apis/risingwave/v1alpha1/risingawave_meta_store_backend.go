@@ -14,14 +14,22 @@
 
 package v1alpha1
 
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
 // RisingWaveMetaStoreBackendType is the type for the meta store backends.
 type RisingWaveMetaStoreBackendType string
 
 // All valid meta store backend types.
 const (
-	RisingWaveMetaStoreBackendTypeMemory  RisingWaveMetaStoreBackendType = "Memory"
-	RisingWaveMetaStoreBackendTypeEtcd    RisingWaveMetaStoreBackendType = "Etcd"
-	RisingWaveMetaStoreBackendTypeUnknown RisingWaveMetaStoreBackendType = "Unknown"
+	RisingWaveMetaStoreBackendTypeMemory     RisingWaveMetaStoreBackendType = "Memory"
+	RisingWaveMetaStoreBackendTypeEtcd       RisingWaveMetaStoreBackendType = "Etcd"
+	RisingWaveMetaStoreBackendTypePostgreSQL RisingWaveMetaStoreBackendType = "PostgreSQL"
+	RisingWaveMetaStoreBackendTypeMySQL      RisingWaveMetaStoreBackendType = "MySQL"
+	RisingWaveMetaStoreBackendTypeSQLite     RisingWaveMetaStoreBackendType = "SQLite"
+	RisingWaveMetaStoreBackendTypeUnknown    RisingWaveMetaStoreBackendType = "Unknown"
 )
 
 // RisingWaveMetaStoreStatus is the status of the meta store.
@@ -46,6 +54,57 @@ type RisingWaveEtcdCredentials struct {
 	PasswordKeyRef string `json:"passwordKeyRef,omitempty"`
 }
 
+// RisingWaveEtcdTLS is the TLS configurations for accessing the etcd. The referenced keys are mounted into the meta Pods.
+type RisingWaveEtcdTLS struct {
+	// CA is the reference to the CA bundle used to verify the certificate of the etcd server. Empty value
+	// indicates to use the system root CAs.
+	// +optional
+	CA *corev1.SecretKeySelector `json:"ca,omitempty"`
+
+	// ClientCert is the reference to the client certificate. It must be set together with the ClientKey
+	// to enable mutual TLS.
+	// +optional
+	ClientCert *corev1.SecretKeySelector `json:"clientCert,omitempty"`
+
+	// ClientKey is the reference to the private key of the client certificate. It must be set together with
+	// the ClientCert to enable mutual TLS.
+	// +optional
+	ClientKey *corev1.SecretKeySelector `json:"clientKey,omitempty"`
+
+	// ServerName overrides the server name used to verify the certificate of the etcd server. Defaults to
+	// the host of the endpoint.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+}
+
+// RisingWaveEtcdManaged is the collection of parameters for the etcd cluster managed by the operator.
+type RisingWaveEtcdManaged struct {
+	// Image of the etcd. Defaults to "quay.io/coreos/etcd:v3.5.10".
+	// +optional
+	// +kubebuilder:default="quay.io/coreos/etcd:v3.5.10"
+	Image string `json:"image,omitempty"`
+
+	// Replicas of the etcd members. An odd number is recommended to tolerate member failures. Defaults to 1.
+	// It's immutable once created because the members are bootstrapped with a static initial cluster.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Resources of the etcd containers.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// StorageSize is the size of the persistent volume claimed by each etcd member. Defaults to "10Gi".
+	// +optional
+	// +kubebuilder:default="10Gi"
+	StorageSize resource.Quantity `json:"storageSize,omitempty"`
+
+	// StorageClassName of the persistent volume claims. Empty value indicates to use the default storage class.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
 // RisingWaveMetaStoreBackendEtcd is the collection of parameters for the etcd backend meta store.
 type RisingWaveMetaStoreBackendEtcd struct {
 	// RisingWaveEtcdCredentials is the credentials provider from a Secret. It could be optional to mean that
@@ -53,8 +112,18 @@ type RisingWaveMetaStoreBackendEtcd struct {
 	// +optional
 	*RisingWaveEtcdCredentials `json:"credentials,omitempty"`
 
-	// Endpoint of etcd. It must be provided.
-	Endpoint string `json:"endpoint"`
+	// Endpoint of etcd. It must be provided unless the etcd is managed by the operator.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Managed indicates the operator to create and own an etcd cluster for the meta store, and to wire
+	// the endpoint in automatically. The endpoint, credentials and TLS must not be set in this mode.
+	// +optional
+	Managed *RisingWaveEtcdManaged `json:"managed,omitempty"`
+
+	// TLS enables the TLS connections to the etcd. Plaintext connections are used if it's not set.
+	// +optional
+	TLS *RisingWaveEtcdTLS `json:"tls,omitempty"`
 
 	// Secret contains the credentials of access the etcd, it must contain the following keys:
 	//   * username
@@ -65,6 +134,78 @@ type RisingWaveMetaStoreBackendEtcd struct {
 	Secret string `json:"secret,omitempty"`
 }
 
+// RisingWaveDBCredentials is the reference and keys selector to the database access credentials stored in a local secret.
+type RisingWaveDBCredentials struct {
+	// The name of the secret in the pod's namespace to select from.
+	SecretName string `json:"secretName"`
+
+	// UsernameKeyRef is the key of the secret to be the username. Must be a valid secret key.
+	// Defaults to "username".
+	// +kubebuilder:default=username
+	UsernameKeyRef string `json:"usernameKeyRef,omitempty"`
+
+	// PasswordKeyRef is the key of the secret to be the password. Must be a valid secret key.
+	// Defaults to "password".
+	// +kubebuilder:default=password
+	PasswordKeyRef string `json:"passwordKeyRef,omitempty"`
+}
+
+// RisingWaveMetaStoreBackendPostgreSQL is the collection of parameters for the PostgreSQL backend meta store.
+type RisingWaveMetaStoreBackendPostgreSQL struct {
+	// RisingWaveDBCredentials is the credentials provider from a Secret.
+	RisingWaveDBCredentials `json:"credentials"`
+
+	// Host of the PostgreSQL service.
+	Host string `json:"host"`
+
+	// Port of the PostgreSQL service. Defaults to 5432.
+	// +optional
+	// +kubebuilder:default=5432
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+
+	// Database of the meta store.
+	Database string `json:"database"`
+
+	// Options are the connection options appended to the connection URL as query parameters,
+	// e.g., sslmode=require.
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// RisingWaveMetaStoreBackendMySQL is the collection of parameters for the MySQL backend meta store.
+type RisingWaveMetaStoreBackendMySQL struct {
+	// RisingWaveDBCredentials is the credentials provider from a Secret.
+	RisingWaveDBCredentials `json:"credentials"`
+
+	// Host of the MySQL service.
+	Host string `json:"host"`
+
+	// Port of the MySQL service. Defaults to 3306.
+	// +optional
+	// +kubebuilder:default=3306
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+
+	// Database of the meta store.
+	Database string `json:"database"`
+
+	// Options are the connection options appended to the connection URL as query parameters,
+	// e.g., ssl-mode=REQUIRED.
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// RisingWaveMetaStoreBackendSQLite is the collection of parameters for the SQLite backend meta store.
+type RisingWaveMetaStoreBackendSQLite struct {
+	// Path of the SQLite database file in the meta container. The file is created if it doesn't exist.
+	// It is recommended to put it under a mounted persistent volume, or the metadata will be lost once
+	// the Pod is recreated.
+	Path string `json:"path"`
+}
+
 // RisingWaveMetaStoreBackend is the collection of parameters for the meta store that RisingWave uses. Note that one
 // and only one of the first-level fields could be set.
 type RisingWaveMetaStoreBackend struct {
@@ -78,4 +219,17 @@ type RisingWaveMetaStoreBackend struct {
 	// Endpoint of the etcd service for storing the metadata.
 	// +optional
 	Etcd *RisingWaveMetaStoreBackendEtcd `json:"etcd,omitempty"`
+
+	// PostgreSQL indicates to store the metadata in a PostgreSQL database.
+	// +optional
+	PostgreSQL *RisingWaveMetaStoreBackendPostgreSQL `json:"postgresql,omitempty"`
+
+	// MySQL indicates to store the metadata in a MySQL database.
+	// +optional
+	MySQL *RisingWaveMetaStoreBackendMySQL `json:"mysql,omitempty"`
+
+	// SQLite indicates to store the metadata in a SQLite database file. Like the memory backend, the
+	// metadata isn't shared among meta Pods, so there must be at most one meta replica.
+	// +optional
+	SQLite *RisingWaveMetaStoreBackendSQLite `json:"sqlite,omitempty"`
 }
@@ -0,0 +1,147 @@
+// Copyright 2023 RisingWave Labs
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RisingWaveBackupScheduleConcurrencyPolicy describes how to treat a scheduled backup when the previous one
+// is still running.
+type RisingWaveBackupScheduleConcurrencyPolicy string
+
+// All valid concurrency policies of RisingWaveBackupSchedule. There's no policy to replace the running backup
+// because a backup job can't be canceled in the meta.
+const (
+	// RisingWaveBackupScheduleConcurrencyPolicyAllow allows the backups to run concurrently.
+	RisingWaveBackupScheduleConcurrencyPolicyAllow RisingWaveBackupScheduleConcurrencyPolicy = "Allow"
+
+	// RisingWaveBackupScheduleConcurrencyPolicyForbid skips the scheduled backup if the previous one hasn't
+	// completed yet.
+	RisingWaveBackupScheduleConcurrencyPolicyForbid RisingWaveBackupScheduleConcurrencyPolicy = "Forbid"
+)
+
+// RisingWaveBackupScheduleRetention is the retention policy of the scheduled backups. The succeeded and failed
+// backups are retained separately. Expired meta snapshots are deleted from the meta together with the backups.
+// Backups are retained forever when neither of the fields is set.
+type RisingWaveBackupScheduleRetention struct {
+	// Number of the latest backups to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Count *int32 `json:"count,omitempty"`
+
+	// Maximum age of the backups to keep, e.g., 168h. The age is counted from the time when the backup was
+	// started.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// RisingWaveBackupScheduleSpec is the spec of RisingWaveBackupSchedule.
+type RisingWaveBackupScheduleSpec struct {
+	// Reference of the target RisingWave.
+	TargetRef RisingWaveBackupTargetRef `json:"targetRef"`
+
+	// Schedule in the standard Cron format, e.g., "0 */6 * * *". Time zones are interpreted as UTC unless
+	// specified with the CRON_TZ prefix.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// ConcurrencyPolicy specifies how to treat the concurrent backups. Defaults to Forbid.
+	// +optional
+	// +kubebuilder:default=Forbid
+	// +kubebuilder:validation:Enum=Allow;Forbid
+	ConcurrencyPolicy RisingWaveBackupScheduleConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Suspend stops scheduling new backups. Running backups aren't affected.
+	// +optional
+	// +kubebuilder:default=false
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// Retention policy of the scheduled backups.
+	// +optional
+	Retention RisingWaveBackupScheduleRetention `json:"retention,omitempty"`
+}
+
+// RisingWaveBackupScheduleRun is the record of a scheduled backup.
+type RisingWaveBackupScheduleRun struct {
+	// Name of the RisingWaveBackup object.
+	Name string `json:"name"`
+
+	// Phase of the backup.
+	// +optional
+	Phase RisingWaveBackupPhase `json:"phase,omitempty"`
+
+	// ID of the meta snapshot. It's only set when the backup succeeds.
+	// +optional
+	SnapshotID *int64 `json:"snapshotID,omitempty"`
+
+	// Time when the backup job was started in the meta.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Time when the backup job was completed, either succeeded or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// RisingWaveBackupScheduleStatus is the status of RisingWaveBackupSchedule.
+type RisingWaveBackupScheduleStatus struct {
+	// Last time when a backup was scheduled.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// Last time when a scheduled backup succeeded.
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+
+	// Runs of the retained backups, from the newest to the oldest.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Runs []RisingWaveBackupScheduleRun `json:"runs,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=rwbackupschedule,categories=all;streaming
+// +kubebuilder:printcolumn:name="TARGET",type=string,JSONPath=`.spec.targetRef.name`
+// +kubebuilder:printcolumn:name="SCHEDULE",type=string,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="SUSPEND",type=boolean,JSONPath=`.spec.suspend`
+// +kubebuilder:printcolumn:name="LAST SCHEDULE",type=date,JSONPath=`.status.lastScheduleTime`
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// RisingWaveBackupSchedule is the struct for RisingWaveBackupSchedule. It creates RisingWaveBackups of the
+// target RisingWave periodically and deletes the expired ones.
+type RisingWaveBackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RisingWaveBackupScheduleSpec   `json:"spec,omitempty"`
+	Status RisingWaveBackupScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RisingWaveBackupScheduleList contains a list of RisingWaveBackupSchedules.
+type RisingWaveBackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []RisingWaveBackupSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RisingWaveBackupSchedule{}, &RisingWaveBackupScheduleList{})
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 RisingWave Labs
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RisingWaveBackupTargetRef is the reference of the target RisingWave.
+type RisingWaveBackupTargetRef struct {
+	// Name of the RisingWave object. It must be in the same namespace as the backup.
+	Name string `json:"name"`
+}
+
+// RisingWaveBackupSpec is the spec of RisingWaveBackup.
+type RisingWaveBackupSpec struct {
+	// Reference of the target RisingWave.
+	TargetRef RisingWaveBackupTargetRef `json:"targetRef"`
+}
+
+// RisingWaveBackupPhase is the phase of RisingWaveBackup.
+type RisingWaveBackupPhase string
+
+// All valid phases of RisingWaveBackup.
+const (
+	RisingWaveBackupPhasePending   RisingWaveBackupPhase = "Pending"
+	RisingWaveBackupPhaseRunning   RisingWaveBackupPhase = "Running"
+	RisingWaveBackupPhaseSucceeded RisingWaveBackupPhase = "Succeeded"
+	RisingWaveBackupPhaseFailed    RisingWaveBackupPhase = "Failed"
+)
+
+// RisingWaveBackupStatus is the status of RisingWaveBackup.
+type RisingWaveBackupStatus struct {
+	// Phase of the backup.
+	// +optional
+	Phase RisingWaveBackupPhase `json:"phase,omitempty"`
+
+	// ID of the backup job in the meta.
+	// +optional
+	JobID *int64 `json:"jobID,omitempty"`
+
+	// ID of the meta snapshot. It's only set when the backup succeeds.
+	// +optional
+	SnapshotID *int64 `json:"snapshotID,omitempty"`
+
+	// Location of the meta snapshot in the state store, in the form of <backup storage url>/<backup storage directory>.
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// Human-readable message indicating details about the last transition, e.g., the reason of a failure.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Time when the backup job was started in the meta.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Time when the backup job was completed, either succeeded or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=rwbackup,categories=all;streaming
+// +kubebuilder:printcolumn:name="TARGET",type=string,JSONPath=`.spec.targetRef.name`
+// +kubebuilder:printcolumn:name="PHASE",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="SNAPSHOT",type=integer,JSONPath=`.status.snapshotID`
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// RisingWaveBackup is the struct for RisingWaveBackup. It takes a meta snapshot of the target RisingWave.
+type RisingWaveBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RisingWaveBackupSpec   `json:"spec,omitempty"`
+	Status RisingWaveBackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RisingWaveBackupList contains a list of RisingWaveBackups.
+type RisingWaveBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []RisingWaveBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RisingWaveBackup{}, &RisingWaveBackupList{})
+}
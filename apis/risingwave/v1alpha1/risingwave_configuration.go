@@ -48,6 +48,136 @@ type RisingWaveNodeConfigurationSecretSource struct {
 	Optional *bool `json:"optional,omitempty"`
 }
 
+// RisingWaveServerSettings are the settings in the `server` section of the `risingwave.toml`.
+type RisingWaveServerSettings struct {
+	// HeartbeatIntervalMs is the interval of the heartbeats between the nodes and the meta, in milliseconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	HeartbeatIntervalMs *int32 `json:"heartbeatIntervalMs,omitempty" toml:"heartbeat_interval_ms,omitempty"`
+
+	// ConnectionPoolSize is the size of the connection pool between the nodes.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ConnectionPoolSize *int32 `json:"connectionPoolSize,omitempty" toml:"connection_pool_size,omitempty"`
+
+	// MetricsLevel is the level of the exposed metrics.
+	// +optional
+	// +kubebuilder:validation:Enum=Disabled;Critical;Info;Debug
+	MetricsLevel *string `json:"metricsLevel,omitempty" toml:"metrics_level,omitempty"`
+
+	// TelemetryEnabled determines whether the telemetry is enabled.
+	// +optional
+	TelemetryEnabled *bool `json:"telemetryEnabled,omitempty" toml:"telemetry_enabled,omitempty"`
+}
+
+// RisingWaveStreamingSettings are the settings in the `streaming` section of the `risingwave.toml`.
+type RisingWaveStreamingSettings struct {
+	// InFlightBarrierNums is the maximum number of the in-flight barriers.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	InFlightBarrierNums *int32 `json:"inFlightBarrierNums,omitempty" toml:"in_flight_barrier_nums,omitempty"`
+
+	// UniqueUserStreamErrors is the maximum number of the unique user stream errors reported.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	UniqueUserStreamErrors *int32 `json:"uniqueUserStreamErrors,omitempty" toml:"unique_user_stream_errors,omitempty"`
+}
+
+// RisingWaveFileCacheSettings are the settings of a file cache in the `storage` section of the `risingwave.toml`.
+type RisingWaveFileCacheSettings struct {
+	// Dir is the directory of the file cache. The file cache is disabled when it's empty.
+	// +optional
+	Dir *string `json:"dir,omitempty" toml:"dir,omitempty"`
+
+	// CapacityMB is the total capacity of the file cache, in MiB.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	CapacityMB *int32 `json:"capacityMB,omitempty" toml:"capacity_mb,omitempty"`
+
+	// FileCapacityMB is the capacity of a single cache file, in MiB.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	FileCapacityMB *int32 `json:"fileCapacityMB,omitempty" toml:"file_capacity_mb,omitempty"`
+
+	// Flushers is the number of the flushers.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Flushers *int32 `json:"flushers,omitempty" toml:"flushers,omitempty"`
+
+	// Reclaimers is the number of the reclaimers.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Reclaimers *int32 `json:"reclaimers,omitempty" toml:"reclaimers,omitempty"`
+
+	// RecoverConcurrency is the concurrency of recovering the cache files on start.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RecoverConcurrency *int32 `json:"recoverConcurrency,omitempty" toml:"recover_concurrency,omitempty"`
+
+	// InsertRateLimitMB limits the rate of the insertion, in MiB/s. 0 means no limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	InsertRateLimitMB *int32 `json:"insertRateLimitMB,omitempty" toml:"insert_rate_limit_mb,omitempty"`
+}
+
+// RisingWaveStorageSettings are the settings in the `storage` section of the `risingwave.toml`.
+type RisingWaveStorageSettings struct {
+	// ShareBuffersSyncParallelism is the parallelism of syncing the shared buffers.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ShareBuffersSyncParallelism *int32 `json:"shareBuffersSyncParallelism,omitempty" toml:"share_buffers_sync_parallelism,omitempty"`
+
+	// SharedBufferCapacityMB is the capacity of the shared buffer, in MiB.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	SharedBufferCapacityMB *int32 `json:"sharedBufferCapacityMB,omitempty" toml:"shared_buffer_capacity_mb,omitempty"`
+
+	// BlockCacheCapacityMB is the capacity of the block cache, in MiB.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BlockCacheCapacityMB *int32 `json:"blockCacheCapacityMB,omitempty" toml:"block_cache_capacity_mb,omitempty"`
+
+	// MetaCacheCapacityMB is the capacity of the meta cache, in MiB.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MetaCacheCapacityMB *int32 `json:"metaCacheCapacityMB,omitempty" toml:"meta_cache_capacity_mb,omitempty"`
+
+	// CompactorMemoryLimitMB is the memory limit of the compactor, in MiB.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	CompactorMemoryLimitMB *int32 `json:"compactorMemoryLimitMB,omitempty" toml:"compactor_memory_limit_mb,omitempty"`
+
+	// MaxConcurrentCompactionTaskNumber is the maximum number of the concurrent compaction tasks.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrentCompactionTaskNumber *int32 `json:"maxConcurrentCompactionTaskNumber,omitempty" toml:"max_concurrent_compaction_task_number,omitempty"`
+
+	// DataFileCache is the file cache for the data blocks.
+	// +optional
+	DataFileCache *RisingWaveFileCacheSettings `json:"dataFileCache,omitempty" toml:"data_file_cache,omitempty"`
+
+	// MetaFileCache is the file cache for the meta blocks.
+	// +optional
+	MetaFileCache *RisingWaveFileCacheSettings `json:"metaFileCache,omitempty" toml:"meta_file_cache,omitempty"`
+}
+
+// RisingWaveConfigurationSettings are the typed settings of the commonly used knobs in the `risingwave.toml`. The
+// settings will be rendered into TOML and deep merged with the configuration from the ConfigMap, which means the
+// values here take precedence over the ones in the ConfigMap.
+type RisingWaveConfigurationSettings struct {
+	// Server settings.
+	// +optional
+	Server *RisingWaveServerSettings `json:"server,omitempty" toml:"server,omitempty"`
+
+	// Streaming settings.
+	// +optional
+	Streaming *RisingWaveStreamingSettings `json:"streaming,omitempty" toml:"streaming,omitempty"`
+
+	// Storage settings, including the file caches.
+	// +optional
+	Storage *RisingWaveStorageSettings `json:"storage,omitempty" toml:"storage,omitempty"`
+}
+
 // RisingWaveNodeConfiguration determines where the configurations are from, either ConfigMap, Secret, or raw string.
 type RisingWaveNodeConfiguration struct {
 	// ConfigMap where the `risingwave.toml` locates.
@@ -55,4 +185,10 @@ type RisingWaveNodeConfiguration struct {
 
 	// Secret where the `risingwave.toml` locates.
 	Secret *RisingWaveNodeConfigurationSecretSource `json:"secret,omitempty"`
+
+	// Settings are the typed settings to be rendered into the `risingwave.toml`. When used in a node group, the
+	// settings override the global ones, and the configuration source is inherited from the global one unless
+	// the ConfigMap or Secret is specified. Settings can't be used together with a Secret.
+	// +optional
+	Settings *RisingWaveConfigurationSettings `json:"settings,omitempty"`
 }
@@ -290,6 +290,26 @@ type RisingWaveNodePodTemplateSpec struct {
 	// Additional containers to run in the same Pod. The containers will be appended to the Pod's containers array in order.
 	// + optional
 	AdditionalContainers []corev1.Container `json:"additionalContainers,omitempty"`
+
+	// Periodic probe of the RisingWave container's liveness. It overrides the default one of the component. By
+	// default, meta, compute and compactor of v2.0.0 or later are probed with the gRPC health check on the service
+	// port, and the others are probed over TCP on the service port.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+	// +optional
+	LivenessProbe *corev1.Probe `json:"livenessProbe,omitempty"`
+
+	// Periodic probe of the RisingWave container's service readiness. It overrides the default one of the component.
+	// By default, it's the same as the liveness probe, except that frontend of v2.0.0 or later is probed with the
+	// HTTP health check on the health port.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+	// +optional
+	ReadinessProbe *corev1.Probe `json:"readinessProbe,omitempty"`
+
+	// StartupProbe indicates that the RisingWave container has successfully initialized. It overrides the default
+	// one of the component, which has the same handler as the default liveness probe.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+	// +optional
+	StartupProbe *corev1.Probe `json:"startupProbe,omitempty"`
 }
 
 // RisingWaveNodePodTemplate determines the Pod specs of a RisingWave node.
@@ -357,6 +377,23 @@ type RisingWaveNodeGroupUpgradeStrategy struct {
 	InPlaceUpdateStrategy *kruisepubs.InPlaceUpdateStrategy `json:"inPlaceUpdateStrategy,omitempty"`
 }
 
+// RisingWaveNodeGroupPodDisruptionBudget is the spec of the PodDisruptionBudget of the Pods in the node group. At most
+// one of the minAvailable and maxUnavailable can be set. If neither is set, the default of the component is used:
+// meta keeps a majority of the replicas available to preserve the quorum, and the other components allow one Pod to
+// be unavailable at a time. The default isn't applied, i.e., no PodDisruptionBudget is created, when the node group
+// has at most one replica or the RisingWave is stopped, so that the node drains aren't blocked.
+type RisingWaveNodeGroupPodDisruptionBudget struct {
+	// The minimum number of Pods that must be available after an eviction.
+	// Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// The maximum number of Pods that can be unavailable after an eviction.
+	// Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
 // RisingWaveNodeGroup is the definition of a group of RisingWave nodes of the same component.
 type RisingWaveNodeGroup struct {
 	// Name of the node group.
@@ -413,8 +450,16 @@ type RisingWaveNodeGroup struct {
 	// process failed deployments and a condition with a ProgressDeadlineExceeded
 	// reason will be surfaced in the deployment status. Note that progress will
 	// not be estimated during the time a deployment is paused. Defaults to 600s.
+	// It's also the deadline of the operator to roll out the node group of any kind, unless it's overridden by
+	// the progressDeadlineSeconds of the upgrade policy.
 	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty" protobuf:"varint,9,opt,name=progressDeadlineSeconds"`
 
+	// PodDisruptionBudget tells the operator to create a PodDisruptionBudget for the Pods in the node group, so that
+	// the voluntary disruptions, e.g., node drains, don't take down too many Pods at once. When it's unset, the default
+	// PodDisruptionBudget is still created for the meta and frontend node groups, but not for the other components.
+	// +optional
+	PodDisruptionBudget *RisingWaveNodeGroupPodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+
 	// Template tells how the Pod should be started. It is an optional field. If it's empty, then the pod template in
 	// the first-level fields under spec will be used.
 	// +optional
@@ -14,21 +14,27 @@
 
 package v1alpha1
 
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
 // RisingWaveStateStoreBackendType is the type for the state store backends.
 type RisingWaveStateStoreBackendType string
 
 // All valid state store backend types.
 const (
-	RisingWaveStateStoreBackendTypeMemory    RisingWaveStateStoreBackendType = "Memory"
-	RisingWaveStateStoreBackendTypeMinIO     RisingWaveStateStoreBackendType = "MinIO"
-	RisingWaveStateStoreBackendTypeS3        RisingWaveStateStoreBackendType = "S3"
-	RisingWaveStateStoreBackendTypeHDFS      RisingWaveStateStoreBackendType = "HDFS"
-	RisingWaveStateStoreBackendTypeWebHDFS   RisingWaveStateStoreBackendType = "WebHDFS"
-	RisingWaveStateStoreBackendTypeGCS       RisingWaveStateStoreBackendType = "GCS"
-	RisingWaveStateStoreBackendTypeAliyunOSS RisingWaveStateStoreBackendType = "AliyunOSS"
-	RisingWaveStateStoreBackendTypeAzureBlob RisingWaveStateStoreBackendType = "AzureBlob"
-	RisingWaveStateStoreBackendTypeLocalDisk RisingWaveStateStoreBackendType = "LocalDisk"
-	RisingWaveStateStoreBackendTypeUnknown   RisingWaveStateStoreBackendType = "Unknown"
+	RisingWaveStateStoreBackendTypeMemory       RisingWaveStateStoreBackendType = "Memory"
+	RisingWaveStateStoreBackendTypeMinIO        RisingWaveStateStoreBackendType = "MinIO"
+	RisingWaveStateStoreBackendTypeS3           RisingWaveStateStoreBackendType = "S3"
+	RisingWaveStateStoreBackendTypeS3Compatible RisingWaveStateStoreBackendType = "S3Compatible"
+	RisingWaveStateStoreBackendTypeHDFS         RisingWaveStateStoreBackendType = "HDFS"
+	RisingWaveStateStoreBackendTypeWebHDFS      RisingWaveStateStoreBackendType = "WebHDFS"
+	RisingWaveStateStoreBackendTypeGCS          RisingWaveStateStoreBackendType = "GCS"
+	RisingWaveStateStoreBackendTypeAliyunOSS    RisingWaveStateStoreBackendType = "AliyunOSS"
+	RisingWaveStateStoreBackendTypeAzureBlob    RisingWaveStateStoreBackendType = "AzureBlob"
+	RisingWaveStateStoreBackendTypeLocalDisk    RisingWaveStateStoreBackendType = "LocalDisk"
+	RisingWaveStateStoreBackendTypeUnknown      RisingWaveStateStoreBackendType = "Unknown"
 )
 
 // RisingWaveStateStoreStatus is the status of the state store.
@@ -40,7 +46,7 @@ type RisingWaveStateStoreStatus struct {
 // RisingWaveMinIOCredentials is the reference and keys selector to the MinIO access credentials stored in a local secret.
 type RisingWaveMinIOCredentials struct {
 	// The name of the secret in the pod's namespace to select from.
-	SecretName string `json:"secretName"`
+	SecretName string `json:"secretName,omitempty"`
 
 	// UsernameKeyRef is the key of the secret to be the username. Must be a valid secret key.
 	// Defaults to "username".
@@ -53,14 +59,56 @@ type RisingWaveMinIOCredentials struct {
 	PasswordKeyRef string `json:"passwordKeyRef,omitempty"`
 }
 
+// RisingWaveMinIOManaged is the collection of parameters for the MinIO managed by the operator.
+type RisingWaveMinIOManaged struct {
+	// Image of the MinIO server. Defaults to "quay.io/minio/minio:RELEASE.2023-09-30T07-02-29Z".
+	// +optional
+	// +kubebuilder:default="quay.io/minio/minio:RELEASE.2023-09-30T07-02-29Z"
+	Image string `json:"image,omitempty"`
+
+	// ClientImage is the image of the MinIO client used to create the bucket.
+	// Defaults to "quay.io/minio/mc:RELEASE.2023-09-29T16-41-22Z".
+	// +optional
+	// +kubebuilder:default="quay.io/minio/mc:RELEASE.2023-09-29T16-41-22Z"
+	ClientImage string `json:"clientImage,omitempty"`
+
+	// Replicas of the MinIO servers. A single-node MinIO is deployed when it's 1, otherwise a distributed
+	// MinIO is deployed and it must be no less than 4. Defaults to 1.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Resources of the MinIO containers.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// StorageSize is the size of the persistent volume claimed by each MinIO server. Defaults to "10Gi".
+	// +optional
+	// +kubebuilder:default="10Gi"
+	StorageSize resource.Quantity `json:"storageSize,omitempty"`
+
+	// StorageClassName of the persistent volume claims. Empty value indicates to use the default storage class.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
 // RisingWaveStateStoreBackendMinIO is the collection of parameters for the MinIO backend state store.
 type RisingWaveStateStoreBackendMinIO struct {
-	// RisingWaveMinIOCredentials is the credentials provider from a Secret.
-	RisingWaveMinIOCredentials `json:"credentials"`
+	// RisingWaveMinIOCredentials is the credentials provider from a Secret. It must be provided unless
+	// the MinIO is managed by the operator.
+	// +optional
+	RisingWaveMinIOCredentials `json:"credentials,omitempty"`
 
-	// Endpoint of the MinIO service.
-	// +kubebuilder:validation:Required
-	Endpoint string `json:"endpoint"`
+	// Endpoint of the MinIO service. It must be provided unless the MinIO is managed by the operator.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Managed indicates the operator to deploy and own a MinIO for the state store. The operator generates
+	// the credentials, creates the bucket, and wires the endpoint in automatically. The endpoint and
+	// credentials must not be set in this mode.
+	// +optional
+	Managed *RisingWaveMinIOManaged `json:"managed,omitempty"`
 
 	// Bucket of the MinIO service.
 	// +kubebuilder:validation:Required
@@ -111,6 +159,73 @@ type RisingWaveStateStoreBackendS3 struct {
 	Endpoint string `json:"endpoint,omitempty"`
 }
 
+// RisingWaveS3CompatibleProvider is the provider preset of the S3-compatible service.
+// +kubebuilder:validation:Enum=HuaweiOBS;TencentCOS;CephRGW;CloudflareR2
+type RisingWaveS3CompatibleProvider string
+
+// All valid provider presets of the S3-compatible services.
+const (
+	RisingWaveS3CompatibleProviderHuaweiOBS    RisingWaveS3CompatibleProvider = "HuaweiOBS"
+	RisingWaveS3CompatibleProviderTencentCOS   RisingWaveS3CompatibleProvider = "TencentCOS"
+	RisingWaveS3CompatibleProviderCephRGW      RisingWaveS3CompatibleProvider = "CephRGW"
+	RisingWaveS3CompatibleProviderCloudflareR2 RisingWaveS3CompatibleProvider = "CloudflareR2"
+)
+
+// RisingWaveS3AddressingStyle is the style to address the buckets of the S3-compatible service.
+// +kubebuilder:validation:Enum=VirtualHosted;Path
+type RisingWaveS3AddressingStyle string
+
+// All valid addressing styles.
+const (
+	// RisingWaveS3AddressingStyleVirtualHosted addresses the bucket with the host, e.g., https://bucket.s3.example.com/key.
+	RisingWaveS3AddressingStyleVirtualHosted RisingWaveS3AddressingStyle = "VirtualHosted"
+
+	// RisingWaveS3AddressingStylePath addresses the bucket with the path, e.g., https://s3.example.com/bucket/key.
+	RisingWaveS3AddressingStylePath RisingWaveS3AddressingStyle = "Path"
+)
+
+// RisingWaveStateStoreBackendS3Compatible is the collection of parameters for the S3-compatible backend state store.
+type RisingWaveStateStoreBackendS3Compatible struct {
+	// RisingWaveS3Credentials is the credentials provider from a Secret. The service account isn't supported.
+	RisingWaveS3Credentials `json:"credentials"`
+
+	// Provider is the preset of a well-known S3-compatible service. The endpoint, region and addressing style
+	// default to the values of the provider when they're not set:
+	//   * HuaweiOBS, endpoint obs.${REGION}.myhuaweicloud.com, virtual-hosted style.
+	//   * TencentCOS, endpoint cos.${REGION}.myqcloud.com, virtual-hosted style.
+	//   * CephRGW, region us-east-1, path style. The endpoint must be set.
+	//   * CloudflareR2, region auto, path style. The endpoint must be set, e.g., <ACCOUNT_ID>.r2.cloudflarestorage.com.
+	// +optional
+	Provider RisingWaveS3CompatibleProvider `json:"provider,omitempty"`
+
+	// Bucket of the S3-compatible service.
+	// +kubebuilder:validation:Required
+	Bucket string `json:"bucket"`
+
+	// Region of the S3-compatible service. It's read from the key "Region" of the credentials secret when
+	// it's empty and not set by the provider.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Endpoint of the S3-compatible service without the bucket. It must be set unless it's set by the provider.
+	// The scheme defaults to https when not specified. You can reference the `REGION` variable in the endpoint
+	// with `${REGION}`, e.g.,
+	//   obs.${REGION}.myhuaweicloud.com
+	// +optional
+	// +kubebuilder:validation:Pattern="^(?:https?://)?(?:[^/.\\s]+\\.)*(?:[^/\\s]+)*$"
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// AddressingStyle is the style to address the buckets, either VirtualHosted or Path. Defaults to the style
+	// of the provider, or Path when there's no provider.
+	// +optional
+	AddressingStyle RisingWaveS3AddressingStyle `json:"addressingStyle,omitempty"`
+
+	// CA is the reference to the PEM encoded CA bundle to verify the certificates of the service. It replaces
+	// the system trust store of the RisingWave containers, so it must contain all the CAs required.
+	// +optional
+	CA *corev1.SecretKeySelector `json:"ca,omitempty"`
+}
+
 // RisingWaveGCSCredentials is the reference and keys selector to the GCS access credentials stored in a local secret.
 type RisingWaveGCSCredentials struct {
 	// UseWorkloadIdentity indicates to use workload identity to access the GCS service.
@@ -261,6 +376,10 @@ type RisingWaveStateStoreBackend struct {
 	// +optional
 	S3 *RisingWaveStateStoreBackendS3 `json:"s3,omitempty"`
 
+	// S3Compatible storage spec.
+	// +optional
+	S3Compatible *RisingWaveStateStoreBackendS3Compatible `json:"s3Compatible,omitempty"`
+
 	// GCS storage spec.
 	// +optional
 	GCS *RisingWaveStateStoreBackendGCS `json:"gcs,omitempty"`
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -73,6 +74,142 @@ type RisingWaveComponentsSpec struct {
 	Connector RisingWaveComponent `json:"connector,omitempty"`
 }
 
+// RisingWaveRestoreFrom is the source to bootstrap a RisingWave from.
+type RisingWaveRestoreFrom struct {
+	// ID of the meta snapshot to restore from.
+	// +kubebuilder:validation:Minimum=1
+	SnapshotID int64 `json:"snapshotID"`
+
+	// StateStore where the meta snapshot is stored, i.e., the backup storage of the source RisingWave. The data
+	// referenced by the snapshot must be accessible in the state store of the RisingWave being restored. When the
+	// source and the state store of the RisingWave are of the same type, they must share the same credentials.
+	StateStore RisingWaveStateStoreBackend `json:"stateStore"`
+
+	// Directory of the meta snapshots in the source state store. Defaults to backup.
+	// +optional
+	// +kubebuilder:default=backup
+	// +kubebuilder:validation:Pattern="^[0-9a-zA-Z_/-]{1,}$"
+	BackupDirectory string `json:"backupDirectory,omitempty"`
+}
+
+// RisingWaveCanaryUpgradePolicy is the policy of upgrading the version with a canary node group per component.
+// When the image of any node group of a component is changed, either through the global image or the image of the
+// node group, the canary node group of the component is upgraded first, and the other node groups of the component
+// wait before the canary has been ready for the soak time. Components without a canary node group are upgraded as
+// usual.
+type RisingWaveCanaryUpgradePolicy struct {
+	// Name of the canary node group of meta.
+	// +optional
+	Meta *string `json:"meta,omitempty"`
+
+	// Name of the canary node group of frontend.
+	// +optional
+	Frontend *string `json:"frontend,omitempty"`
+
+	// Name of the canary node group of compute.
+	// +optional
+	Compute *string `json:"compute,omitempty"`
+
+	// Name of the canary node group of compactor.
+	// +optional
+	Compactor *string `json:"compactor,omitempty"`
+
+	// Name of the canary node group of connector.
+	// +optional
+	Connector *string `json:"connector,omitempty"`
+
+	// SoakSeconds is the time in seconds that the canary node group must stay ready before the other node groups
+	// are upgraded. Defaults to 300.
+	// +optional
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=0
+	SoakSeconds *int32 `json:"soakSeconds,omitempty"`
+}
+
+// RisingWaveUpgradePolicy is the policy of rolling out the changes of the spec.
+type RisingWaveUpgradePolicy struct {
+	// ProgressDeadlineSeconds overrides the progress deadlines of all node groups when set. The workloads of a node
+	// group must be rolled out before its progress deadline, counted from the time when the rollout of the current
+	// generation started. Otherwise, the condition Failed is set, the condition Upgrading is cleared, and the spec
+	// won't be synced again until it's changed.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+
+	// AutoRollback reverts the pod templates of the workloads to the last known-good revision once when the upgrade
+	// fails. The revision is recorded in the ConfigMap <name>-revision every time a rollout completes. The spec
+	// is kept as is. Defaults to false.
+	// +optional
+	// +kubebuilder:default=false
+	AutoRollback *bool `json:"autoRollback,omitempty"`
+
+	// Canary upgrades the images with a canary node group per component, either by changing the global image or
+	// the images of the node groups. Note that the soak time doesn't count in the progress deadline.
+	// +optional
+	Canary *RisingWaveCanaryUpgradePolicy `json:"canary,omitempty"`
+}
+
+// RisingWaveMetaDashboardIngress is the specification of the Ingress that exposes the meta dashboard through the
+// meta leader Service.
+type RisingWaveMetaDashboardIngress struct {
+	// IngressClassName of the Ingress. The default class of the cluster is used when it's empty.
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+
+	// Host of the Ingress rule. The rule applies to all hosts when it's empty.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// Path of the Ingress rule. Defaults to "/".
+	// +optional
+	// +kubebuilder:default=/
+	Path string `json:"path,omitempty"`
+
+	// TLS configuration of the Ingress.
+	// +optional
+	TLS []networkingv1.IngressTLS `json:"tls,omitempty"`
+
+	// AdditionalMetadata tells the operator to add the specified metadata onto the Ingress, e.g., the annotations
+	// required by the Ingress controller.
+	// +optional
+	AdditionalMetadata PartialObjectMeta `json:"additionalMetadata,omitempty"`
+}
+
+// RisingWaveMetaLeaderService is the specification of the Service that selects only the meta leader, which is named
+// <name>-meta-leader and serves the RPC and dashboard ports of meta.
+type RisingWaveMetaLeaderService struct {
+	// DashboardIngress exposes the dashboard port of the meta leader Service with an Ingress if set.
+	// +optional
+	DashboardIngress *RisingWaveMetaDashboardIngress `json:"dashboardIngress,omitempty"`
+}
+
+// RisingWaveMetaTLS is the TLS configuration of the connections from the operator to the RPC port of meta, e.g.,
+// when a service mesh enforces mutual TLS in front of meta. RisingWave itself doesn't serve TLS on the RPC port, so
+// the Pods are left unchanged, and the components still connect to meta in plaintext. The operator-level TLS
+// options are used for the fields left unset.
+type RisingWaveMetaTLS struct {
+	// SecretName is the name of the Secret that contains the CA bundle (ca.crt) to verify the certificate of meta.
+	// The operator-level CA bundle is used when it's empty.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// ClientCertSecretName is the name of the Secret that contains the client certificate (tls.crt) and the private
+	// key (tls.key) the operator presents to meta. The operator-level client certificate is used when it's empty,
+	// and no client certificate is presented if neither is set.
+	// +optional
+	ClientCertSecretName string `json:"clientCertSecretName,omitempty"`
+
+	// ServerName overrides the server name used to verify the certificate of meta. Defaults to the domain of the
+	// meta Service, i.e., <name>-meta.<namespace>.svc.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// VerifyServerName tells the operator whether to verify the server name in the certificate of meta. The
+	// certificate chain is always verified with the CA bundle. Defaults to the operator-level option.
+	// +optional
+	VerifyServerName *bool `json:"verifyServerName,omitempty"`
+}
+
 // RisingWaveSpec is the overall spec.
 type RisingWaveSpec struct {
 	// The spec of ports and some controllers (such as `restartAt`) of each component,
@@ -125,6 +262,43 @@ type RisingWaveSpec struct {
 	// But keep in mind that memory backend is not recommended in production.
 	// +kubebuilder:default={memory: true}
 	StateStore RisingWaveStateStoreBackend `json:"stateStore,omitempty"`
+
+	// Flag to indicate if the RisingWave should be stopped (hibernated). When it's set to true, the workloads of all
+	// components are scaled to zero while the replicas declared in the node groups are kept, and they will be scaled
+	// back when it's turned off. The managed etcd and MinIO are not affected.
+	// +optional
+	// +kubebuilder:default=false
+	Stopped *bool `json:"stopped,omitempty"`
+
+	// RestoreFrom bootstraps the RisingWave from an existing meta snapshot. When it's set, the meta store is restored
+	// with a Job before the meta nodes are started for the first time, and the progress is reported in the condition
+	// Restored. The meta store must be either etcd, PostgreSQL or MySQL. It's immutable after the RisingWave is created.
+	// +optional
+	RestoreFrom *RisingWaveRestoreFrom `json:"restoreFrom,omitempty"`
+
+	// Policy of upgrading the components.
+	// +optional
+	UpgradePolicy RisingWaveUpgradePolicy `json:"upgradePolicy,omitempty"`
+
+	// Flag to indicate if the compute nodes should be scaled in gracefully. If enabled, the compute workers about to
+	// be removed are marked as unschedulable in meta and their actors are migrated to the other workers before the
+	// Pods are deleted, and the workers are unregistered from meta after the Pods are gone. Otherwise, the Pods are
+	// deleted right away and meta has to recover the streaming jobs. The workers of the node groups scaled to zero are
+	// drained as well, unless there's no other schedulable compute worker to migrate the actors to.
+	// +optional
+	// +kubebuilder:default=false
+	EnableGracefulComputeScaleIn *bool `json:"enableGracefulComputeScaleIn,omitempty"`
+
+	// MetaLeaderService tells the operator to create a ClusterIP Service that selects only the meta leader, so that
+	// the dashboard and admin RPCs always reach the leader. The meta Service selects all meta Pods, including the
+	// followers. The leader Service isn't created when it's unset, and it's deleted when it's unset afterwards.
+	// +optional
+	MetaLeaderService *RisingWaveMetaLeaderService `json:"metaLeaderService,omitempty"`
+
+	// MetaTLS enables TLS on the connections from the operator to meta. The operator connects to meta in plaintext
+	// when it's unset, even if the operator-level TLS options are set. It doesn't configure the Pods.
+	// +optional
+	MetaTLS *RisingWaveMetaTLS `json:"metaTLS,omitempty"`
 }
 
 // ComponentGroupReplicasStatus are the running status of Pods in group.
@@ -172,6 +346,168 @@ type RisingWaveComponentsReplicasStatus struct {
 	Connector ComponentReplicasStatus `json:"connector"`
 }
 
+// RisingWaveUpgradePhase is the phase of a version upgrade. Components are upgraded one phase after another, and
+// each phase waits before the components of the previous phases are ready.
+type RisingWaveUpgradePhase string
+
+// All phases of a version upgrade, in the order of execution.
+const (
+	RisingWaveUpgradePhaseMeta                  RisingWaveUpgradePhase = "Meta"
+	RisingWaveUpgradePhaseCompute               RisingWaveUpgradePhase = "Compute"
+	RisingWaveUpgradePhaseCompactorAndConnector RisingWaveUpgradePhase = "CompactorAndConnector"
+	RisingWaveUpgradePhaseFrontend              RisingWaveUpgradePhase = "Frontend"
+)
+
+// RisingWaveUpgradeStatus is the status of an ongoing version upgrade.
+type RisingWaveUpgradeStatus struct {
+	// Version that the components are being upgraded to.
+	TargetVersion string `json:"targetVersion"`
+
+	// Current phase of the upgrade.
+	Phase RisingWaveUpgradePhase `json:"phase"`
+
+	// Components that are waiting for the current phase to complete.
+	// +optional
+	PendingComponents []string `json:"pendingComponents,omitempty"`
+}
+
+// RisingWaveCanaryStatus is the status of the canary node group of the component being upgraded.
+type RisingWaveCanaryStatus struct {
+	// Component of the canary node group.
+	Component string `json:"component"`
+
+	// Name of the canary node group.
+	Group string `json:"group"`
+
+	// Time when the canary node group became ready with the target version. It's not set when the canary node
+	// group isn't ready yet.
+	// +optional
+	ReadyTime *metav1.Time `json:"readyTime,omitempty"`
+}
+
+// RisingWaveRolloutStatus is the status of the rollout of a generation.
+type RisingWaveRolloutStatus struct {
+	// Generation being rolled out.
+	Generation int64 `json:"generation"`
+
+	// Time when the rollout started.
+	StartTime metav1.Time `json:"startTime"`
+
+	// Failed tells whether the rollout has exceeded the progress deadline.
+	// +optional
+	Failed bool `json:"failed,omitempty"`
+
+	// RolledBack tells whether the workloads have been reverted to the last known-good revision. The workloads are
+	// reverted at most once per failed generation.
+	// +optional
+	RolledBack bool `json:"rolledBack,omitempty"`
+
+	// Components of which the canary node groups have soaked in the rollout.
+	// +optional
+	SoakedCanaryComponents []string `json:"soakedCanaryComponents,omitempty"`
+}
+
+// RisingWaveComputeScaleInPhase is the phase of a graceful scale-in of a compute node group.
+type RisingWaveComputeScaleInPhase string
+
+// All phases of a graceful scale-in, in the order of execution.
+const (
+	// RisingWaveComputeScaleInPhaseDraining means the workers are unschedulable and their actors are being migrated.
+	// The workload of the node group is held until the actors are migrated.
+	RisingWaveComputeScaleInPhaseDraining RisingWaveComputeScaleInPhase = "Draining"
+
+	// RisingWaveComputeScaleInPhaseDrained means the actors are migrated and the workload is being scaled in. The
+	// workers are unregistered from meta after the Pods are gone.
+	RisingWaveComputeScaleInPhaseDrained RisingWaveComputeScaleInPhase = "Drained"
+)
+
+// RisingWaveComputeWorker is a compute worker registered in meta.
+type RisingWaveComputeWorker struct {
+	// ID of the worker in meta.
+	ID uint32 `json:"id"`
+
+	// Host of the worker.
+	Host string `json:"host"`
+
+	// Port of the worker.
+	Port int32 `json:"port"`
+
+	// Name of the Pod that runs the worker.
+	Pod string `json:"pod"`
+}
+
+// RisingWaveComputeScaleInStatus is the status of a graceful scale-in of a compute node group.
+type RisingWaveComputeScaleInStatus struct {
+	// Name of the node group.
+	Group string `json:"group"`
+
+	// Replicas that the node group is being scaled in to.
+	Replicas int32 `json:"replicas"`
+
+	// Current phase of the scale-in.
+	Phase RisingWaveComputeScaleInPhase `json:"phase"`
+
+	// Workers to be removed.
+	// +optional
+	Workers []RisingWaveComputeWorker `json:"workers,omitempty"`
+
+	// Time when the scale-in started.
+	StartTime metav1.Time `json:"startTime"`
+}
+
+// RisingWaveOrphanedWorker is a worker registered in meta without a Pod running it.
+type RisingWaveOrphanedWorker struct {
+	// ID of the worker in meta.
+	ID uint32 `json:"id"`
+
+	// Component of the worker, e.g., frontend, compute and compactor.
+	Component string `json:"component"`
+
+	// Host of the worker.
+	Host string `json:"host"`
+
+	// Port of the worker.
+	Port int32 `json:"port"`
+
+	// Time when the worker is first found orphaned. It's deleted from meta after a grace period.
+	FirstObservedTime metav1.Time `json:"firstObservedTime"`
+}
+
+// RisingWaveMetaLeaderStatus is the status of the meta leader.
+type RisingWaveMetaLeaderStatus struct {
+	// Name of the Pod of the last observed meta leader.
+	// +optional
+	Pod string `json:"pod,omitempty"`
+
+	// Address of the last observed meta leader, in the form of <pod IP>:<service port>.
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// Number of the times that the leader has been changed to another Pod since it's first observed.
+	// +optional
+	TransitionCount int32 `json:"transitionCount,omitempty"`
+
+	// Time when the leader was last changed.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// RisingWaveFrontendEndpointStatus is the endpoint to connect to the frontend nodes.
+type RisingWaveFrontendEndpointStatus struct {
+	// In-cluster host of the frontend Service, in the form of <service>.<namespace>.svc.
+	Host string `json:"host"`
+
+	// Port of the frontend Service.
+	Port int32 `json:"port"`
+
+	// Type of the frontend Service.
+	ServiceType corev1.ServiceType `json:"serviceType"`
+
+	// Ingress points of the load balancer when the Service is of type LoadBalancer.
+	// +optional
+	LoadBalancerIngress []corev1.LoadBalancerIngress `json:"loadBalancerIngress,omitempty"`
+}
+
 // RisingWaveConditionType is the condition type of RisingWave.
 type RisingWaveConditionType string
 
@@ -182,6 +518,10 @@ const (
 	RisingWaveConditionUpgrading    RisingWaveConditionType = "Upgrading"
 	RisingWaveConditionFailed       RisingWaveConditionType = "Failed"
 	RisingWaveConditionUnknown      RisingWaveConditionType = "Unknown"
+	RisingWaveConditionStopped      RisingWaveConditionType = "Stopped"
+	RisingWaveConditionRestored     RisingWaveConditionType = "Restored"
+
+	RisingWaveConditionCanaryInProgress RisingWaveConditionType = "CanaryInProgress"
 )
 
 // RisingWaveCondition indicates a condition of RisingWave.
@@ -245,9 +585,20 @@ type RisingWaveStatus struct {
 	// when controller observes the changes on the spec and going to sync the subresources.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
-	// Version of the Global Image
+	// Version of the global image.
 	Version string `json:"version,omitempty"`
 
+	// Version of the global image that has been rolled out to all components. It's empty before the first rollout
+	// completes.
+	// +optional
+	RolledOutVersion string `json:"rolledOutVersion,omitempty"`
+
+	// Status of the ongoing version upgrade. It's only set when the version in the spec differs from the one
+	// rolled out, and the components are being upgraded in the order of meta, compute, compactor and connector,
+	// and frontend.
+	// +optional
+	Upgrade *RisingWaveUpgradeStatus `json:"upgrade,omitempty"`
+
 	// Replica status of components.
 	ComponentReplicas RisingWaveComponentsReplicasStatus `json:"componentReplicas,omitempty"`
 
@@ -270,6 +621,48 @@ type RisingWaveStatus struct {
 
 	// Status of the state store.
 	StateStore RisingWaveStateStoreStatus `json:"stateStore,omitempty"`
+
+	// Hash of the meta store and state store specs that have been rolled out. Since the locations of the stores
+	// are immutable, a different hash from the spec means the credentials are being rotated.
+	StoresHash string `json:"storesHash,omitempty"`
+
+	// Hash of the contents of the ConfigMaps and Secrets referenced by the Pods that have been rolled out. The
+	// affected node groups will be rolled when it's changed.
+	ReferencedObjectsHash string `json:"referencedObjectsHash,omitempty"`
+
+	// Status of the ongoing rollout. It's cleared when the rollout completes.
+	// +optional
+	Rollout *RisingWaveRolloutStatus `json:"rollout,omitempty"`
+
+	// Status of the canary node group when upgrading the version with canaries. It's cleared when the version
+	// upgrade completes.
+	// +optional
+	Canary *RisingWaveCanaryStatus `json:"canary,omitempty"`
+
+	// Status of the ongoing graceful scale-ins of the compute node groups. An entry is removed once the workers
+	// are unregistered from meta.
+	// +optional
+	// +listType=map
+	// +listMapKey=group
+	ComputeScaleIns []RisingWaveComputeScaleInStatus `json:"computeScaleIns,omitempty"`
+
+	// Workers registered in meta but without a Pod running them. They are deleted from meta after a grace period.
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	OrphanedWorkers []RisingWaveOrphanedWorker `json:"orphanedWorkers,omitempty"`
+
+	// Status of the meta leader, which is recognized by the role label of the meta Pods.
+	// +optional
+	MetaLeader *RisingWaveMetaLeaderStatus `json:"metaLeader,omitempty"`
+
+	// Endpoint to connect to the frontend nodes.
+	// +optional
+	FrontendEndpoint *RisingWaveFrontendEndpointStatus `json:"frontendEndpoint,omitempty"`
+
+	// In-cluster URL of the meta dashboard.
+	// +optional
+	MetaDashboardURL string `json:"metaDashboardURL,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -280,6 +673,9 @@ type RisingWaveStatus struct {
 // +kubebuilder:printcolumn:name="VERSION",type=string,JSONPath=`.status.version`
 // +kubebuilder:printcolumn:name="RUNNING",type=string,JSONPath=`.status.conditions[?(@.type=="Running")].status`
 // +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="META LEADER",type=string,JSONPath=`.status.metaLeader.pod`,priority=1
+// +kubebuilder:printcolumn:name="FRONTEND HOST",type=string,JSONPath=`.status.frontendEndpoint.host`,priority=1
+// +kubebuilder:printcolumn:name="FRONTEND PORT",type=integer,JSONPath=`.status.frontendEndpoint.port`,priority=1
 
 // RisingWave is the struct for RisingWave object.
 type RisingWave struct {
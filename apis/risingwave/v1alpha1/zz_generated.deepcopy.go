@@ -24,6 +24,8 @@ import (
 	"github.com/openkruise/kruise-api/apps/pub"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -200,6 +202,357 @@ func (in *RisingWaveAzureBlobCredentials) DeepCopy() *RisingWaveAzureBlobCredent
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveBackup) DeepCopyInto(out *RisingWaveBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveBackup.
+func (in *RisingWaveBackup) DeepCopy() *RisingWaveBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RisingWaveBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveBackupList) DeepCopyInto(out *RisingWaveBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RisingWaveBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveBackupList.
+func (in *RisingWaveBackupList) DeepCopy() *RisingWaveBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RisingWaveBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveBackupSchedule) DeepCopyInto(out *RisingWaveBackupSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveBackupSchedule.
+func (in *RisingWaveBackupSchedule) DeepCopy() *RisingWaveBackupSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveBackupSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RisingWaveBackupSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveBackupScheduleList) DeepCopyInto(out *RisingWaveBackupScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RisingWaveBackupSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveBackupScheduleList.
+func (in *RisingWaveBackupScheduleList) DeepCopy() *RisingWaveBackupScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveBackupScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RisingWaveBackupScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveBackupScheduleRetention) DeepCopyInto(out *RisingWaveBackupScheduleRetention) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveBackupScheduleRetention.
+func (in *RisingWaveBackupScheduleRetention) DeepCopy() *RisingWaveBackupScheduleRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveBackupScheduleRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveBackupScheduleRun) DeepCopyInto(out *RisingWaveBackupScheduleRun) {
+	*out = *in
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveBackupScheduleRun.
+func (in *RisingWaveBackupScheduleRun) DeepCopy() *RisingWaveBackupScheduleRun {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveBackupScheduleRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveBackupScheduleSpec) DeepCopyInto(out *RisingWaveBackupScheduleSpec) {
+	*out = *in
+	out.TargetRef = in.TargetRef
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	in.Retention.DeepCopyInto(&out.Retention)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveBackupScheduleSpec.
+func (in *RisingWaveBackupScheduleSpec) DeepCopy() *RisingWaveBackupScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveBackupScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveBackupScheduleStatus) DeepCopyInto(out *RisingWaveBackupScheduleStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Runs != nil {
+		in, out := &in.Runs, &out.Runs
+		*out = make([]RisingWaveBackupScheduleRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveBackupScheduleStatus.
+func (in *RisingWaveBackupScheduleStatus) DeepCopy() *RisingWaveBackupScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveBackupScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveBackupSpec) DeepCopyInto(out *RisingWaveBackupSpec) {
+	*out = *in
+	out.TargetRef = in.TargetRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveBackupSpec.
+func (in *RisingWaveBackupSpec) DeepCopy() *RisingWaveBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveBackupStatus) DeepCopyInto(out *RisingWaveBackupStatus) {
+	*out = *in
+	if in.JobID != nil {
+		in, out := &in.JobID, &out.JobID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveBackupStatus.
+func (in *RisingWaveBackupStatus) DeepCopy() *RisingWaveBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveBackupTargetRef) DeepCopyInto(out *RisingWaveBackupTargetRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveBackupTargetRef.
+func (in *RisingWaveBackupTargetRef) DeepCopy() *RisingWaveBackupTargetRef {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveBackupTargetRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveCanaryStatus) DeepCopyInto(out *RisingWaveCanaryStatus) {
+	*out = *in
+	if in.ReadyTime != nil {
+		in, out := &in.ReadyTime, &out.ReadyTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveCanaryStatus.
+func (in *RisingWaveCanaryStatus) DeepCopy() *RisingWaveCanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveCanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveCanaryUpgradePolicy) DeepCopyInto(out *RisingWaveCanaryUpgradePolicy) {
+	*out = *in
+	if in.Meta != nil {
+		in, out := &in.Meta, &out.Meta
+		*out = new(string)
+		**out = **in
+	}
+	if in.Frontend != nil {
+		in, out := &in.Frontend, &out.Frontend
+		*out = new(string)
+		**out = **in
+	}
+	if in.Compute != nil {
+		in, out := &in.Compute, &out.Compute
+		*out = new(string)
+		**out = **in
+	}
+	if in.Compactor != nil {
+		in, out := &in.Compactor, &out.Compactor
+		*out = new(string)
+		**out = **in
+	}
+	if in.Connector != nil {
+		in, out := &in.Connector, &out.Connector
+		*out = new(string)
+		**out = **in
+	}
+	if in.SoakSeconds != nil {
+		in, out := &in.SoakSeconds, &out.SoakSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveCanaryUpgradePolicy.
+func (in *RisingWaveCanaryUpgradePolicy) DeepCopy() *RisingWaveCanaryUpgradePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveCanaryUpgradePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RisingWaveComponent) DeepCopyInto(out *RisingWaveComponent) {
 	*out = *in
@@ -288,6 +641,42 @@ func (in *RisingWaveComponentsSpec) DeepCopy() *RisingWaveComponentsSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveComputeScaleInStatus) DeepCopyInto(out *RisingWaveComputeScaleInStatus) {
+	*out = *in
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]RisingWaveComputeWorker, len(*in))
+		copy(*out, *in)
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveComputeScaleInStatus.
+func (in *RisingWaveComputeScaleInStatus) DeepCopy() *RisingWaveComputeScaleInStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveComputeScaleInStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveComputeWorker) DeepCopyInto(out *RisingWaveComputeWorker) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveComputeWorker.
+func (in *RisingWaveComputeWorker) DeepCopy() *RisingWaveComputeWorker {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveComputeWorker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RisingWaveCondition) DeepCopyInto(out *RisingWaveCondition) {
 	*out = *in
@@ -304,6 +693,36 @@ func (in *RisingWaveCondition) DeepCopy() *RisingWaveCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveConfigurationSettings) DeepCopyInto(out *RisingWaveConfigurationSettings) {
+	*out = *in
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(RisingWaveServerSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(RisingWaveStreamingSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(RisingWaveStorageSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveConfigurationSettings.
+func (in *RisingWaveConfigurationSettings) DeepCopy() *RisingWaveConfigurationSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveConfigurationSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RisingWaveConfigurationSpec) DeepCopyInto(out *RisingWaveConfigurationSpec) {
 	*out = *in
@@ -320,6 +739,21 @@ func (in *RisingWaveConfigurationSpec) DeepCopy() *RisingWaveConfigurationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveDBCredentials) DeepCopyInto(out *RisingWaveDBCredentials) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveDBCredentials.
+func (in *RisingWaveDBCredentials) DeepCopy() *RisingWaveDBCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveDBCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RisingWaveEtcdCredentials) DeepCopyInto(out *RisingWaveEtcdCredentials) {
 	*out = *in
@@ -335,6 +769,130 @@ func (in *RisingWaveEtcdCredentials) DeepCopy() *RisingWaveEtcdCredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveEtcdManaged) DeepCopyInto(out *RisingWaveEtcdManaged) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	out.StorageSize = in.StorageSize.DeepCopy()
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveEtcdManaged.
+func (in *RisingWaveEtcdManaged) DeepCopy() *RisingWaveEtcdManaged {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveEtcdManaged)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveEtcdTLS) DeepCopyInto(out *RisingWaveEtcdTLS) {
+	*out = *in
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCert != nil {
+		in, out := &in.ClientCert, &out.ClientCert
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientKey != nil {
+		in, out := &in.ClientKey, &out.ClientKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveEtcdTLS.
+func (in *RisingWaveEtcdTLS) DeepCopy() *RisingWaveEtcdTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveEtcdTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveFileCacheSettings) DeepCopyInto(out *RisingWaveFileCacheSettings) {
+	*out = *in
+	if in.Dir != nil {
+		in, out := &in.Dir, &out.Dir
+		*out = new(string)
+		**out = **in
+	}
+	if in.CapacityMB != nil {
+		in, out := &in.CapacityMB, &out.CapacityMB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FileCapacityMB != nil {
+		in, out := &in.FileCapacityMB, &out.FileCapacityMB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Flushers != nil {
+		in, out := &in.Flushers, &out.Flushers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Reclaimers != nil {
+		in, out := &in.Reclaimers, &out.Reclaimers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RecoverConcurrency != nil {
+		in, out := &in.RecoverConcurrency, &out.RecoverConcurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InsertRateLimitMB != nil {
+		in, out := &in.InsertRateLimitMB, &out.InsertRateLimitMB
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveFileCacheSettings.
+func (in *RisingWaveFileCacheSettings) DeepCopy() *RisingWaveFileCacheSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveFileCacheSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveFrontendEndpointStatus) DeepCopyInto(out *RisingWaveFrontendEndpointStatus) {
+	*out = *in
+	if in.LoadBalancerIngress != nil {
+		in, out := &in.LoadBalancerIngress, &out.LoadBalancerIngress
+		*out = make([]v1.LoadBalancerIngress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveFrontendEndpointStatus.
+func (in *RisingWaveFrontendEndpointStatus) DeepCopy() *RisingWaveFrontendEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveFrontendEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RisingWaveGCSCredentials) DeepCopyInto(out *RisingWaveGCSCredentials) {
 	*out = *in
@@ -402,6 +960,73 @@ func (in *RisingWaveList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveMetaDashboardIngress) DeepCopyInto(out *RisingWaveMetaDashboardIngress) {
+	*out = *in
+	if in.IngressClassName != nil {
+		in, out := &in.IngressClassName, &out.IngressClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = make([]networkingv1.IngressTLS, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.AdditionalMetadata.DeepCopyInto(&out.AdditionalMetadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaDashboardIngress.
+func (in *RisingWaveMetaDashboardIngress) DeepCopy() *RisingWaveMetaDashboardIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveMetaDashboardIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveMetaLeaderService) DeepCopyInto(out *RisingWaveMetaLeaderService) {
+	*out = *in
+	if in.DashboardIngress != nil {
+		in, out := &in.DashboardIngress, &out.DashboardIngress
+		*out = new(RisingWaveMetaDashboardIngress)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaLeaderService.
+func (in *RisingWaveMetaLeaderService) DeepCopy() *RisingWaveMetaLeaderService {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveMetaLeaderService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveMetaLeaderStatus) DeepCopyInto(out *RisingWaveMetaLeaderStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaLeaderStatus.
+func (in *RisingWaveMetaLeaderStatus) DeepCopy() *RisingWaveMetaLeaderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveMetaLeaderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RisingWaveMetaStoreBackend) DeepCopyInto(out *RisingWaveMetaStoreBackend) {
 	*out = *in
@@ -415,64 +1040,192 @@ func (in *RisingWaveMetaStoreBackend) DeepCopyInto(out *RisingWaveMetaStoreBacke
 		*out = new(RisingWaveMetaStoreBackendEtcd)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PostgreSQL != nil {
+		in, out := &in.PostgreSQL, &out.PostgreSQL
+		*out = new(RisingWaveMetaStoreBackendPostgreSQL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MySQL != nil {
+		in, out := &in.MySQL, &out.MySQL
+		*out = new(RisingWaveMetaStoreBackendMySQL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQLite != nil {
+		in, out := &in.SQLite, &out.SQLite
+		*out = new(RisingWaveMetaStoreBackendSQLite)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaStoreBackend.
+func (in *RisingWaveMetaStoreBackend) DeepCopy() *RisingWaveMetaStoreBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveMetaStoreBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveMetaStoreBackendEtcd) DeepCopyInto(out *RisingWaveMetaStoreBackendEtcd) {
+	*out = *in
+	if in.RisingWaveEtcdCredentials != nil {
+		in, out := &in.RisingWaveEtcdCredentials, &out.RisingWaveEtcdCredentials
+		*out = new(RisingWaveEtcdCredentials)
+		**out = **in
+	}
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(RisingWaveEtcdManaged)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(RisingWaveEtcdTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaStoreBackendEtcd.
+func (in *RisingWaveMetaStoreBackendEtcd) DeepCopy() *RisingWaveMetaStoreBackendEtcd {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveMetaStoreBackendEtcd)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveMetaStoreBackendMySQL) DeepCopyInto(out *RisingWaveMetaStoreBackendMySQL) {
+	*out = *in
+	out.RisingWaveDBCredentials = in.RisingWaveDBCredentials
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaStoreBackendMySQL.
+func (in *RisingWaveMetaStoreBackendMySQL) DeepCopy() *RisingWaveMetaStoreBackendMySQL {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveMetaStoreBackendMySQL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveMetaStoreBackendPostgreSQL) DeepCopyInto(out *RisingWaveMetaStoreBackendPostgreSQL) {
+	*out = *in
+	out.RisingWaveDBCredentials = in.RisingWaveDBCredentials
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaStoreBackendPostgreSQL.
+func (in *RisingWaveMetaStoreBackendPostgreSQL) DeepCopy() *RisingWaveMetaStoreBackendPostgreSQL {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveMetaStoreBackendPostgreSQL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveMetaStoreBackendSQLite) DeepCopyInto(out *RisingWaveMetaStoreBackendSQLite) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaStoreBackendSQLite.
+func (in *RisingWaveMetaStoreBackendSQLite) DeepCopy() *RisingWaveMetaStoreBackendSQLite {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveMetaStoreBackendSQLite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveMetaStoreStatus) DeepCopyInto(out *RisingWaveMetaStoreStatus) {
+	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaStoreBackend.
-func (in *RisingWaveMetaStoreBackend) DeepCopy() *RisingWaveMetaStoreBackend {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaStoreStatus.
+func (in *RisingWaveMetaStoreStatus) DeepCopy() *RisingWaveMetaStoreStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RisingWaveMetaStoreBackend)
+	out := new(RisingWaveMetaStoreStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RisingWaveMetaStoreBackendEtcd) DeepCopyInto(out *RisingWaveMetaStoreBackendEtcd) {
+func (in *RisingWaveMetaTLS) DeepCopyInto(out *RisingWaveMetaTLS) {
 	*out = *in
-	if in.RisingWaveEtcdCredentials != nil {
-		in, out := &in.RisingWaveEtcdCredentials, &out.RisingWaveEtcdCredentials
-		*out = new(RisingWaveEtcdCredentials)
+	if in.VerifyServerName != nil {
+		in, out := &in.VerifyServerName, &out.VerifyServerName
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaStoreBackendEtcd.
-func (in *RisingWaveMetaStoreBackendEtcd) DeepCopy() *RisingWaveMetaStoreBackendEtcd {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaTLS.
+func (in *RisingWaveMetaTLS) DeepCopy() *RisingWaveMetaTLS {
 	if in == nil {
 		return nil
 	}
-	out := new(RisingWaveMetaStoreBackendEtcd)
+	out := new(RisingWaveMetaTLS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RisingWaveMetaStoreStatus) DeepCopyInto(out *RisingWaveMetaStoreStatus) {
+func (in *RisingWaveMinIOCredentials) DeepCopyInto(out *RisingWaveMinIOCredentials) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMetaStoreStatus.
-func (in *RisingWaveMetaStoreStatus) DeepCopy() *RisingWaveMetaStoreStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMinIOCredentials.
+func (in *RisingWaveMinIOCredentials) DeepCopy() *RisingWaveMinIOCredentials {
 	if in == nil {
 		return nil
 	}
-	out := new(RisingWaveMetaStoreStatus)
+	out := new(RisingWaveMinIOCredentials)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RisingWaveMinIOCredentials) DeepCopyInto(out *RisingWaveMinIOCredentials) {
+func (in *RisingWaveMinIOManaged) DeepCopyInto(out *RisingWaveMinIOManaged) {
 	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	out.StorageSize = in.StorageSize.DeepCopy()
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMinIOCredentials.
-func (in *RisingWaveMinIOCredentials) DeepCopy() *RisingWaveMinIOCredentials {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveMinIOManaged.
+func (in *RisingWaveMinIOManaged) DeepCopy() *RisingWaveMinIOManaged {
 	if in == nil {
 		return nil
 	}
-	out := new(RisingWaveMinIOCredentials)
+	out := new(RisingWaveMinIOManaged)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -490,6 +1243,11 @@ func (in *RisingWaveNodeConfiguration) DeepCopyInto(out *RisingWaveNodeConfigura
 		*out = new(RisingWaveNodeConfigurationSecretSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(RisingWaveConfigurationSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveNodeConfiguration.
@@ -619,6 +1377,11 @@ func (in *RisingWaveNodeGroup) DeepCopyInto(out *RisingWaveNodeGroup) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(RisingWaveNodeGroupPodDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Template.DeepCopyInto(&out.Template)
 }
 
@@ -632,6 +1395,31 @@ func (in *RisingWaveNodeGroup) DeepCopy() *RisingWaveNodeGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveNodeGroupPodDisruptionBudget) DeepCopyInto(out *RisingWaveNodeGroupPodDisruptionBudget) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveNodeGroupPodDisruptionBudget.
+func (in *RisingWaveNodeGroupPodDisruptionBudget) DeepCopy() *RisingWaveNodeGroupPodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveNodeGroupPodDisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RisingWaveNodeGroupRollingUpdate) DeepCopyInto(out *RisingWaveNodeGroupRollingUpdate) {
 	*out = *in
@@ -836,6 +1624,21 @@ func (in *RisingWaveNodePodTemplateSpec) DeepCopyInto(out *RisingWaveNodePodTemp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveNodePodTemplateSpec.
@@ -848,6 +1651,59 @@ func (in *RisingWaveNodePodTemplateSpec) DeepCopy() *RisingWaveNodePodTemplateSp
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveOrphanedWorker) DeepCopyInto(out *RisingWaveOrphanedWorker) {
+	*out = *in
+	in.FirstObservedTime.DeepCopyInto(&out.FirstObservedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveOrphanedWorker.
+func (in *RisingWaveOrphanedWorker) DeepCopy() *RisingWaveOrphanedWorker {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveOrphanedWorker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveRestoreFrom) DeepCopyInto(out *RisingWaveRestoreFrom) {
+	*out = *in
+	in.StateStore.DeepCopyInto(&out.StateStore)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveRestoreFrom.
+func (in *RisingWaveRestoreFrom) DeepCopy() *RisingWaveRestoreFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveRestoreFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveRolloutStatus) DeepCopyInto(out *RisingWaveRolloutStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.SoakedCanaryComponents != nil {
+		in, out := &in.SoakedCanaryComponents, &out.SoakedCanaryComponents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveRolloutStatus.
+func (in *RisingWaveRolloutStatus) DeepCopy() *RisingWaveRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RisingWaveS3Credentials) DeepCopyInto(out *RisingWaveS3Credentials) {
 	*out = *in
@@ -1060,6 +1916,41 @@ func (in *RisingWaveScaleViewTargetRef) DeepCopy() *RisingWaveScaleViewTargetRef
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveServerSettings) DeepCopyInto(out *RisingWaveServerSettings) {
+	*out = *in
+	if in.HeartbeatIntervalMs != nil {
+		in, out := &in.HeartbeatIntervalMs, &out.HeartbeatIntervalMs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConnectionPoolSize != nil {
+		in, out := &in.ConnectionPoolSize, &out.ConnectionPoolSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MetricsLevel != nil {
+		in, out := &in.MetricsLevel, &out.MetricsLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.TelemetryEnabled != nil {
+		in, out := &in.TelemetryEnabled, &out.TelemetryEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveServerSettings.
+func (in *RisingWaveServerSettings) DeepCopy() *RisingWaveServerSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveServerSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RisingWaveSpec) DeepCopyInto(out *RisingWaveSpec) {
 	*out = *in
@@ -1083,6 +1974,32 @@ func (in *RisingWaveSpec) DeepCopyInto(out *RisingWaveSpec) {
 	in.AdditionalFrontendServiceMetadata.DeepCopyInto(&out.AdditionalFrontendServiceMetadata)
 	in.MetaStore.DeepCopyInto(&out.MetaStore)
 	in.StateStore.DeepCopyInto(&out.StateStore)
+	if in.Stopped != nil {
+		in, out := &in.Stopped, &out.Stopped
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(RisingWaveRestoreFrom)
+		(*in).DeepCopyInto(*out)
+	}
+	in.UpgradePolicy.DeepCopyInto(&out.UpgradePolicy)
+	if in.EnableGracefulComputeScaleIn != nil {
+		in, out := &in.EnableGracefulComputeScaleIn, &out.EnableGracefulComputeScaleIn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MetaLeaderService != nil {
+		in, out := &in.MetaLeaderService, &out.MetaLeaderService
+		*out = new(RisingWaveMetaLeaderService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetaTLS != nil {
+		in, out := &in.MetaTLS, &out.MetaTLS
+		*out = new(RisingWaveMetaTLS)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveSpec.
@@ -1111,13 +2028,18 @@ func (in *RisingWaveStateStoreBackend) DeepCopyInto(out *RisingWaveStateStoreBac
 	if in.MinIO != nil {
 		in, out := &in.MinIO, &out.MinIO
 		*out = new(RisingWaveStateStoreBackendMinIO)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.S3 != nil {
 		in, out := &in.S3, &out.S3
 		*out = new(RisingWaveStateStoreBackendS3)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.S3Compatible != nil {
+		in, out := &in.S3Compatible, &out.S3Compatible
+		*out = new(RisingWaveStateStoreBackendS3Compatible)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GCS != nil {
 		in, out := &in.GCS, &out.GCS
 		*out = new(RisingWaveStateStoreBackendGCS)
@@ -1237,6 +2159,11 @@ func (in *RisingWaveStateStoreBackendLocalDisk) DeepCopy() *RisingWaveStateStore
 func (in *RisingWaveStateStoreBackendMinIO) DeepCopyInto(out *RisingWaveStateStoreBackendMinIO) {
 	*out = *in
 	out.RisingWaveMinIOCredentials = in.RisingWaveMinIOCredentials
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(RisingWaveMinIOManaged)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveStateStoreBackendMinIO.
@@ -1265,6 +2192,27 @@ func (in *RisingWaveStateStoreBackendS3) DeepCopy() *RisingWaveStateStoreBackend
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveStateStoreBackendS3Compatible) DeepCopyInto(out *RisingWaveStateStoreBackendS3Compatible) {
+	*out = *in
+	in.RisingWaveS3Credentials.DeepCopyInto(&out.RisingWaveS3Credentials)
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveStateStoreBackendS3Compatible.
+func (in *RisingWaveStateStoreBackendS3Compatible) DeepCopy() *RisingWaveStateStoreBackendS3Compatible {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveStateStoreBackendS3Compatible)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RisingWaveStateStoreStatus) DeepCopyInto(out *RisingWaveStateStoreStatus) {
 	*out = *in
@@ -1283,6 +2231,11 @@ func (in *RisingWaveStateStoreStatus) DeepCopy() *RisingWaveStateStoreStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RisingWaveStatus) DeepCopyInto(out *RisingWaveStatus) {
 	*out = *in
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(RisingWaveUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ComponentReplicas.DeepCopyInto(&out.ComponentReplicas)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1300,6 +2253,40 @@ func (in *RisingWaveStatus) DeepCopyInto(out *RisingWaveStatus) {
 	}
 	out.MetaStore = in.MetaStore
 	out.StateStore = in.StateStore
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RisingWaveRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(RisingWaveCanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComputeScaleIns != nil {
+		in, out := &in.ComputeScaleIns, &out.ComputeScaleIns
+		*out = make([]RisingWaveComputeScaleInStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OrphanedWorkers != nil {
+		in, out := &in.OrphanedWorkers, &out.OrphanedWorkers
+		*out = make([]RisingWaveOrphanedWorker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetaLeader != nil {
+		in, out := &in.MetaLeader, &out.MetaLeader
+		*out = new(RisingWaveMetaLeaderStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FrontendEndpoint != nil {
+		in, out := &in.FrontendEndpoint, &out.FrontendEndpoint
+		*out = new(RisingWaveFrontendEndpointStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveStatus.
@@ -1312,6 +2299,136 @@ func (in *RisingWaveStatus) DeepCopy() *RisingWaveStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveStorageSettings) DeepCopyInto(out *RisingWaveStorageSettings) {
+	*out = *in
+	if in.ShareBuffersSyncParallelism != nil {
+		in, out := &in.ShareBuffersSyncParallelism, &out.ShareBuffersSyncParallelism
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SharedBufferCapacityMB != nil {
+		in, out := &in.SharedBufferCapacityMB, &out.SharedBufferCapacityMB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BlockCacheCapacityMB != nil {
+		in, out := &in.BlockCacheCapacityMB, &out.BlockCacheCapacityMB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MetaCacheCapacityMB != nil {
+		in, out := &in.MetaCacheCapacityMB, &out.MetaCacheCapacityMB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CompactorMemoryLimitMB != nil {
+		in, out := &in.CompactorMemoryLimitMB, &out.CompactorMemoryLimitMB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentCompactionTaskNumber != nil {
+		in, out := &in.MaxConcurrentCompactionTaskNumber, &out.MaxConcurrentCompactionTaskNumber
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DataFileCache != nil {
+		in, out := &in.DataFileCache, &out.DataFileCache
+		*out = new(RisingWaveFileCacheSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetaFileCache != nil {
+		in, out := &in.MetaFileCache, &out.MetaFileCache
+		*out = new(RisingWaveFileCacheSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveStorageSettings.
+func (in *RisingWaveStorageSettings) DeepCopy() *RisingWaveStorageSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveStorageSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveStreamingSettings) DeepCopyInto(out *RisingWaveStreamingSettings) {
+	*out = *in
+	if in.InFlightBarrierNums != nil {
+		in, out := &in.InFlightBarrierNums, &out.InFlightBarrierNums
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UniqueUserStreamErrors != nil {
+		in, out := &in.UniqueUserStreamErrors, &out.UniqueUserStreamErrors
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveStreamingSettings.
+func (in *RisingWaveStreamingSettings) DeepCopy() *RisingWaveStreamingSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveStreamingSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveUpgradePolicy) DeepCopyInto(out *RisingWaveUpgradePolicy) {
+	*out = *in
+	if in.ProgressDeadlineSeconds != nil {
+		in, out := &in.ProgressDeadlineSeconds, &out.ProgressDeadlineSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(RisingWaveCanaryUpgradePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveUpgradePolicy.
+func (in *RisingWaveUpgradePolicy) DeepCopy() *RisingWaveUpgradePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveUpgradePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RisingWaveUpgradeStatus) DeepCopyInto(out *RisingWaveUpgradeStatus) {
+	*out = *in
+	if in.PendingComponents != nil {
+		in, out := &in.PendingComponents, &out.PendingComponents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RisingWaveUpgradeStatus.
+func (in *RisingWaveUpgradeStatus) DeepCopy() *RisingWaveUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RisingWaveUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadReplicaStatus) DeepCopyInto(out *WorkloadReplicaStatus) {
 	*out = *in
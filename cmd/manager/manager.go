@@ -22,6 +22,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	prometheusv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -68,6 +69,10 @@ var (
 	enableLeaderElection bool
 	featureGates         string
 	operatorVersion      string
+	metaTLSOptions       risingwavecontroller.MetaTLSOptions
+
+	orphanedWorkerDeletionGracePeriod time.Duration
+	metaPodRoleSyncInterval           time.Duration
 )
 
 func requireKubernetesVersion(serverVersion *version.Info, minMajor, minMinor int) {
@@ -88,6 +93,12 @@ func main() {
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false, "Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
 	flag.StringVar(&featureGates, "feature-gates", "", "The feature gates arguments for the operator.")
+	flag.StringVar(&metaTLSOptions.CAFile, "meta-tls-ca-file", "", "The CA bundle to verify the certificates of meta. It only applies to the RisingWaves with spec.metaTLS set but no CA Secret in it. Setting it alone doesn't enable TLS: the RisingWaves without spec.metaTLS are always connected in plaintext.")
+	flag.StringVar(&metaTLSOptions.CertFile, "meta-tls-cert-file", "", "The client certificate presented to meta. It only applies to the RisingWaves with spec.metaTLS set but no client certificate Secret in it.")
+	flag.StringVar(&metaTLSOptions.KeyFile, "meta-tls-key-file", "", "The private key of the client certificate presented to meta.")
+	flag.BoolVar(&metaTLSOptions.VerifyServerName, "meta-tls-verify-server-name", true, "Verify the server name in the certificates of meta. It only applies to the RisingWaves with spec.metaTLS set but verifyServerName unset in it.")
+	flag.DurationVar(&orphanedWorkerDeletionGracePeriod, "orphaned-worker-deletion-grace-period", risingwavecontroller.DefaultRisingWaveOrphanedWorkerDeletionGracePeriod, "The period that the workers registered in meta have been without Pods before being deleted from meta. It should be no shorter than the heartbeat timeout of meta.")
+	flag.DurationVar(&metaPodRoleSyncInterval, "meta-pod-role-sync-interval", risingwavecontroller.DefaultMetaPodRoleSyncInterval, "The interval of polling the roles of the meta Pods.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -126,7 +137,9 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = risingwavecontroller.NewMetaPodRoleLabeler(mgr.GetClient()).SetupWithManager(mgr); err != nil {
+	metaDialer := risingwavecontroller.NewMetaDialer(mgr.GetClient(), metaTLSOptions)
+
+	if err = risingwavecontroller.NewMetaPodRoleLabeler(mgr.GetClient(), metaDialer, metaPodRoleSyncInterval).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "meta-pod-role-labeler")
 		os.Exit(1)
 	}
@@ -137,16 +150,36 @@ func main() {
 		featureManager.IsFeatureEnabled(features.EnableOpenKruiseFeature),
 		featureManager.IsFeatureEnabled(features.EnableForceUpdate),
 		operatorVersion,
+		metaDialer,
 	).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "RisingWave")
 		os.Exit(1)
 	}
 
+	if err = risingwavecontroller.NewRisingWaveWorkerRegistrationController(mgr.GetClient(), metaDialer, orphanedWorkerDeletionGracePeriod).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RisingWaveWorkerRegistration")
+		os.Exit(1)
+	}
+
 	if err = risingwavecontroller.NewRisingWaveScaleViewController(mgr.GetClient()).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "RisingWaveScaleView")
 		os.Exit(1)
 	}
 
+	if err = risingwavecontroller.NewRisingWaveBackupController(mgr.GetClient(), metaDialer).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RisingWaveBackup")
+		os.Exit(1)
+	}
+
+	if err = risingwavecontroller.NewRisingWaveBackupScheduleController(
+		mgr.GetClient(),
+		mgr.GetEventRecorderFor("risingwave-backup-schedule-controller"),
+		metaDialer,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RisingWaveBackupSchedule")
+		os.Exit(1)
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
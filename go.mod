@@ -8,10 +8,12 @@ require (
 	github.com/go-logr/logr v1.2.4
 	github.com/google/uuid v1.3.0
 	github.com/openkruise/kruise-api v1.3.0
+	github.com/pelletier/go-toml/v2 v2.0.8
 	github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring v0.63.0
 	github.com/prometheus/client_golang v1.15.1
 	github.com/prometheus/client_model v0.4.0
 	github.com/risingwavelabs/ctrlkit v1.0.0
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/samber/lo v1.38.1
 	github.com/stretchr/testify v1.8.3
 	golang.org/x/time v0.3.0
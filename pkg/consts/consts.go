@@ -30,6 +30,14 @@ const (
 	LabelRisingWaveOperatorVersion = "risingwave/operator-version"
 )
 
+// LabelRisingWaveConfig marks a ConfigMap as a RisingWave configuration, and the value is the key of the
+// `risingwave.toml` in it. The ConfigMaps with the label are validated by the webhook.
+const LabelRisingWaveConfig = "risingwave.risingwavelabs.com/config"
+
+// LabelRisingWaveBackupSchedule marks a RisingWaveBackup as created by a RisingWaveBackupSchedule, and the value is
+// the name of the schedule.
+const LabelRisingWaveBackupSchedule = "risingwave.risingwavelabs.com/backup-schedule"
+
 // =================================================
 // Annotations.
 // =================================================
@@ -40,6 +48,7 @@ const (
 	AnnotationPauseReconcile          = "risingwave.risingwavelabs.com/pause-reconcile"
 	AnnotationBypassValidatingWebhook = "risingwave.risingwavelabs.com/bypass-validating-webhook"
 	AnnotationInheritLabelPrefix      = "risingwave.risingwavelabs.com/inherit-label-prefix"
+	AnnotationReferencedObjectsHash   = "risingwave.risingwavelabs.com/referenced-objects-hash"
 )
 
 // =================================================
@@ -67,6 +76,8 @@ const (
 	ComponentCompactor = "compactor"
 	ComponentConnector = "connector"
 	ComponentConfig    = "config"
+	ComponentEtcd      = "etcd"
+	ComponentMinIO     = "minio"
 )
 
 // Credential keys for MinIO.
@@ -110,6 +121,11 @@ const (
 	PortService   string = "service"
 	PortMetrics   string = "metrics"
 	PortDashboard string = "dashboard"
+	PortClient    string = "client"
+	PortPeer      string = "peer"
+	PortAPI       string = "api"
+	PortConsole   string = "console"
+	PortHealth    string = "health"
 )
 
 // Port numbers of components.
@@ -121,8 +137,13 @@ const (
 	ComputeMetricsPort   int32 = 1222
 	FrontendServicePort  int32 = 4567
 	FrontendMetricsPort  int32 = 8080
+	FrontendHealthPort   int32 = 6786
 	CompactorServicePort int32 = 6660
 	CompactorMetricsPort int32 = 1260
 	ConnectorServicePort int32 = 50051
 	ConnectorMetricsPort int32 = 50052
+	EtcdClientPort       int32 = 2379
+	EtcdPeerPort         int32 = 2380
+	MinIOAPIPort         int32 = 9000
+	MinIOConsolePort     int32 = 9001
 )
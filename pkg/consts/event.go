@@ -26,9 +26,22 @@ type RisingWaveEventType struct {
 
 // Valid event types.
 var (
-	RisingWaveEventTypeInitializing = RisingWaveEventType{Name: "Initializing", Type: corev1.EventTypeNormal}
-	RisingWaveEventTypeRunning      = RisingWaveEventType{Name: "Running", Type: corev1.EventTypeNormal}
-	RisingWaveEventTypeUnhealthy    = RisingWaveEventType{Name: "Unhealthy", Type: corev1.EventTypeWarning}
-	RisingWaveEventTypeRecovering   = RisingWaveEventType{Name: "Recovering", Type: corev1.EventTypeNormal}
-	RisingWaveEventTypeUpgrading    = RisingWaveEventType{Name: "Upgrading", Type: corev1.EventTypeNormal}
+	RisingWaveEventTypeInitializing  = RisingWaveEventType{Name: "Initializing", Type: corev1.EventTypeNormal}
+	RisingWaveEventTypeRunning       = RisingWaveEventType{Name: "Running", Type: corev1.EventTypeNormal}
+	RisingWaveEventTypeUnhealthy     = RisingWaveEventType{Name: "Unhealthy", Type: corev1.EventTypeWarning}
+	RisingWaveEventTypeRecovering    = RisingWaveEventType{Name: "Recovering", Type: corev1.EventTypeNormal}
+	RisingWaveEventTypeUpgrading     = RisingWaveEventType{Name: "Upgrading", Type: corev1.EventTypeNormal}
+	RisingWaveEventTypeUpgradeFailed = RisingWaveEventType{Name: "UpgradeFailed", Type: corev1.EventTypeWarning}
+	RisingWaveEventTypeRolledBack    = RisingWaveEventType{Name: "RolledBack", Type: corev1.EventTypeWarning}
+)
+
+// Valid event types of RisingWaveBackupSchedule.
+var (
+	RisingWaveEventTypeBackupScheduled        = RisingWaveEventType{Name: "BackupScheduled", Type: corev1.EventTypeNormal}
+	RisingWaveEventTypeBackupSkipped          = RisingWaveEventType{Name: "BackupSkipped", Type: corev1.EventTypeNormal}
+	RisingWaveEventTypeBackupSucceeded        = RisingWaveEventType{Name: "BackupSucceeded", Type: corev1.EventTypeNormal}
+	RisingWaveEventTypeBackupFailed           = RisingWaveEventType{Name: "BackupFailed", Type: corev1.EventTypeWarning}
+	RisingWaveEventTypeSnapshotsDeleted       = RisingWaveEventType{Name: "SnapshotsDeleted", Type: corev1.EventTypeNormal}
+	RisingWaveEventTypeSnapshotDeletionFailed = RisingWaveEventType{Name: "SnapshotDeletionFailed", Type: corev1.EventTypeWarning}
+	RisingWaveEventTypeInvalidSchedule        = RisingWaveEventType{Name: "InvalidSchedule", Type: corev1.EventTypeWarning}
 )
@@ -0,0 +1,222 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
+	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+)
+
+// MetaDialer dials the meta node of the RisingWave at the given address. The RisingWave can be nil when it's unknown,
+// and then meta is dialed in plaintext.
+type MetaDialer func(ctx context.Context, risingwave *risingwavev1alpha1.RisingWave, addr string) (*grpc.ClientConn, error)
+
+func dialMetaInsecure(ctx context.Context, _ *risingwavev1alpha1.RisingWave, addr string) (*grpc.ClientConn, error) {
+	return grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// MetaTLSOptions are the operator-level TLS options of the connections to meta. They only apply to the RisingWaves
+// with the meta TLS enabled in the spec, and are used when the spec leaves the CA bundle, the client certificate or
+// the server name verification unset.
+type MetaTLSOptions struct {
+	// CAFile is the path of the CA bundle to verify the certificate of meta.
+	CAFile string
+
+	// CertFile and KeyFile are the paths of the client certificate and private key. They're optional.
+	CertFile string
+	KeyFile  string
+
+	// VerifyServerName tells whether to verify the server name in the certificate of meta.
+	VerifyServerName bool
+}
+
+// metaDialer dials meta with the TLS configuration of the RisingWave, falling back to the operator-level options.
+// The RisingWaves without the meta TLS enabled are dialed in plaintext. The files and Secrets are read on every dial
+// so that the rotated certificates are picked up.
+type metaDialer struct {
+	reader  client.Reader
+	options MetaTLSOptions
+}
+
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+func (d *metaDialer) Dial(ctx context.Context, risingwave *risingwavev1alpha1.RisingWave, addr string) (*grpc.ClientConn, error) {
+	tlsConfig, err := d.tlsConfig(ctx, risingwave)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build meta tls config: %w", err)
+	}
+	if tlsConfig == nil {
+		return dialMetaInsecure(ctx, risingwave, addr)
+	}
+	return grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+}
+
+func (d *metaDialer) getSecret(ctx context.Context, namespace, name string) (*corev1.Secret, error) {
+	var secret corev1.Secret
+	if err := d.reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &secret); err != nil {
+		return nil, fmt.Errorf("unable to get secret %s: %w", name, err)
+	}
+	return &secret, nil
+}
+
+func (d *metaDialer) caPEM(ctx context.Context, risingwave *risingwavev1alpha1.RisingWave) ([]byte, error) {
+	metaTLS := risingwave.Spec.MetaTLS
+
+	if metaTLS.SecretName != "" {
+		secret, err := d.getSecret(ctx, risingwave.Namespace, metaTLS.SecretName)
+		if err != nil {
+			return nil, err
+		}
+		caPEM, ok := secret.Data[corev1.ServiceAccountRootCAKey]
+		if !ok {
+			return nil, fmt.Errorf("key %s not found in secret %s", corev1.ServiceAccountRootCAKey, metaTLS.SecretName)
+		}
+		return caPEM, nil
+	}
+
+	if d.options.CAFile == "" {
+		return nil, errors.New("neither the ca secret nor the operator-level ca file is set")
+	}
+	caPEM, err := os.ReadFile(d.options.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read ca file: %w", err)
+	}
+	return caPEM, nil
+}
+
+func (d *metaDialer) clientCertPEM(ctx context.Context, risingwave *risingwavev1alpha1.RisingWave) ([]byte, []byte, error) {
+	metaTLS := risingwave.Spec.MetaTLS
+
+	if metaTLS.ClientCertSecretName != "" {
+		secret, err := d.getSecret(ctx, risingwave.Namespace, metaTLS.ClientCertSecretName)
+		if err != nil {
+			return nil, nil, err
+		}
+		return secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey], nil
+	}
+
+	if d.options.CertFile == "" && d.options.KeyFile == "" {
+		return nil, nil, nil
+	}
+	certPEM, err := os.ReadFile(d.options.CertFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read cert file: %w", err)
+	}
+	keyPEM, err := os.ReadFile(d.options.KeyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read key file: %w", err)
+	}
+	return certPEM, keyPEM, nil
+}
+
+func (d *metaDialer) tlsConfig(ctx context.Context, risingwave *risingwavev1alpha1.RisingWave) (*tls.Config, error) {
+	// Plaintext unless the RisingWave opts in.
+	if risingwave == nil || risingwave.Spec.MetaTLS == nil {
+		return nil, nil
+	}
+	metaTLS := risingwave.Spec.MetaTLS
+
+	caPEM, err := d.caPEM(ctx, risingwave)
+	if err != nil {
+		return nil, err
+	}
+	certPEM, keyPEM, err := d.clientCertPEM(ctx, risingwave)
+	if err != nil {
+		return nil, err
+	}
+
+	serverName := metaTLS.ServerName
+	if serverName == "" {
+		serverName = defaultMetaTLSServerName(risingwave)
+	}
+	return newMetaTLSConfig(caPEM, certPEM, keyPEM, serverName, pointer.BoolDeref(metaTLS.VerifyServerName, d.options.VerifyServerName))
+}
+
+// defaultMetaTLSServerName returns the domain of the meta Service. The operator dials the Pod IPs, which aren't
+// expected in the certificate.
+func defaultMetaTLSServerName(risingwave *risingwavev1alpha1.RisingWave) string {
+	return fmt.Sprintf("%s-%s.%s.svc", risingwave.Name, consts.ComponentMeta, risingwave.Namespace)
+}
+
+// newMetaTLSConfig builds the TLS config from the PEM encoded CA bundle and the optional client certificate. When
+// the server name isn't verified, the certificate chain is still verified with the CA bundle.
+func newMetaTLSConfig(caPEM, certPEM, keyPEM []byte, serverName string, verifyServerName bool) (*tls.Config, error) {
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("no valid certificates in ca")
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    roots,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if len(certPEM) > 0 || len(keyPEM) > 0 {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if !verifyServerName {
+		// Skip the default verification, which always checks the server name, and verify the chain only.
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return errors.New("no certificate from meta")
+			}
+			intermediates := x509.NewCertPool()
+			for _, cert := range state.PeerCertificates[1:] {
+				intermediates.AddCert(cert)
+			}
+			_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+				Roots:         roots,
+				Intermediates: intermediates,
+			})
+			return err
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// NewMetaDialer creates a MetaDialer that dials meta with the TLS configuration of the RisingWave and the
+// operator-level options. The RisingWaves without the meta TLS enabled are dialed in plaintext.
+func NewMetaDialer(reader client.Reader, options MetaTLSOptions) MetaDialer {
+	d := &metaDialer{
+		reader:  reader,
+		options: options,
+	}
+	return d.Dial
+}
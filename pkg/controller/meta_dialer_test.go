@@ -0,0 +1,273 @@
+// Copyright 2023 RisingWave Labs
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
+	pb "github.com/risingwavelabs/risingwave-operator/pkg/controller/proto"
+	"github.com/risingwavelabs/risingwave-operator/pkg/testutils"
+)
+
+type testCertificateAuthority struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+}
+
+func newTestCertificateAuthority(t *testing.T) *testCertificateAuthority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCertificateAuthority{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue returns the PEM encoded certificate and private key signed by the CA.
+func (ca *testCertificateAuthority) issue(t *testing.T, dnsNames ...string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "test"},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// newTLSMetaServer starts a meta member server serving TLS on a local port and returns its address. If the client CA
+// is set, the client certificates are required.
+func newTLSMetaServer(t *testing.T, certPEM, keyPEM, clientCAPEM []byte) string {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if clientCAPEM != nil {
+		tlsConfig.ClientCAs = x509.NewCertPool()
+		tlsConfig.ClientCAs.AppendCertsFromPEM(clientCAPEM)
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	pb.RegisterMetaMemberServiceServer(grpcServer, &fakeMetaMemberServer{})
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestMetaDialer(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	serverName := defaultMetaTLSServerName(risingwave)
+
+	ca, otherCA := newTestCertificateAuthority(t), newTestCertificateAuthority(t)
+	serverCert, serverKey := ca.issue(t, serverName)
+	mismatchedServerCert, mismatchedServerKey := ca.issue(t, "meta.example.com")
+	clientCert, clientKey := ca.issue(t)
+
+	addr := newTLSMetaServer(t, serverCert, serverKey, nil)
+	mismatchedAddr := newTLSMetaServer(t, mismatchedServerCert, mismatchedServerKey, nil)
+	mtlsAddr := newTLSMetaServer(t, serverCert, serverKey, ca.certPEM)
+
+	dir := t.TempDir()
+	caFile, certFile, keyFile := filepath.Join(dir, "ca.crt"), filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	for file, data := range map[string][]byte{caFile: ca.certPEM, certFile: clientCert, keyFile: clientKey} {
+		if err := os.WriteFile(file, data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	newSecret := func(name string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: risingwave.Namespace, Name: name},
+			Data:       data,
+		}
+	}
+
+	testcases := map[string]struct {
+		metaTLS *risingwavev1alpha1.RisingWaveMetaTLS
+		options MetaTLSOptions
+		addr    string
+		fail    bool
+	}{
+		"insecure": {
+			addr: addr,
+			fail: true,
+		},
+		"secret": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{SecretName: "meta-tls"},
+			addr:    addr,
+		},
+		"secret-server-name-mismatched": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{SecretName: "meta-tls"},
+			options: MetaTLSOptions{VerifyServerName: true},
+			addr:    mismatchedAddr,
+			fail:    true,
+		},
+		"secret-server-name-overridden": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{SecretName: "meta-tls", ServerName: "meta.example.com"},
+			addr:    mismatchedAddr,
+		},
+		"secret-server-name-not-verified": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{SecretName: "meta-tls", VerifyServerName: pointer.Bool(false)},
+			addr:    mismatchedAddr,
+		},
+		"secret-untrusted-ca": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{SecretName: "other-meta-tls", VerifyServerName: pointer.Bool(false)},
+			addr:    mismatchedAddr,
+			fail:    true,
+		},
+		"secret-without-client-cert": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{SecretName: "meta-tls"},
+			addr:    mtlsAddr,
+			fail:    true,
+		},
+		"secret-with-client-cert": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{SecretName: "meta-tls", ClientCertSecretName: "meta-client-tls"},
+			addr:    mtlsAddr,
+		},
+		"secret-server-name-verification-from-options": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{SecretName: "meta-tls"},
+			options: MetaTLSOptions{VerifyServerName: false},
+			addr:    mismatchedAddr,
+		},
+		"options": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{},
+			options: MetaTLSOptions{CAFile: caFile, VerifyServerName: true},
+			addr:    addr,
+		},
+		"options-server-name-not-verified": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{},
+			options: MetaTLSOptions{CAFile: caFile},
+			addr:    mismatchedAddr,
+		},
+		"options-client-cert": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{SecretName: "meta-tls"},
+			options: MetaTLSOptions{CertFile: certFile, KeyFile: keyFile, VerifyServerName: true},
+			addr:    mtlsAddr,
+		},
+		"options-without-ca": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{},
+			addr:    addr,
+			fail:    true,
+		},
+		"options-not-applied-without-meta-tls": {
+			options: MetaTLSOptions{CAFile: caFile, VerifyServerName: true},
+			addr:    addr,
+			fail:    true,
+		},
+		"secret-preferred-over-options": {
+			metaTLS: &risingwavev1alpha1.RisingWaveMetaTLS{SecretName: "other-meta-tls"},
+			options: MetaTLSOptions{CAFile: caFile, VerifyServerName: true},
+			addr:    addr,
+			fail:    true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			risingwave := risingwave.DeepCopy()
+			risingwave.Spec.MetaTLS = tc.metaTLS
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(testutils.Scheme).
+				WithObjects(
+					newSecret("meta-tls", map[string][]byte{"ca.crt": ca.certPEM, "tls.crt": serverCert, "tls.key": serverKey}),
+					newSecret("other-meta-tls", map[string][]byte{"ca.crt": otherCA.certPEM}),
+					newSecret("meta-client-tls", map[string][]byte{"tls.crt": clientCert, "tls.key": clientKey}),
+				).
+				Build()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			conn, err := NewMetaDialer(fakeClient, tc.options)(ctx, risingwave, tc.addr)
+			if err == nil {
+				defer conn.Close()
+				_, err = pb.NewMetaMemberServiceClient(conn).Members(ctx, &pb.MembersRequest{})
+			}
+			if tc.fail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
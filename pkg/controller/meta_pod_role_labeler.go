@@ -18,63 +18,105 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/samber/lo"
 
 	"github.com/risingwavelabs/ctrlkit"
 
+	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
 	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
 	pb "github.com/risingwavelabs/risingwave-operator/pkg/controller/proto"
 	"github.com/risingwavelabs/risingwave-operator/pkg/factory/envs"
 	"github.com/risingwavelabs/risingwave-operator/pkg/utils"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/connectivity"
 )
 
-// MetaPodRoleLabeler reconciles meta pods object.
-type MetaPodRoleLabeler struct {
-	client.Client
-}
-
-// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;update;patch
+// MetaPodRoleLabeler related constants.
+const (
+	// DefaultMetaPodRoleSyncInterval is the default interval of polling the meta members of each RisingWave.
+	// Besides, the roles are synced when the meta Pods change or the connection to meta breaks.
+	DefaultMetaPodRoleSyncInterval = 2 * time.Second
+	MetaPodRoleRPCTimeout          = 2 * time.Second
+)
 
-// getMetaRole sends a gRPC request to the meta node at host:port to tell its role from the response. The endpoint is used
-// to identify the meta node. If the node isn't found in the response, an unknown will be returned.
-func (mpl *MetaPodRoleLabeler) getMetaRole(ctx context.Context, host string, port uint, endpoint string) (string, error) {
-	addr := fmt.Sprintf("%s:%v", host, port)
-	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return "", fmt.Errorf("unable to connect: %w", err)
-	}
-	defer conn.Close()
+// metaRoleWatcher keeps a connection to one of the meta nodes of a RisingWave, from which the members of all meta
+// nodes are fetched.
+type metaRoleWatcher struct {
+	addr   string
+	conn   *grpc.ClientConn
+	cancel context.CancelFunc
+}
 
-	metaClient := pb.NewMetaMemberServiceClient(conn)
+// members returns the members of meta, with the leader in it.
+func (w *metaRoleWatcher) members(ctx context.Context) ([]*pb.MetaMember, error) {
+	ctx, cancel := context.WithTimeout(ctx, MetaPodRoleRPCTimeout)
+	defer cancel()
 
-	resp, err := metaClient.Members(ctx, &pb.MembersRequest{})
+	resp, err := pb.NewMetaMemberServiceClient(w.conn).Members(ctx, &pb.MembersRequest{})
 	if err != nil {
-		return "", fmt.Errorf("request failed: %w", err)
+		return nil, fmt.Errorf("unable to get members from %s: %w", w.addr, err)
 	}
+	return resp.Members, nil
+}
 
-	for _, member := range resp.Members {
-		if member.Address.Host == endpoint && member.Address.Port == int32(port) {
-			return lo.If(member.IsLeader, consts.MetaRoleLeader).Else(consts.MetaRoleFollower), nil
+// watchConnection calls notify whenever the connection is lost, e.g., when the meta node is gone during a failover.
+// It returns when the context is done.
+func (w *metaRoleWatcher) watchConnection(ctx context.Context, notify func()) {
+	state := w.conn.GetState()
+	for w.conn.WaitForStateChange(ctx, state) {
+		newState := w.conn.GetState()
+		// Ignore the shutdown caused by stop.
+		if state == connectivity.Ready && ctx.Err() == nil {
+			notify()
 		}
+		state = newState
 	}
+}
 
-	logger := log.FromContext(ctx)
-	logger.Info("No role recognized from the current member list!", "members", resp.Members, "address", fmt.Sprintf("%s:%d", host, port), "endpoint", endpoint)
+func (w *metaRoleWatcher) stop() {
+	w.cancel()
+	_ = w.conn.Close()
+}
 
-	return consts.MetaRoleUnknown, nil
+// MetaPodRoleLabeler labels the meta Pods of each RisingWave with their roles. It keeps one connection per RisingWave
+// and relabels all the meta Pods of the RisingWave with the members fetched in one request.
+type MetaPodRoleLabeler struct {
+	client.Client
+	Dial MetaDialer
+
+	// SyncInterval is the interval of polling the meta members. DefaultMetaPodRoleSyncInterval is used if it's
+	// not positive.
+	SyncInterval time.Duration
+
+	mu       sync.Mutex
+	watchers map[types.NamespacedName]*metaRoleWatcher
+	events   chan ctrlevent.GenericEvent
 }
 
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=risingwave.risingwavelabs.com,resources=risingwaves,verbs=get;list;watch
+
 func (mpl *MetaPodRoleLabeler) isRisingWaveMetaPod(pod *corev1.Pod) bool {
 	if pod == nil {
 		return false
@@ -153,128 +195,260 @@ func (mpl *MetaPodRoleLabeler) getEndpointFromEnvVars(pod *corev1.Pod, envVars [
 	return endpoint
 }
 
-func (mpl *MetaPodRoleLabeler) syncRoleLabelForSinglePod(ctx context.Context, pod *corev1.Pod) (string, error) {
-	// Extract information from the Pod.
-	if !mpl.isRisingWaveMetaPod(pod) {
-		return "", errors.New("not a meta pod")
-	}
-	if pod.Status.PodIP == "" {
-		return "", errors.New("not running")
-	}
+func (mpl *MetaPodRoleLabeler) getEndpoint(pod *corev1.Pod) (string, int32, error) {
 	metaContainer := utils.GetContainerFromPod(pod, "meta")
 	if metaContainer == nil {
-		return "", errors.New("meta container not found")
+		return "", 0, errors.New("meta container not found")
 	}
 	svcPort, ok := utils.GetPortFromContainer(metaContainer, consts.PortService)
 	if !ok {
-		return "", errors.New("service port not found")
+		return "", 0, errors.New("service port not found")
 	}
 	endpoint := mpl.getEndpointFromArgs(pod, metaContainer.Args)
 	if len(endpoint) == 0 {
 		if endpoint = mpl.getEndpointFromEnvVars(pod, metaContainer.Env); len(endpoint) == 0 {
-			return "", errors.New("endpoint not found")
+			return "", 0, errors.New("endpoint not found")
 		}
 	}
+	return endpoint, svcPort, nil
+}
 
-	logger := log.FromContext(ctx).WithValues("pod", pod.Name)
+// notify enqueues the RisingWave to sync the role labels. The event is dropped if the queue is full, and the
+// labels will be synced in the next poll.
+func (mpl *MetaPodRoleLabeler) notify(key types.NamespacedName) {
+	select {
+	case mpl.events <- ctrlevent.GenericEvent{Object: &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+	}}:
+	default:
+	}
+}
 
-	// Send a gRPC request and get the current role.
-	role, err := func() (string, error) {
-		ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
-		defer cancel()
-		return mpl.getMetaRole(ctx, pod.Status.PodIP, uint(svcPort), endpoint)
-	}()
+func (mpl *MetaPodRoleLabeler) startWatcher(ctx context.Context, key types.NamespacedName, risingwave *risingwavev1alpha1.RisingWave, addr string) (*metaRoleWatcher, error) {
+	conn, err := mpl.Dial(ctx, risingwave, addr)
 	if err != nil {
-		logger.Info("Failed to get the current role from the meta Pod.", "error", err)
-		// Use an unknown role.
-		role = consts.MetaRoleUnknown
+		return nil, fmt.Errorf("unable to connect to %s: %w", addr, err)
 	}
 
-	// Update the role if it changes.
-	beforeRole := pod.Labels[consts.LabelRisingWaveMetaRole]
-	if beforeRole != role {
-		originalPod := pod.DeepCopy()
-		pod.Labels[consts.LabelRisingWaveMetaRole] = role
-		err := mpl.Patch(ctx, pod, client.StrategicMergeFrom(originalPod))
-		return role, err
+	watchCtx, cancel := context.WithCancel(context.Background())
+	watcher := &metaRoleWatcher{addr: addr, conn: conn, cancel: cancel}
+	go watcher.watchConnection(watchCtx, func() { mpl.notify(key) })
+
+	mpl.mu.Lock()
+	defer mpl.mu.Unlock()
+	if previous, ok := mpl.watchers[key]; ok {
+		previous.stop()
 	}
+	mpl.watchers[key] = watcher
+
+	return watcher, nil
+}
+
+func (mpl *MetaPodRoleLabeler) getWatcher(key types.NamespacedName) *metaRoleWatcher {
+	mpl.mu.Lock()
+	defer mpl.mu.Unlock()
+	return mpl.watchers[key]
+}
 
-	return role, nil
+func (mpl *MetaPodRoleLabeler) stopWatcher(key types.NamespacedName) {
+	mpl.mu.Lock()
+	defer mpl.mu.Unlock()
+	if watcher, ok := mpl.watchers[key]; ok {
+		watcher.stop()
+		delete(mpl.watchers, key)
+	}
 }
 
-func (mpl *MetaPodRoleLabeler) syncRoleLabels(ctx context.Context, pod *corev1.Pod) {
+// getMembers gets the members of meta with the existing connection. If it fails, it connects to the meta Pods one by
+// one, starting from the last known leader, until the members are returned. The RisingWave is nil if it's not found.
+func (mpl *MetaPodRoleLabeler) getMembers(ctx context.Context, key types.NamespacedName, risingwave *risingwavev1alpha1.RisingWave, pods []corev1.Pod) ([]*pb.MetaMember, error) {
 	logger := log.FromContext(ctx)
 
-	beforeRole := pod.Labels[consts.LabelRisingWaveMetaRole]
-	role, err := mpl.syncRoleLabelForSinglePod(ctx, pod)
-	if err != nil {
-		logger.Info("Failed to sync the meta role label.", "pod", pod.Name, "error", err)
-		return
+	var failedAddr string
+	if watcher := mpl.getWatcher(key); watcher != nil {
+		members, err := watcher.members(ctx)
+		if err == nil {
+			return members, nil
+		}
+		logger.Info("Failed to get the members of meta, reconnecting.", "error", err)
+		failedAddr = watcher.addr
+		mpl.stopWatcher(key)
 	}
 
-	if beforeRole != consts.MetaRoleLeader && role == consts.MetaRoleLeader {
-		risingwaveName := pod.Labels[consts.LabelRisingWaveName]
-		currentPod := pod.Name
+	sort.SliceStable(pods, func(i, j int) bool {
+		return pods[i].Labels[consts.LabelRisingWaveMetaRole] == consts.MetaRoleLeader &&
+			pods[j].Labels[consts.LabelRisingWaveMetaRole] != consts.MetaRoleLeader
+	})
 
-		var leaderPodList corev1.PodList
-		err := mpl.List(ctx, &leaderPodList, client.InNamespace(pod.Namespace), client.MatchingLabels{
-			consts.LabelRisingWaveName:      risingwaveName,
-			consts.LabelRisingWaveComponent: consts.ComponentMeta,
-			consts.LabelRisingWaveMetaRole:  consts.MetaRoleLeader,
-		})
+	var errs []error
+	for _, pod := range pods {
+		_, svcPort, err := mpl.getEndpoint(&pod)
 		if err != nil {
-			logger.Info("Failed to list leader Pods.", "pod", pod.Name, "error", err)
+			continue
 		}
+		addr := fmt.Sprintf("%s:%d", pod.Status.PodIP, svcPort)
+		if addr == failedAddr {
+			continue
+		}
+
+		watcher, err := mpl.startWatcher(ctx, key, risingwave, addr)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		members, err := watcher.members(ctx)
+		if err == nil {
+			return members, nil
+		}
+		errs = append(errs, err)
+		mpl.stopWatcher(key)
+	}
+
+	if len(errs) == 0 {
+		return nil, errors.New("no meta pod to connect")
+	}
+	return nil, utilerrors.NewAggregate(errs)
+}
+
+// getMetaRole tells the role of the meta Pod from the members. The endpoint is used to identify the meta node. If the
+// node isn't found in the members, an unknown will be returned.
+func (mpl *MetaPodRoleLabeler) getMetaRole(members []*pb.MetaMember, endpoint string, port int32) string {
+	for _, member := range members {
+		if member.Address.Host == endpoint && member.Address.Port == port {
+			return lo.If(member.IsLeader, consts.MetaRoleLeader).Else(consts.MetaRoleFollower)
+		}
+	}
+	return consts.MetaRoleUnknown
+}
+
+// syncRoleLabels relabels all the meta Pods with the members in one pass. The Pods that are no longer the leader are
+// relabeled first, so that there's at most one Pod labeled as the leader at any time.
+func (mpl *MetaPodRoleLabeler) syncRoleLabels(ctx context.Context, pods []corev1.Pod, members []*pb.MetaMember) {
+	logger := log.FromContext(ctx)
+
+	roles := make(map[string]string, len(pods))
+	for _, pod := range pods {
+		endpoint, svcPort, err := mpl.getEndpoint(&pod)
+		if err != nil {
+			logger.Info("Failed to sync the meta role label.", "pod", pod.Name, "error", err)
+			continue
+		}
+		role := mpl.getMetaRole(members, endpoint, svcPort)
+		if role == consts.MetaRoleUnknown && members != nil {
+			logger.Info("No role recognized from the current member list!", "members", members, "pod", pod.Name, "endpoint", endpoint)
+		}
+		roles[pod.Name] = role
+	}
 
-		leaderPods := lo.Filter(leaderPodList.Items, func(item corev1.Pod, _ int) bool {
-			return item.Name != currentPod
-		})
+	sort.SliceStable(pods, func(i, j int) bool {
+		return roles[pods[i].Name] != consts.MetaRoleLeader && roles[pods[j].Name] == consts.MetaRoleLeader
+	})
 
-		// Do our best.
-		for _, pod := range leaderPods {
-			if _, err := mpl.syncRoleLabelForSinglePod(ctx, &pod); err != nil {
-				logger.Info("Failed to sync the meta role label.", "pod", pod.Name, "error", err)
-			}
+	// Do our best.
+	for _, pod := range pods {
+		role, ok := roles[pod.Name]
+		if !ok || pod.Labels[consts.LabelRisingWaveMetaRole] == role {
+			continue
+		}
+
+		originalPod := pod.DeepCopy()
+		pod.Labels[consts.LabelRisingWaveMetaRole] = role
+		if err := mpl.Patch(ctx, &pod, client.StrategicMergeFrom(originalPod)); err != nil {
+			logger.Info("Failed to sync the meta role label.", "pod", pod.Name, "error", err)
 		}
 	}
 }
 
-// Reconcile handles the pods of the meta service. Will add the metaLeaderLabel to the pods.
+// Reconcile handles the meta pods of a RisingWave. Will add the metaLeaderLabel to the pods.
 func (mpl *MetaPodRoleLabeler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, e error) {
-	var pod corev1.Pod
-	if err := mpl.Get(ctx, req.NamespacedName, &pod); err != nil {
-		return ctrlkit.RequeueIfError(client.IgnoreNotFound(err))
+	var podList corev1.PodList
+	if err := mpl.List(ctx, &podList, client.InNamespace(req.Namespace), client.MatchingLabels{
+		consts.LabelRisingWaveName:      req.Name,
+		consts.LabelRisingWaveComponent: consts.ComponentMeta,
+	}); err != nil {
+		return ctrlkit.RequeueIfErrorAndWrap("unable to list meta pods", err)
 	}
 
-	// If Pod is deleted or not running, then no need to sync.
-	if utils.IsDeleted(&pod) || !utils.IsPodRunning(&pod) {
+	// Ignore the deleted, non-meta or non-running Pods.
+	pods := lo.Filter(podList.Items, func(pod corev1.Pod, _ int) bool {
+		return !utils.IsDeleted(&pod) && utils.IsPodRunning(&pod) && pod.Status.PodIP != "" && mpl.isRisingWaveMetaPod(&pod)
+	})
+
+	// Close the connection if there's no meta Pod running, e.g., the RisingWave is deleted or stopped.
+	if len(pods) == 0 {
+		mpl.stopWatcher(req.NamespacedName)
 		return ctrlkit.NoRequeue()
 	}
 
-	// Ignore non-meta or non-running Pods.
-	if pod.Status.PodIP == "" || !mpl.isRisingWaveMetaPod(&pod) {
-		return ctrlkit.NoRequeue()
+	// The RisingWave tells how to connect to meta. The operator-level options apply if it's not found.
+	risingwave := &risingwavev1alpha1.RisingWave{}
+	if err := mpl.Get(ctx, req.NamespacedName, risingwave); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrlkit.RequeueIfErrorAndWrap("unable to get risingwave", err)
+		}
+		risingwave = nil
+	}
+
+	members, err := mpl.getMembers(ctx, req.NamespacedName, risingwave, pods)
+	if err != nil {
+		log.FromContext(ctx).Info("Failed to get the members of meta.", "error", err)
+		// Use an unknown role.
+		members = nil
 	}
 
-	// Sync the label for the current Pod. If the current Pod is the new leader, then
-	// aggressively sync the labels for all leader Pods.
-	mpl.syncRoleLabels(ctx, &pod)
+	mpl.syncRoleLabels(ctx, pods, members)
+
+	// Poll periodically in case the changes aren't notified.
+	return ctrlkit.RequeueAfter(mpl.syncInterval())
+}
 
-	// Sync every 2 seconds.
-	return ctrlkit.RequeueAfter(2 * time.Second)
+func (mpl *MetaPodRoleLabeler) syncInterval() time.Duration {
+	if mpl.SyncInterval > 0 {
+		return mpl.SyncInterval
+	}
+	return DefaultMetaPodRoleSyncInterval
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (mpl *MetaPodRoleLabeler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("meta-pod-role-labeler").
-		For(&corev1.Pod{}).
+		// Sync the RisingWave when its meta Pods are created, deleted, or started with new addresses.
+		Watches(
+			&corev1.Pod{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+				return []reconcile.Request{
+					{
+						NamespacedName: types.NamespacedName{
+							Namespace: obj.GetNamespace(),
+							Name:      obj.GetLabels()[consts.LabelRisingWaveName],
+						},
+					},
+				}
+			}),
+			builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetLabels()[consts.LabelRisingWaveComponent] == consts.ComponentMeta &&
+					obj.GetLabels()[consts.LabelRisingWaveName] != ""
+			}), predicate.Funcs{
+				UpdateFunc: func(e ctrlevent.UpdateEvent) bool {
+					oldPod, newPod := e.ObjectOld.(*corev1.Pod), e.ObjectNew.(*corev1.Pod)
+					return oldPod.Status.PodIP != newPod.Status.PodIP || oldPod.Status.Phase != newPod.Status.Phase ||
+						utils.IsDeleted(oldPod) != utils.IsDeleted(newPod)
+				},
+			}),
+		).
+		// Sync the RisingWave when the connection to meta is lost.
+		WatchesRawSource(&source.Channel{Source: mpl.events}, &handler.EnqueueRequestForObject{}).
 		Complete(mpl)
 }
 
 // NewMetaPodRoleLabeler creates a new MetaPodRoleLabeler.
-func NewMetaPodRoleLabeler(client client.Client) *MetaPodRoleLabeler {
+func NewMetaPodRoleLabeler(client client.Client, dial MetaDialer, syncInterval time.Duration) *MetaPodRoleLabeler {
 	return &MetaPodRoleLabeler{
-		Client: client,
+		Client:       client,
+		Dial:         dial,
+		SyncInterval: syncInterval,
+		watchers:     make(map[types.NamespacedName]*metaRoleWatcher),
+		events:       make(chan ctrlevent.GenericEvent, 1024),
 	}
 }
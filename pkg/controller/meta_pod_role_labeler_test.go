@@ -0,0 +1,181 @@
+// Copyright 2023 RisingWave Labs
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
+	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+	pb "github.com/risingwavelabs/risingwave-operator/pkg/controller/proto"
+	"github.com/risingwavelabs/risingwave-operator/pkg/testutils"
+)
+
+type fakeMetaMemberServer struct {
+	pb.UnimplementedMetaMemberServiceServer
+
+	mu       sync.Mutex
+	leader   string
+	hosts    []string
+	requests int
+}
+
+func (s *fakeMetaMemberServer) Members(context.Context, *pb.MembersRequest) (*pb.MembersResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	var members []*pb.MetaMember
+	for _, host := range s.hosts {
+		members = append(members, &pb.MetaMember{
+			Address:  &pb.HostAddress{Host: host, Port: consts.MetaServicePort},
+			IsLeader: host == s.leader,
+		})
+	}
+	return &pb.MembersResponse{Members: members}, nil
+}
+
+func (s *fakeMetaMemberServer) setLeader(leader string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.leader = leader
+}
+
+func newFakeMetaPod(namespace, risingwaveName string, ordinal int, role string) *corev1.Pod {
+	podIP := fmt.Sprintf("10.0.0.%d", ordinal)
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-meta-%d", risingwaveName, ordinal),
+			Namespace: namespace,
+			Labels: map[string]string{
+				consts.LabelRisingWaveName:      risingwaveName,
+				consts.LabelRisingWaveComponent: consts.ComponentMeta,
+				consts.LabelRisingWaveMetaRole:  role,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "meta",
+					Args: []string{"--advertise-addr", "$(POD_IP):5690"},
+					Ports: []corev1.ContainerPort{
+						{Name: consts.PortService, ContainerPort: consts.MetaServicePort},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			PodIP: podIP,
+		},
+	}
+}
+
+func TestMetaPodRoleLabeler_Reconcile(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	pods := []client.Object{
+		newFakeMetaPod(risingwave.Namespace, risingwave.Name, 0, consts.MetaRoleLeader),
+		newFakeMetaPod(risingwave.Namespace, risingwave.Name, 1, consts.MetaRoleFollower),
+		newFakeMetaPod(risingwave.Namespace, risingwave.Name, 2, ""),
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(testutils.Scheme).
+		WithObjects(append(pods, risingwave)...).
+		Build()
+
+	server := &fakeMetaMemberServer{leader: "10.0.0.1", hosts: []string{"10.0.0.0", "10.0.0.1"}}
+	dialer := newInMemoryMetaDialer(t, func(grpcServer *grpc.Server) {
+		pb.RegisterMetaMemberServiceServer(grpcServer, server)
+	})
+	var dials []string
+	var dialedRisingWave *risingwavev1alpha1.RisingWave
+	labeler := NewMetaPodRoleLabeler(fakeClient, func(ctx context.Context, risingwave *risingwavev1alpha1.RisingWave, addr string) (*grpc.ClientConn, error) {
+		dials = append(dials, addr)
+		dialedRisingWave = risingwave
+		return dialer(ctx, risingwave, addr)
+	}, 0)
+	t.Cleanup(func() { labeler.stopWatcher(client.ObjectKeyFromObject(risingwave)) })
+
+	assertRoles := func(expected map[string]string) {
+		for name, role := range expected {
+			var pod corev1.Pod
+			if err := fakeClient.Get(context.Background(), types.NamespacedName{Namespace: risingwave.Namespace, Name: name}, &pod); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, role, pod.Labels[consts.LabelRisingWaveMetaRole], "role of "+name)
+		}
+	}
+	reconcileOnce := func() {
+		result, err := labeler.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(risingwave)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, DefaultMetaPodRoleSyncInterval, result.RequeueAfter)
+	}
+
+	// All meta Pods are relabeled with one request.
+	reconcileOnce()
+	assertRoles(map[string]string{
+		risingwave.Name + "-meta-0": consts.MetaRoleFollower,
+		risingwave.Name + "-meta-1": consts.MetaRoleLeader,
+		risingwave.Name + "-meta-2": consts.MetaRoleUnknown,
+	})
+	assert.Equal(t, 1, server.requests)
+	assert.Equal(t, []string{"10.0.0.0:5690"}, dials, "should connect to the last known leader")
+	if assert.NotNil(t, dialedRisingWave, "should dial with the risingwave") {
+		assert.Equal(t, risingwave.Name, dialedRisingWave.Name)
+	}
+
+	// The connection is reused.
+	server.setLeader("10.0.0.0")
+	reconcileOnce()
+	assertRoles(map[string]string{
+		risingwave.Name + "-meta-0": consts.MetaRoleLeader,
+		risingwave.Name + "-meta-1": consts.MetaRoleFollower,
+	})
+	assert.Equal(t, 2, server.requests)
+	assert.Len(t, dials, 1)
+
+	// The connection is closed when there's no meta Pod.
+	for _, pod := range pods {
+		if err := fakeClient.Delete(context.Background(), pod); err != nil {
+			t.Fatal(err)
+		}
+	}
+	result, err := labeler.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(risingwave)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Zero(t, result.RequeueAfter)
+	assert.Nil(t, labeler.getWatcher(client.ObjectKeyFromObject(risingwave)))
+}
+
+func TestMetaPodRoleLabeler_SyncInterval(t *testing.T) {
+	assert.Equal(t, DefaultMetaPodRoleSyncInterval, (&MetaPodRoleLabeler{}).syncInterval())
+	assert.Equal(t, 500*time.Millisecond, (&MetaPodRoleLabeler{SyncInterval: 500 * time.Millisecond}).syncInterval())
+}
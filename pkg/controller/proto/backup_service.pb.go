@@ -0,0 +1,571 @@
+// Copyright 2023 RisingWave Labs
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: backup_service.proto
+
+package proto
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BackupJobStatus int32
+
+const (
+	BackupJobStatus_UNSPECIFIED BackupJobStatus = 0
+	BackupJobStatus_RUNNING     BackupJobStatus = 1
+	BackupJobStatus_SUCCEEDED   BackupJobStatus = 2
+	BackupJobStatus_NOT_FOUND   BackupJobStatus = 3
+	BackupJobStatus_FAILED      BackupJobStatus = 4
+)
+
+// Enum value maps for BackupJobStatus.
+var (
+	BackupJobStatus_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "NOT_FOUND",
+		4: "FAILED",
+	}
+	BackupJobStatus_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"RUNNING":     1,
+		"SUCCEEDED":   2,
+		"NOT_FOUND":   3,
+		"FAILED":      4,
+	}
+)
+
+func (x BackupJobStatus) Enum() *BackupJobStatus {
+	p := new(BackupJobStatus)
+	*p = x
+	return p
+}
+
+func (x BackupJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackupJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_backup_service_proto_enumTypes[0].Descriptor()
+}
+
+func (BackupJobStatus) Type() protoreflect.EnumType {
+	return &file_backup_service_proto_enumTypes[0]
+}
+
+func (x BackupJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackupJobStatus.Descriptor instead.
+func (BackupJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_backup_service_proto_rawDescGZIP(), []int{0}
+}
+
+type BackupMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BackupMetaRequest) Reset() {
+	*x = BackupMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backup_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupMetaRequest) ProtoMessage() {}
+
+func (x *BackupMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupMetaRequest.ProtoReflect.Descriptor instead.
+func (*BackupMetaRequest) Descriptor() ([]byte, []int) {
+	return file_backup_service_proto_rawDescGZIP(), []int{0}
+}
+
+type BackupMetaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId uint64 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *BackupMetaResponse) Reset() {
+	*x = BackupMetaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backup_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupMetaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupMetaResponse) ProtoMessage() {}
+
+func (x *BackupMetaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupMetaResponse.ProtoReflect.Descriptor instead.
+func (*BackupMetaResponse) Descriptor() ([]byte, []int) {
+	return file_backup_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BackupMetaResponse) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type GetBackupJobStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId uint64 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *GetBackupJobStatusRequest) Reset() {
+	*x = GetBackupJobStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backup_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBackupJobStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBackupJobStatusRequest) ProtoMessage() {}
+
+func (x *GetBackupJobStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBackupJobStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBackupJobStatusRequest) Descriptor() ([]byte, []int) {
+	return file_backup_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetBackupJobStatusRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type GetBackupJobStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId     uint64          `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	JobStatus BackupJobStatus `protobuf:"varint,2,opt,name=job_status,json=jobStatus,proto3,enum=backup_service.BackupJobStatus" json:"job_status,omitempty"`
+	Message   string          `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GetBackupJobStatusResponse) Reset() {
+	*x = GetBackupJobStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backup_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBackupJobStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBackupJobStatusResponse) ProtoMessage() {}
+
+func (x *GetBackupJobStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBackupJobStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetBackupJobStatusResponse) Descriptor() ([]byte, []int) {
+	return file_backup_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBackupJobStatusResponse) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *GetBackupJobStatusResponse) GetJobStatus() BackupJobStatus {
+	if x != nil {
+		return x.JobStatus
+	}
+	return BackupJobStatus_UNSPECIFIED
+}
+
+func (x *GetBackupJobStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteMetaSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SnapshotIds []uint64 `protobuf:"varint,1,rep,packed,name=snapshot_ids,json=snapshotIds,proto3" json:"snapshot_ids,omitempty"`
+}
+
+func (x *DeleteMetaSnapshotRequest) Reset() {
+	*x = DeleteMetaSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backup_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMetaSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMetaSnapshotRequest) ProtoMessage() {}
+
+func (x *DeleteMetaSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMetaSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMetaSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_backup_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteMetaSnapshotRequest) GetSnapshotIds() []uint64 {
+	if x != nil {
+		return x.SnapshotIds
+	}
+	return nil
+}
+
+type DeleteMetaSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteMetaSnapshotResponse) Reset() {
+	*x = DeleteMetaSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backup_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMetaSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMetaSnapshotResponse) ProtoMessage() {}
+
+func (x *DeleteMetaSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMetaSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMetaSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_backup_service_proto_rawDescGZIP(), []int{5}
+}
+
+var File_backup_service_proto protoreflect.FileDescriptor
+
+var file_backup_service_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x12, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x8d, 0x01, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a,
+	0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0a, 0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x6a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x19,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x73, 0x22, 0x1c, 0x0a, 0x1a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x59, 0x0a, 0x0f, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0f, 0x0a,
+	0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53,
+	0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0xbe, 0x02, 0x0a, 0x0d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x21, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x29, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4a, 0x6f, 0x62,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x29, 0x2e, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x51, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x69,
+	0x73, 0x69, 0x6e, 0x67, 0x77, 0x61, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x01,
+	0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x77, 0x61, 0x76, 0x65, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x72, 0x69, 0x73, 0x69,
+	0x6e, 0x67, 0x77, 0x61, 0x76, 0x65, 0x2d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_backup_service_proto_rawDescOnce sync.Once
+	file_backup_service_proto_rawDescData = file_backup_service_proto_rawDesc
+)
+
+func file_backup_service_proto_rawDescGZIP() []byte {
+	file_backup_service_proto_rawDescOnce.Do(func() {
+		file_backup_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_backup_service_proto_rawDescData)
+	})
+	return file_backup_service_proto_rawDescData
+}
+
+var file_backup_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_backup_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_backup_service_proto_goTypes = []interface{}{
+	(BackupJobStatus)(0),               // 0: backup_service.BackupJobStatus
+	(*BackupMetaRequest)(nil),          // 1: backup_service.BackupMetaRequest
+	(*BackupMetaResponse)(nil),         // 2: backup_service.BackupMetaResponse
+	(*GetBackupJobStatusRequest)(nil),  // 3: backup_service.GetBackupJobStatusRequest
+	(*GetBackupJobStatusResponse)(nil), // 4: backup_service.GetBackupJobStatusResponse
+	(*DeleteMetaSnapshotRequest)(nil),  // 5: backup_service.DeleteMetaSnapshotRequest
+	(*DeleteMetaSnapshotResponse)(nil), // 6: backup_service.DeleteMetaSnapshotResponse
+}
+var file_backup_service_proto_depIdxs = []int32{
+	0, // 0: backup_service.GetBackupJobStatusResponse.job_status:type_name -> backup_service.BackupJobStatus
+	1, // 1: backup_service.BackupService.BackupMeta:input_type -> backup_service.BackupMetaRequest
+	3, // 2: backup_service.BackupService.GetBackupJobStatus:input_type -> backup_service.GetBackupJobStatusRequest
+	5, // 3: backup_service.BackupService.DeleteMetaSnapshot:input_type -> backup_service.DeleteMetaSnapshotRequest
+	2, // 4: backup_service.BackupService.BackupMeta:output_type -> backup_service.BackupMetaResponse
+	4, // 5: backup_service.BackupService.GetBackupJobStatus:output_type -> backup_service.GetBackupJobStatusResponse
+	6, // 6: backup_service.BackupService.DeleteMetaSnapshot:output_type -> backup_service.DeleteMetaSnapshotResponse
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_backup_service_proto_init() }
+func file_backup_service_proto_init() {
+	if File_backup_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_backup_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupMetaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backup_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupMetaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backup_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBackupJobStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backup_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBackupJobStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backup_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMetaSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_backup_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMetaSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_backup_service_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backup_service_proto_goTypes,
+		DependencyIndexes: file_backup_service_proto_depIdxs,
+		EnumInfos:         file_backup_service_proto_enumTypes,
+		MessageInfos:      file_backup_service_proto_msgTypes,
+	}.Build()
+	File_backup_service_proto = out.File
+	file_backup_service_proto_rawDesc = nil
+	file_backup_service_proto_goTypes = nil
+	file_backup_service_proto_depIdxs = nil
+}
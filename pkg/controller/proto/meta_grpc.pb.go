@@ -118,3 +118,405 @@ var MetaMemberService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "meta.proto",
 }
+
+// SystemParamsServiceClient is the client API for SystemParamsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SystemParamsServiceClient interface {
+	GetSystemParams(ctx context.Context, in *GetSystemParamsRequest, opts ...grpc.CallOption) (*GetSystemParamsResponse, error)
+}
+
+type systemParamsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSystemParamsServiceClient(cc grpc.ClientConnInterface) SystemParamsServiceClient {
+	return &systemParamsServiceClient{cc}
+}
+
+func (c *systemParamsServiceClient) GetSystemParams(ctx context.Context, in *GetSystemParamsRequest, opts ...grpc.CallOption) (*GetSystemParamsResponse, error) {
+	out := new(GetSystemParamsResponse)
+	err := c.cc.Invoke(ctx, "/meta.SystemParamsService/GetSystemParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SystemParamsServiceServer is the server API for SystemParamsService service.
+// All implementations must embed UnimplementedSystemParamsServiceServer
+// for forward compatibility
+type SystemParamsServiceServer interface {
+	GetSystemParams(context.Context, *GetSystemParamsRequest) (*GetSystemParamsResponse, error)
+	mustEmbedUnimplementedSystemParamsServiceServer()
+}
+
+// UnimplementedSystemParamsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSystemParamsServiceServer struct {
+}
+
+func (UnimplementedSystemParamsServiceServer) GetSystemParams(context.Context, *GetSystemParamsRequest) (*GetSystemParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemParams not implemented")
+}
+func (UnimplementedSystemParamsServiceServer) mustEmbedUnimplementedSystemParamsServiceServer() {}
+
+// UnsafeSystemParamsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SystemParamsServiceServer will
+// result in compilation errors.
+type UnsafeSystemParamsServiceServer interface {
+	mustEmbedUnimplementedSystemParamsServiceServer()
+}
+
+func RegisterSystemParamsServiceServer(s grpc.ServiceRegistrar, srv SystemParamsServiceServer) {
+	s.RegisterService(&SystemParamsService_ServiceDesc, srv)
+}
+
+func _SystemParamsService_GetSystemParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemParamsServiceServer).GetSystemParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta.SystemParamsService/GetSystemParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemParamsServiceServer).GetSystemParams(ctx, req.(*GetSystemParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SystemParamsService_ServiceDesc is the grpc.ServiceDesc for SystemParamsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SystemParamsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "meta.SystemParamsService",
+	HandlerType: (*SystemParamsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSystemParams",
+			Handler:    _SystemParamsService_GetSystemParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "meta.proto",
+}
+
+// ClusterServiceClient is the client API for ClusterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClusterServiceClient interface {
+	DeleteWorkerNode(ctx context.Context, in *DeleteWorkerNodeRequest, opts ...grpc.CallOption) (*DeleteWorkerNodeResponse, error)
+	UpdateWorkerNodeSchedulability(ctx context.Context, in *UpdateWorkerNodeSchedulabilityRequest, opts ...grpc.CallOption) (*UpdateWorkerNodeSchedulabilityResponse, error)
+	ListAllNodes(ctx context.Context, in *ListAllNodesRequest, opts ...grpc.CallOption) (*ListAllNodesResponse, error)
+}
+
+type clusterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClusterServiceClient(cc grpc.ClientConnInterface) ClusterServiceClient {
+	return &clusterServiceClient{cc}
+}
+
+func (c *clusterServiceClient) DeleteWorkerNode(ctx context.Context, in *DeleteWorkerNodeRequest, opts ...grpc.CallOption) (*DeleteWorkerNodeResponse, error) {
+	out := new(DeleteWorkerNodeResponse)
+	err := c.cc.Invoke(ctx, "/meta.ClusterService/DeleteWorkerNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) UpdateWorkerNodeSchedulability(ctx context.Context, in *UpdateWorkerNodeSchedulabilityRequest, opts ...grpc.CallOption) (*UpdateWorkerNodeSchedulabilityResponse, error) {
+	out := new(UpdateWorkerNodeSchedulabilityResponse)
+	err := c.cc.Invoke(ctx, "/meta.ClusterService/UpdateWorkerNodeSchedulability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ListAllNodes(ctx context.Context, in *ListAllNodesRequest, opts ...grpc.CallOption) (*ListAllNodesResponse, error) {
+	out := new(ListAllNodesResponse)
+	err := c.cc.Invoke(ctx, "/meta.ClusterService/ListAllNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClusterServiceServer is the server API for ClusterService service.
+// All implementations must embed UnimplementedClusterServiceServer
+// for forward compatibility
+type ClusterServiceServer interface {
+	DeleteWorkerNode(context.Context, *DeleteWorkerNodeRequest) (*DeleteWorkerNodeResponse, error)
+	UpdateWorkerNodeSchedulability(context.Context, *UpdateWorkerNodeSchedulabilityRequest) (*UpdateWorkerNodeSchedulabilityResponse, error)
+	ListAllNodes(context.Context, *ListAllNodesRequest) (*ListAllNodesResponse, error)
+	mustEmbedUnimplementedClusterServiceServer()
+}
+
+// UnimplementedClusterServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedClusterServiceServer struct {
+}
+
+func (UnimplementedClusterServiceServer) DeleteWorkerNode(context.Context, *DeleteWorkerNodeRequest) (*DeleteWorkerNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkerNode not implemented")
+}
+func (UnimplementedClusterServiceServer) UpdateWorkerNodeSchedulability(context.Context, *UpdateWorkerNodeSchedulabilityRequest) (*UpdateWorkerNodeSchedulabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerNodeSchedulability not implemented")
+}
+func (UnimplementedClusterServiceServer) ListAllNodes(context.Context, *ListAllNodesRequest) (*ListAllNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllNodes not implemented")
+}
+func (UnimplementedClusterServiceServer) mustEmbedUnimplementedClusterServiceServer() {}
+
+// UnsafeClusterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClusterServiceServer will
+// result in compilation errors.
+type UnsafeClusterServiceServer interface {
+	mustEmbedUnimplementedClusterServiceServer()
+}
+
+func RegisterClusterServiceServer(s grpc.ServiceRegistrar, srv ClusterServiceServer) {
+	s.RegisterService(&ClusterService_ServiceDesc, srv)
+}
+
+func _ClusterService_DeleteWorkerNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkerNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).DeleteWorkerNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta.ClusterService/DeleteWorkerNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).DeleteWorkerNode(ctx, req.(*DeleteWorkerNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_UpdateWorkerNodeSchedulability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerNodeSchedulabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).UpdateWorkerNodeSchedulability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta.ClusterService/UpdateWorkerNodeSchedulability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).UpdateWorkerNodeSchedulability(ctx, req.(*UpdateWorkerNodeSchedulabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ListAllNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ListAllNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta.ClusterService/ListAllNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ListAllNodes(ctx, req.(*ListAllNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClusterService_ServiceDesc is the grpc.ServiceDesc for ClusterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClusterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "meta.ClusterService",
+	HandlerType: (*ClusterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteWorkerNode",
+			Handler:    _ClusterService_DeleteWorkerNode_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerNodeSchedulability",
+			Handler:    _ClusterService_UpdateWorkerNodeSchedulability_Handler,
+		},
+		{
+			MethodName: "ListAllNodes",
+			Handler:    _ClusterService_ListAllNodes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "meta.proto",
+}
+
+// ScaleServiceClient is the client API for ScaleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScaleServiceClient interface {
+	GetClusterInfo(ctx context.Context, in *GetClusterInfoRequest, opts ...grpc.CallOption) (*GetClusterInfoResponse, error)
+	GetReschedulePlan(ctx context.Context, in *GetReschedulePlanRequest, opts ...grpc.CallOption) (*GetReschedulePlanResponse, error)
+	Reschedule(ctx context.Context, in *RescheduleRequest, opts ...grpc.CallOption) (*RescheduleResponse, error)
+}
+
+type scaleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScaleServiceClient(cc grpc.ClientConnInterface) ScaleServiceClient {
+	return &scaleServiceClient{cc}
+}
+
+func (c *scaleServiceClient) GetClusterInfo(ctx context.Context, in *GetClusterInfoRequest, opts ...grpc.CallOption) (*GetClusterInfoResponse, error) {
+	out := new(GetClusterInfoResponse)
+	err := c.cc.Invoke(ctx, "/meta.ScaleService/GetClusterInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scaleServiceClient) GetReschedulePlan(ctx context.Context, in *GetReschedulePlanRequest, opts ...grpc.CallOption) (*GetReschedulePlanResponse, error) {
+	out := new(GetReschedulePlanResponse)
+	err := c.cc.Invoke(ctx, "/meta.ScaleService/GetReschedulePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scaleServiceClient) Reschedule(ctx context.Context, in *RescheduleRequest, opts ...grpc.CallOption) (*RescheduleResponse, error) {
+	out := new(RescheduleResponse)
+	err := c.cc.Invoke(ctx, "/meta.ScaleService/Reschedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScaleServiceServer is the server API for ScaleService service.
+// All implementations must embed UnimplementedScaleServiceServer
+// for forward compatibility
+type ScaleServiceServer interface {
+	GetClusterInfo(context.Context, *GetClusterInfoRequest) (*GetClusterInfoResponse, error)
+	GetReschedulePlan(context.Context, *GetReschedulePlanRequest) (*GetReschedulePlanResponse, error)
+	Reschedule(context.Context, *RescheduleRequest) (*RescheduleResponse, error)
+	mustEmbedUnimplementedScaleServiceServer()
+}
+
+// UnimplementedScaleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedScaleServiceServer struct {
+}
+
+func (UnimplementedScaleServiceServer) GetClusterInfo(context.Context, *GetClusterInfoRequest) (*GetClusterInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterInfo not implemented")
+}
+func (UnimplementedScaleServiceServer) GetReschedulePlan(context.Context, *GetReschedulePlanRequest) (*GetReschedulePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReschedulePlan not implemented")
+}
+func (UnimplementedScaleServiceServer) Reschedule(context.Context, *RescheduleRequest) (*RescheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reschedule not implemented")
+}
+func (UnimplementedScaleServiceServer) mustEmbedUnimplementedScaleServiceServer() {}
+
+// UnsafeScaleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScaleServiceServer will
+// result in compilation errors.
+type UnsafeScaleServiceServer interface {
+	mustEmbedUnimplementedScaleServiceServer()
+}
+
+func RegisterScaleServiceServer(s grpc.ServiceRegistrar, srv ScaleServiceServer) {
+	s.RegisterService(&ScaleService_ServiceDesc, srv)
+}
+
+func _ScaleService_GetClusterInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScaleServiceServer).GetClusterInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta.ScaleService/GetClusterInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScaleServiceServer).GetClusterInfo(ctx, req.(*GetClusterInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScaleService_GetReschedulePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReschedulePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScaleServiceServer).GetReschedulePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta.ScaleService/GetReschedulePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScaleServiceServer).GetReschedulePlan(ctx, req.(*GetReschedulePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScaleService_Reschedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScaleServiceServer).Reschedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meta.ScaleService/Reschedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScaleServiceServer).Reschedule(ctx, req.(*RescheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScaleService_ServiceDesc is the grpc.ServiceDesc for ScaleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScaleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "meta.ScaleService",
+	HandlerType: (*ScaleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetClusterInfo",
+			Handler:    _ScaleService_GetClusterInfo_Handler,
+		},
+		{
+			MethodName: "GetReschedulePlan",
+			Handler:    _ScaleService_GetReschedulePlan_Handler,
+		},
+		{
+			MethodName: "Reschedule",
+			Handler:    _ScaleService_Reschedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "meta.proto",
+}
@@ -0,0 +1,294 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/risingwavelabs/ctrlkit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
+	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+	pb "github.com/risingwavelabs/risingwave-operator/pkg/controller/proto"
+	"github.com/risingwavelabs/risingwave-operator/pkg/metrics"
+	"github.com/risingwavelabs/risingwave-operator/pkg/utils"
+)
+
+// RisingWaveBackup controller related constants.
+const (
+	RisingWaveBackupPollInterval = 5 * time.Second
+	RisingWaveBackupRPCTimeout   = 5 * time.Second
+)
+
+var errMetaLeaderNotFound = errors.New("meta leader not found")
+
+// getMetaLeaderAddr returns the address of the meta leader of the given RisingWave. It relies on the role labels
+// maintained by the MetaPodRoleLabeler, and returns errMetaLeaderNotFound when there's no running leader Pod.
+func getMetaLeaderAddr(ctx context.Context, reader client.Reader, namespace, risingwaveName string) (string, error) {
+	var metaPodList corev1.PodList
+	err := reader.List(ctx, &metaPodList, client.InNamespace(namespace), client.MatchingLabels{
+		consts.LabelRisingWaveName:      risingwaveName,
+		consts.LabelRisingWaveComponent: consts.ComponentMeta,
+		consts.LabelRisingWaveMetaRole:  consts.MetaRoleLeader,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to list meta pods: %w", err)
+	}
+
+	for _, pod := range metaPodList.Items {
+		if utils.IsDeleted(&pod) || !utils.IsPodRunning(&pod) || pod.Status.PodIP == "" {
+			continue
+		}
+
+		svcPort, ok := utils.GetPortFromContainer(utils.GetContainerFromPod(&pod, "meta"), consts.PortService)
+		if !ok {
+			svcPort = consts.MetaServicePort
+		}
+		return fmt.Sprintf("%s:%d", pod.Status.PodIP, svcPort), nil
+	}
+
+	return "", errMetaLeaderNotFound
+}
+
+// redactURL removes the user info (e.g., the credentials of MinIO) from the URL.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	u.User = nil
+	return u.String()
+}
+
+// RisingWaveBackupController is the controller for RisingWaveBackup.
+type RisingWaveBackupController struct {
+	Client client.Client
+	Dial   MetaDialer
+}
+
+// +kubebuilder:rbac:groups=risingwave.risingwavelabs.com,resources=risingwaves,verbs=get;list;watch
+// +kubebuilder:rbac:groups=risingwave.risingwavelabs.com,resources=risingwavebackups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=risingwave.risingwavelabs.com,resources=risingwavebackups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+
+// Reconcile implements the reconcile.Reconciler.
+func (c *RisingWaveBackupController) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var backup risingwavev1alpha1.RisingWaveBackup
+	if err := c.Client.Get(ctx, request.NamespacedName, &backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info("Not found, abort")
+			return ctrlkit.NoRequeue()
+		}
+		logger.Error(err, "Failed to get risingwavebackup")
+		return ctrlkit.RequeueIfErrorAndWrap("unable to get risingwavebackup", err)
+	}
+
+	// Nothing to do with the deleted or completed backups.
+	if utils.IsDeleted(&backup) || isRisingWaveBackupCompleted(&backup) {
+		return ctrlkit.NoRequeue()
+	}
+
+	originalStatus := backup.Status.DeepCopy()
+	result, err := c.syncBackup(ctx, &backup)
+
+	if !equality.Semantic.DeepEqual(originalStatus, &backup.Status) {
+		if updateErr := c.Client.Status().Update(ctx, &backup); updateErr != nil {
+			logger.Error(updateErr, "Failed to update status of risingwavebackup")
+			return ctrlkit.RequeueIfErrorAndWrap("unable to update status of risingwavebackup", updateErr)
+		}
+	}
+
+	return result, err
+}
+
+func isRisingWaveBackupCompleted(backup *risingwavev1alpha1.RisingWaveBackup) bool {
+	phase := backup.Status.Phase
+	return phase == risingwavev1alpha1.RisingWaveBackupPhaseSucceeded || phase == risingwavev1alpha1.RisingWaveBackupPhaseFailed
+}
+
+func markRisingWaveBackupFailed(backup *risingwavev1alpha1.RisingWaveBackup, message string) {
+	now := metav1.Now()
+	backup.Status.Phase = risingwavev1alpha1.RisingWaveBackupPhaseFailed
+	backup.Status.Message = message
+	backup.Status.CompletionTime = &now
+}
+
+// handleRisingWaveBackupRPCError fails the backup if the error isn't a transient one, otherwise it requeues.
+func handleRisingWaveBackupRPCError(backup *risingwavev1alpha1.RisingWaveBackup, msg string, err error) (reconcile.Result, error) {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.Unknown:
+		return ctrlkit.RequeueIfErrorAndWrap(msg, err)
+	default:
+		markRisingWaveBackupFailed(backup, fmt.Sprintf("%s: %s", msg, status.Convert(err).Message()))
+		return ctrlkit.NoRequeue()
+	}
+}
+
+func (c *RisingWaveBackupController) syncBackup(ctx context.Context, backup *risingwavev1alpha1.RisingWaveBackup) (reconcile.Result, error) {
+	var risingwave risingwavev1alpha1.RisingWave
+	err := c.Client.Get(ctx, types.NamespacedName{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.TargetRef.Name,
+	}, &risingwave)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			markRisingWaveBackupFailed(backup, fmt.Sprintf("target RisingWave %s not found", backup.Spec.TargetRef.Name))
+			return ctrlkit.NoRequeue()
+		}
+		return ctrlkit.RequeueIfErrorAndWrap("unable to get risingwave", err)
+	}
+
+	addr, err := getMetaLeaderAddr(ctx, c.Client, backup.Namespace, risingwave.Name)
+	if err != nil {
+		if errors.Is(err, errMetaLeaderNotFound) {
+			if backup.Status.Phase == "" {
+				backup.Status.Phase = risingwavev1alpha1.RisingWaveBackupPhasePending
+			}
+			backup.Status.Message = "Waiting for the meta leader to be ready"
+			return ctrlkit.RequeueAfter(RisingWaveBackupPollInterval)
+		}
+		return ctrlkit.RequeueIfErrorAndWrap("unable to find meta leader", err)
+	}
+
+	conn, err := c.Dial(ctx, &risingwave, addr)
+	if err != nil {
+		return ctrlkit.RequeueIfErrorAndWrap("unable to connect to meta", err)
+	}
+	defer conn.Close()
+
+	if backup.Status.JobID == nil {
+		return c.startBackupJob(ctx, conn, backup)
+	}
+	return c.syncBackupJobStatus(ctx, conn, backup)
+}
+
+func (c *RisingWaveBackupController) getBackupLocation(ctx context.Context, conn grpc.ClientConnInterface) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, RisingWaveBackupRPCTimeout)
+	defer cancel()
+
+	resp, err := pb.NewSystemParamsServiceClient(conn).GetSystemParams(ctx, &pb.GetSystemParamsRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	params := resp.GetParams()
+	return strings.TrimSuffix(redactURL(params.GetBackupStorageUrl()), "/") + "/" + params.GetBackupStorageDirectory(), nil
+}
+
+func (c *RisingWaveBackupController) startBackupJob(ctx context.Context, conn grpc.ClientConnInterface, backup *risingwavev1alpha1.RisingWaveBackup) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	resp, err := func() (*pb.BackupMetaResponse, error) {
+		ctx, cancel := context.WithTimeout(ctx, RisingWaveBackupRPCTimeout)
+		defer cancel()
+		return pb.NewBackupServiceClient(conn).BackupMeta(ctx, &pb.BackupMetaRequest{})
+	}()
+	if err != nil {
+		return handleRisingWaveBackupRPCError(backup, "unable to start backup job", err)
+	}
+
+	logger.Info("Backup job started", "job", resp.JobId)
+
+	now := metav1.Now()
+	backup.Status.Phase = risingwavev1alpha1.RisingWaveBackupPhaseRunning
+	backup.Status.JobID = pointer.Int64(int64(resp.JobId))
+	backup.Status.StartTime = &now
+	backup.Status.Message = ""
+
+	// The location is informative, do not fail the backup when it's unknown.
+	location, err := c.getBackupLocation(ctx, conn)
+	if err != nil {
+		logger.Info("Failed to get the backup location", "error", err)
+	} else {
+		backup.Status.Location = location
+	}
+
+	return ctrlkit.RequeueAfter(RisingWaveBackupPollInterval)
+}
+
+func (c *RisingWaveBackupController) syncBackupJobStatus(ctx context.Context, conn grpc.ClientConnInterface, backup *risingwavev1alpha1.RisingWaveBackup) (reconcile.Result, error) {
+	jobID := *backup.Status.JobID
+
+	resp, err := func() (*pb.GetBackupJobStatusResponse, error) {
+		ctx, cancel := context.WithTimeout(ctx, RisingWaveBackupRPCTimeout)
+		defer cancel()
+		return pb.NewBackupServiceClient(conn).GetBackupJobStatus(ctx, &pb.GetBackupJobStatusRequest{JobId: uint64(jobID)})
+	}()
+	if err != nil {
+		return handleRisingWaveBackupRPCError(backup, "unable to get backup job status", err)
+	}
+
+	switch resp.JobStatus {
+	case pb.BackupJobStatus_SUCCEEDED:
+		// The ID of the snapshot is the same as the ID of the job that creates it.
+		now := metav1.Now()
+		backup.Status.Phase = risingwavev1alpha1.RisingWaveBackupPhaseSucceeded
+		backup.Status.SnapshotID = pointer.Int64(jobID)
+		backup.Status.Message = resp.Message
+		backup.Status.CompletionTime = &now
+		return ctrlkit.NoRequeue()
+	case pb.BackupJobStatus_FAILED:
+		markRisingWaveBackupFailed(backup, resp.Message)
+		return ctrlkit.NoRequeue()
+	case pb.BackupJobStatus_NOT_FOUND:
+		markRisingWaveBackupFailed(backup, fmt.Sprintf("backup job %d not found in meta", jobID))
+		return ctrlkit.NoRequeue()
+	default:
+		return ctrlkit.RequeueAfter(RisingWaveBackupPollInterval)
+	}
+}
+
+// SetupWithManager sets up the controller with a given manager.
+func (c *RisingWaveBackupController) SetupWithManager(mgr ctrl.Manager) error {
+	gvk, err := apiutil.GVKForObject(&risingwavev1alpha1.RisingWaveBackup{}, c.Client.Scheme())
+	if err != nil {
+		return fmt.Errorf("unable to find gvk for RisingWaveBackup: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&risingwavev1alpha1.RisingWaveBackup{}).
+		Complete(metrics.NewControllerMetricsRecorder(c, "RisingWaveBackupController", gvk))
+}
+
+// NewRisingWaveBackupController creates a new RisingWaveBackupController.
+func NewRisingWaveBackupController(client client.Client, dial MetaDialer) *RisingWaveBackupController {
+	return &RisingWaveBackupController{
+		Client: client,
+		Dial:   dial,
+	}
+}
@@ -0,0 +1,380 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/risingwavelabs/ctrlkit"
+	"github.com/robfig/cron/v3"
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
+	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+	pb "github.com/risingwavelabs/risingwave-operator/pkg/controller/proto"
+	"github.com/risingwavelabs/risingwave-operator/pkg/metrics"
+	"github.com/risingwavelabs/risingwave-operator/pkg/utils"
+)
+
+// RisingWaveBackupScheduleController is the controller for RisingWaveBackupSchedule. It creates a RisingWaveBackup
+// for each scheduled run, and deletes the expired backups together with their meta snapshots.
+type RisingWaveBackupScheduleController struct {
+	Client   client.Client
+	Recorder record.EventRecorder
+	Dial     MetaDialer
+	Clock    clock.PassiveClock
+}
+
+// +kubebuilder:rbac:groups=risingwave.risingwavelabs.com,resources=risingwaves,verbs=get;list;watch
+// +kubebuilder:rbac:groups=risingwave.risingwavelabs.com,resources=risingwavebackups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=risingwave.risingwavelabs.com,resources=risingwavebackupschedules,verbs=get;list;watch
+// +kubebuilder:rbac:groups=risingwave.risingwavelabs.com,resources=risingwavebackupschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=risingwave.risingwavelabs.com,resources=risingwavebackupschedules/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile implements the reconcile.Reconciler.
+func (c *RisingWaveBackupScheduleController) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var schedule risingwavev1alpha1.RisingWaveBackupSchedule
+	if err := c.Client.Get(ctx, request.NamespacedName, &schedule); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info("Not found, abort")
+			return ctrlkit.NoRequeue()
+		}
+		logger.Error(err, "Failed to get risingwavebackupschedule")
+		return ctrlkit.RequeueIfErrorAndWrap("unable to get risingwavebackupschedule", err)
+	}
+
+	// The backups are deleted by the garbage collector of Kubernetes, while the snapshots are kept in the meta.
+	if utils.IsDeleted(&schedule) {
+		return ctrlkit.NoRequeue()
+	}
+
+	sched, err := cron.ParseStandard(schedule.Spec.Schedule)
+	if err != nil {
+		c.recordEvent(&schedule, consts.RisingWaveEventTypeInvalidSchedule, "Unable to parse schedule %q: %s", schedule.Spec.Schedule, err)
+		return ctrlkit.NoRequeue()
+	}
+
+	backups, err := c.listScheduledBackups(ctx, &schedule)
+	if err != nil {
+		return ctrlkit.RequeueIfErrorAndWrap("unable to list scheduled backups", err)
+	}
+
+	originalStatus := schedule.Status.DeepCopy()
+
+	c.recordCompletedBackups(&schedule, backups)
+
+	result, err := c.scheduleBackup(ctx, &schedule, sched, backups)
+	if err == nil {
+		err = c.deleteExpiredBackups(ctx, &schedule, backups)
+	}
+
+	// List again to catch the created and deleted backups.
+	if backups, listErr := c.listScheduledBackups(ctx, &schedule); listErr == nil {
+		c.syncRuns(&schedule, backups)
+	}
+
+	if !equality.Semantic.DeepEqual(originalStatus, &schedule.Status) {
+		if updateErr := c.Client.Status().Update(ctx, &schedule); updateErr != nil {
+			logger.Error(updateErr, "Failed to update status of risingwavebackupschedule")
+			return ctrlkit.RequeueIfErrorAndWrap("unable to update status of risingwavebackupschedule", updateErr)
+		}
+	}
+
+	if err != nil {
+		return ctrlkit.RequeueIfErrorAndWrap("unable to sync risingwavebackupschedule", err)
+	}
+	return result, nil
+}
+
+func (c *RisingWaveBackupScheduleController) recordEvent(schedule *risingwavev1alpha1.RisingWaveBackupSchedule, event consts.RisingWaveEventType, messageFmt string, args ...any) {
+	c.Recorder.Eventf(schedule, event.Type, event.Name, messageFmt, args...)
+}
+
+// listScheduledBackups lists the backups created by the schedule, from the newest to the oldest.
+func (c *RisingWaveBackupScheduleController) listScheduledBackups(ctx context.Context, schedule *risingwavev1alpha1.RisingWaveBackupSchedule) ([]risingwavev1alpha1.RisingWaveBackup, error) {
+	var backupList risingwavev1alpha1.RisingWaveBackupList
+	err := c.Client.List(ctx, &backupList, client.InNamespace(schedule.Namespace), client.MatchingLabels{
+		consts.LabelRisingWaveBackupSchedule: schedule.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	backups := lo.Filter(backupList.Items, func(backup risingwavev1alpha1.RisingWaveBackup, _ int) bool {
+		return ctrlkit.ValidateOwnership(&backup, schedule)
+	})
+	sort.SliceStable(backups, func(i, j int) bool {
+		ti, tj := backups[i].CreationTimestamp, backups[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return tj.Before(&ti)
+		}
+		return backups[i].Name > backups[j].Name
+	})
+	return backups, nil
+}
+
+// recordCompletedBackups records events for the backups completed since the last reconciliation and updates the
+// last successful time.
+func (c *RisingWaveBackupScheduleController) recordCompletedBackups(schedule *risingwavev1alpha1.RisingWaveBackupSchedule, backups []risingwavev1alpha1.RisingWaveBackup) {
+	lastPhases := make(map[string]risingwavev1alpha1.RisingWaveBackupPhase)
+	for _, run := range schedule.Status.Runs {
+		lastPhases[run.Name] = run.Phase
+	}
+
+	for _, backup := range backups {
+		if !isRisingWaveBackupCompleted(&backup) || lastPhases[backup.Name] == backup.Status.Phase {
+			continue
+		}
+
+		if backup.Status.Phase == risingwavev1alpha1.RisingWaveBackupPhaseSucceeded {
+			c.recordEvent(schedule, consts.RisingWaveEventTypeBackupSucceeded, "Backup %s succeeded with snapshot %d",
+				backup.Name, lo.FromPtr(backup.Status.SnapshotID))
+		} else {
+			c.recordEvent(schedule, consts.RisingWaveEventTypeBackupFailed, "Backup %s failed: %s", backup.Name, backup.Status.Message)
+		}
+	}
+
+	for _, backup := range backups {
+		completionTime := backup.Status.CompletionTime
+		if backup.Status.Phase != risingwavev1alpha1.RisingWaveBackupPhaseSucceeded || completionTime == nil {
+			continue
+		}
+		if last := schedule.Status.LastSuccessfulTime; last == nil || last.Before(completionTime) {
+			schedule.Status.LastSuccessfulTime = completionTime.DeepCopy()
+		}
+	}
+}
+
+// getMostRecentScheduleTime returns the most recent schedule time that isn't later than now, and the next schedule
+// time after now. The returned schedule time is nil if there's no unmet schedule since the last one.
+func getMostRecentScheduleTime(schedule *risingwavev1alpha1.RisingWaveBackupSchedule, sched cron.Schedule, now time.Time) (*time.Time, time.Time) {
+	earliest := schedule.CreationTimestamp.Time
+	if schedule.Status.LastScheduleTime != nil {
+		earliest = schedule.Status.LastScheduleTime.Time
+	}
+
+	var mostRecent *time.Time
+	t := sched.Next(earliest)
+	for !t.After(now) {
+		mostRecent = lo.ToPtr(t)
+		t = sched.Next(t)
+	}
+	return mostRecent, t
+}
+
+func getScheduledBackupName(schedule *risingwavev1alpha1.RisingWaveBackupSchedule, scheduledTime time.Time) string {
+	return fmt.Sprintf("%s-%d", schedule.Name, scheduledTime.Unix()/60)
+}
+
+// scheduleBackup creates a backup if there's an unmet schedule, and returns a result that requeues at the next
+// schedule time. Only the most recent one of the missed schedules is run.
+func (c *RisingWaveBackupScheduleController) scheduleBackup(ctx context.Context, schedule *risingwavev1alpha1.RisingWaveBackupSchedule,
+	sched cron.Schedule, backups []risingwavev1alpha1.RisingWaveBackup) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	now := c.Clock.Now()
+	scheduledTime, nextScheduleTime := getMostRecentScheduleTime(schedule, sched, now)
+	result := reconcile.Result{RequeueAfter: nextScheduleTime.Sub(now)}
+
+	if lo.FromPtr(schedule.Spec.Suspend) || scheduledTime == nil {
+		return result, nil
+	}
+
+	if schedule.Spec.ConcurrencyPolicy != risingwavev1alpha1.RisingWaveBackupScheduleConcurrencyPolicyAllow {
+		active, ok := lo.Find(backups, func(backup risingwavev1alpha1.RisingWaveBackup) bool {
+			return !isRisingWaveBackupCompleted(&backup)
+		})
+		if ok {
+			c.recordEvent(schedule, consts.RisingWaveEventTypeBackupSkipped, "Backup skipped because backup %s is still running", active.Name)
+			schedule.Status.LastScheduleTime = &metav1.Time{Time: *scheduledTime}
+			return result, nil
+		}
+	}
+
+	backup := &risingwavev1alpha1.RisingWaveBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getScheduledBackupName(schedule, *scheduledTime),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				consts.LabelRisingWaveBackupSchedule: schedule.Name,
+			},
+		},
+		Spec: risingwavev1alpha1.RisingWaveBackupSpec{
+			TargetRef: schedule.Spec.TargetRef,
+		},
+	}
+	if err := ctrl.SetControllerReference(schedule, backup, c.Client.Scheme()); err != nil {
+		return result, fmt.Errorf("unable to set controller reference: %w", err)
+	}
+
+	if err := c.Client.Create(ctx, backup); err != nil {
+		// The backup has been created before the status is updated in the last reconciliation.
+		if !apierrors.IsAlreadyExists(err) {
+			return result, fmt.Errorf("unable to create backup: %w", err)
+		}
+	} else {
+		logger.Info("Backup scheduled", "backup", backup.Name)
+		c.recordEvent(schedule, consts.RisingWaveEventTypeBackupScheduled, "Created backup %s", backup.Name)
+	}
+
+	schedule.Status.LastScheduleTime = &metav1.Time{Time: *scheduledTime}
+	return result, nil
+}
+
+// getRisingWaveBackupTime returns the time when the backup was started, or created if it has never been started.
+func getRisingWaveBackupTime(backup *risingwavev1alpha1.RisingWaveBackup) time.Time {
+	if backup.Status.StartTime != nil {
+		return backup.Status.StartTime.Time
+	}
+	return backup.CreationTimestamp.Time
+}
+
+// getExpiredBackups returns the completed backups in the given phase that are beyond the retention. The backups
+// must be sorted from the newest to the oldest.
+func getExpiredBackups(backups []risingwavev1alpha1.RisingWaveBackup, phase risingwavev1alpha1.RisingWaveBackupPhase,
+	retention risingwavev1alpha1.RisingWaveBackupScheduleRetention, now time.Time) []risingwavev1alpha1.RisingWaveBackup {
+	var expired []risingwavev1alpha1.RisingWaveBackup
+
+	kept := 0
+	for _, backup := range backups {
+		if backup.Status.Phase != phase {
+			continue
+		}
+
+		if (retention.Count != nil && kept >= int(*retention.Count)) ||
+			(retention.MaxAge != nil && now.Sub(getRisingWaveBackupTime(&backup)) > retention.MaxAge.Duration) {
+			expired = append(expired, backup)
+		} else {
+			kept++
+		}
+	}
+
+	return expired
+}
+
+// deleteExpiredBackups deletes the expired backups. The snapshots of the succeeded ones are deleted from the meta
+// first, so that the backups are kept for retrying when the meta isn't available.
+func (c *RisingWaveBackupScheduleController) deleteExpiredBackups(ctx context.Context, schedule *risingwavev1alpha1.RisingWaveBackupSchedule, backups []risingwavev1alpha1.RisingWaveBackup) error {
+	logger := log.FromContext(ctx)
+
+	now := c.Clock.Now()
+	expiredSucceeded := getExpiredBackups(backups, risingwavev1alpha1.RisingWaveBackupPhaseSucceeded, schedule.Spec.Retention, now)
+	expiredFailed := getExpiredBackups(backups, risingwavev1alpha1.RisingWaveBackupPhaseFailed, schedule.Spec.Retention, now)
+
+	snapshotIDs := lo.FilterMap(expiredSucceeded, func(backup risingwavev1alpha1.RisingWaveBackup, _ int) (uint64, bool) {
+		return uint64(lo.FromPtr(backup.Status.SnapshotID)), backup.Status.SnapshotID != nil
+	})
+	if len(snapshotIDs) > 0 {
+		if err := c.deleteMetaSnapshots(ctx, schedule, snapshotIDs); err != nil {
+			c.recordEvent(schedule, consts.RisingWaveEventTypeSnapshotDeletionFailed, "Unable to delete snapshots %v: %s", snapshotIDs, err)
+			return fmt.Errorf("unable to delete meta snapshots: %w", err)
+		}
+		logger.Info("Expired snapshots deleted", "snapshots", snapshotIDs)
+		c.recordEvent(schedule, consts.RisingWaveEventTypeSnapshotsDeleted, "Deleted expired snapshots %v", snapshotIDs)
+	}
+
+	for _, backup := range append(expiredSucceeded, expiredFailed...) {
+		if err := c.Client.Delete(ctx, &backup); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("unable to delete backup %s: %w", backup.Name, err)
+		}
+		logger.Info("Expired backup deleted", "backup", backup.Name)
+	}
+
+	return nil
+}
+
+func (c *RisingWaveBackupScheduleController) deleteMetaSnapshots(ctx context.Context, schedule *risingwavev1alpha1.RisingWaveBackupSchedule, snapshotIDs []uint64) error {
+	addr, err := getMetaLeaderAddr(ctx, c.Client, schedule.Namespace, schedule.Spec.TargetRef.Name)
+	if err != nil {
+		return fmt.Errorf("unable to find meta leader: %w", err)
+	}
+
+	// The operator-level TLS options apply if the RisingWave is gone.
+	risingwave := &risingwavev1alpha1.RisingWave{}
+	if err := c.Client.Get(ctx, types.NamespacedName{Namespace: schedule.Namespace, Name: schedule.Spec.TargetRef.Name}, risingwave); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to get risingwave: %w", err)
+		}
+		risingwave = nil
+	}
+
+	conn, err := c.Dial(ctx, risingwave, addr)
+	if err != nil {
+		return fmt.Errorf("unable to connect to meta: %w", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, RisingWaveBackupRPCTimeout)
+	defer cancel()
+
+	_, err = pb.NewBackupServiceClient(conn).DeleteMetaSnapshot(ctx, &pb.DeleteMetaSnapshotRequest{SnapshotIds: snapshotIDs})
+	return err
+}
+
+// syncRuns records the retained backups in the status.
+func (c *RisingWaveBackupScheduleController) syncRuns(schedule *risingwavev1alpha1.RisingWaveBackupSchedule, backups []risingwavev1alpha1.RisingWaveBackup) {
+	schedule.Status.Runs = lo.Map(backups, func(backup risingwavev1alpha1.RisingWaveBackup, _ int) risingwavev1alpha1.RisingWaveBackupScheduleRun {
+		return risingwavev1alpha1.RisingWaveBackupScheduleRun{
+			Name:           backup.Name,
+			Phase:          backup.Status.Phase,
+			SnapshotID:     backup.Status.SnapshotID,
+			StartTime:      backup.Status.StartTime,
+			CompletionTime: backup.Status.CompletionTime,
+		}
+	})
+}
+
+// SetupWithManager sets up the controller with a given manager.
+func (c *RisingWaveBackupScheduleController) SetupWithManager(mgr ctrl.Manager) error {
+	gvk, err := apiutil.GVKForObject(&risingwavev1alpha1.RisingWaveBackupSchedule{}, c.Client.Scheme())
+	if err != nil {
+		return fmt.Errorf("unable to find gvk for RisingWaveBackupSchedule: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&risingwavev1alpha1.RisingWaveBackupSchedule{}).
+		Owns(&risingwavev1alpha1.RisingWaveBackup{}).
+		Complete(metrics.NewControllerMetricsRecorder(c, "RisingWaveBackupScheduleController", gvk))
+}
+
+// NewRisingWaveBackupScheduleController creates a new RisingWaveBackupScheduleController.
+func NewRisingWaveBackupScheduleController(client client.Client, recorder record.EventRecorder, dial MetaDialer) *RisingWaveBackupScheduleController {
+	return &RisingWaveBackupScheduleController{
+		Client:   client,
+		Recorder: recorder,
+		Dial:     dial,
+		Clock:    clock.RealClock{},
+	}
+}
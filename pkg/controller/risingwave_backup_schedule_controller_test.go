@@ -0,0 +1,312 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
+	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+	"github.com/risingwavelabs/risingwave-operator/pkg/testutils"
+)
+
+var testScheduleCreationTime = time.Date(2023, 6, 1, 10, 30, 0, 0, time.UTC)
+
+func newTestRisingWaveBackupSchedule(risingwave *risingwavev1alpha1.RisingWave, patch func(*risingwavev1alpha1.RisingWaveBackupSchedule)) *risingwavev1alpha1.RisingWaveBackupSchedule {
+	schedule := &risingwavev1alpha1.RisingWaveBackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "schedule",
+			Namespace:         risingwave.Namespace,
+			UID:               types.UID("schedule-uid"),
+			CreationTimestamp: metav1.NewTime(testScheduleCreationTime),
+		},
+		Spec: risingwavev1alpha1.RisingWaveBackupScheduleSpec{
+			TargetRef: risingwavev1alpha1.RisingWaveBackupTargetRef{
+				Name: risingwave.Name,
+			},
+			Schedule:          "0 * * * *",
+			ConcurrencyPolicy: risingwavev1alpha1.RisingWaveBackupScheduleConcurrencyPolicyForbid,
+		},
+	}
+	if patch != nil {
+		patch(schedule)
+	}
+	return schedule
+}
+
+func newTestScheduledBackup(schedule *risingwavev1alpha1.RisingWaveBackupSchedule, scheduledTime time.Time, phase risingwavev1alpha1.RisingWaveBackupPhase, snapshotID *int64) *risingwavev1alpha1.RisingWaveBackup {
+	backup := &risingwavev1alpha1.RisingWaveBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              getScheduledBackupName(schedule, scheduledTime),
+			Namespace:         schedule.Namespace,
+			CreationTimestamp: metav1.NewTime(scheduledTime),
+			Labels: map[string]string{
+				consts.LabelRisingWaveBackupSchedule: schedule.Name,
+			},
+		},
+		Spec: risingwavev1alpha1.RisingWaveBackupSpec{
+			TargetRef: schedule.Spec.TargetRef,
+		},
+		Status: risingwavev1alpha1.RisingWaveBackupStatus{
+			Phase:      phase,
+			SnapshotID: snapshotID,
+			StartTime:  &metav1.Time{Time: scheduledTime},
+		},
+	}
+	if phase == risingwavev1alpha1.RisingWaveBackupPhaseSucceeded || phase == risingwavev1alpha1.RisingWaveBackupPhaseFailed {
+		backup.Status.CompletionTime = &metav1.Time{Time: scheduledTime.Add(time.Minute)}
+	}
+	if err := ctrl.SetControllerReference(schedule, backup, testutils.Scheme); err != nil {
+		panic(err)
+	}
+	return backup
+}
+
+func newTestRisingWaveBackupScheduleController(t *testing.T, server *fakeMetaServer, objects ...client.Object) (*RisingWaveBackupScheduleController, *record.FakeRecorder, *clocktesting.FakePassiveClock) {
+	recorder := record.NewFakeRecorder(16)
+	clock := clocktesting.NewFakePassiveClock(testScheduleCreationTime)
+
+	return &RisingWaveBackupScheduleController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithStatusSubresource(&risingwavev1alpha1.RisingWaveBackupSchedule{}, &risingwavev1alpha1.RisingWaveBackup{}).
+			WithObjects(objects...).
+			Build(),
+		Recorder: recorder,
+		Dial:     newFakeMetaDialer(t, server),
+		Clock:    clock,
+	}, recorder, clock
+}
+
+func reconcileRisingWaveBackupSchedule(t *testing.T, controller *RisingWaveBackupScheduleController, schedule *risingwavev1alpha1.RisingWaveBackupSchedule) (reconcile.Result, *risingwavev1alpha1.RisingWaveBackupSchedule) {
+	result, err := controller.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: schedule.Namespace, Name: schedule.Name},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var current risingwavev1alpha1.RisingWaveBackupSchedule
+	if err := controller.Client.Get(context.Background(), client.ObjectKeyFromObject(schedule), &current); err != nil {
+		t.Fatal(err)
+	}
+	return result, &current
+}
+
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case e := <-recorder.Events:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func listTestScheduledBackups(t *testing.T, controller *RisingWaveBackupScheduleController, schedule *risingwavev1alpha1.RisingWaveBackupSchedule) []risingwavev1alpha1.RisingWaveBackup {
+	backups, err := controller.listScheduledBackups(context.Background(), schedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return backups
+}
+
+func Test_RisingWaveBackupScheduleController_Schedule(t *testing.T) {
+	testcases := map[string]struct {
+		concurrencyPolicy risingwavev1alpha1.RisingWaveBackupScheduleConcurrencyPolicy
+		expectedBackups   int
+		expectedEvent     string
+	}{
+		"forbid": {
+			concurrencyPolicy: risingwavev1alpha1.RisingWaveBackupScheduleConcurrencyPolicyForbid,
+			expectedBackups:   1,
+			expectedEvent:     "Normal BackupSkipped",
+		},
+		"allow": {
+			concurrencyPolicy: risingwavev1alpha1.RisingWaveBackupScheduleConcurrencyPolicyAllow,
+			expectedBackups:   2,
+			expectedEvent:     "Normal BackupScheduled",
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			risingwave := testutils.FakeRisingWave()
+			schedule := newTestRisingWaveBackupSchedule(risingwave, func(schedule *risingwavev1alpha1.RisingWaveBackupSchedule) {
+				schedule.Spec.ConcurrencyPolicy = tc.concurrencyPolicy
+			})
+			controller, recorder, clock := newTestRisingWaveBackupScheduleController(t, &fakeMetaServer{}, risingwave, schedule)
+
+			// Not yet.
+			clock.SetTime(testScheduleCreationTime.Add(29 * time.Minute))
+			result, current := reconcileRisingWaveBackupSchedule(t, controller, schedule)
+			assert.Equal(t, time.Minute, result.RequeueAfter)
+			assert.Nil(t, current.Status.LastScheduleTime)
+			assert.Empty(t, listTestScheduledBackups(t, controller, schedule))
+
+			// Scheduled at 11:00.
+			firstScheduledTime := testScheduleCreationTime.Add(30 * time.Minute)
+			clock.SetTime(firstScheduledTime.Add(time.Minute))
+			result, current = reconcileRisingWaveBackupSchedule(t, controller, schedule)
+			assert.Equal(t, 59*time.Minute, result.RequeueAfter)
+			assert.True(t, firstScheduledTime.Equal(current.Status.LastScheduleTime.Time))
+			assert.Equal(t, []risingwavev1alpha1.RisingWaveBackupScheduleRun{
+				{Name: getScheduledBackupName(schedule, firstScheduledTime)},
+			}, current.Status.Runs)
+			backups := listTestScheduledBackups(t, controller, schedule)
+			if assert.Len(t, backups, 1) {
+				assert.Equal(t, risingwave.Name, backups[0].Spec.TargetRef.Name)
+			}
+			events := drainEvents(recorder)
+			if assert.Len(t, events, 1) {
+				assert.Contains(t, events[0], "Normal BackupScheduled")
+			}
+
+			// Scheduled at 12:00 while the first one is still running.
+			clock.SetTime(firstScheduledTime.Add(time.Hour))
+			_, current = reconcileRisingWaveBackupSchedule(t, controller, schedule)
+			assert.True(t, firstScheduledTime.Add(time.Hour).Equal(current.Status.LastScheduleTime.Time))
+			assert.Len(t, listTestScheduledBackups(t, controller, schedule), tc.expectedBackups)
+			assert.Len(t, current.Status.Runs, tc.expectedBackups)
+			events = drainEvents(recorder)
+			if assert.Len(t, events, 1) {
+				assert.Contains(t, events[0], tc.expectedEvent)
+			}
+		})
+	}
+}
+
+func Test_RisingWaveBackupScheduleController_MissedSchedules(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	schedule := newTestRisingWaveBackupSchedule(risingwave, nil)
+	controller, _, clock := newTestRisingWaveBackupScheduleController(t, &fakeMetaServer{}, risingwave, schedule)
+
+	// Only the most recent one of the missed schedules is run.
+	clock.SetTime(testScheduleCreationTime.Add(5 * time.Hour))
+	_, current := reconcileRisingWaveBackupSchedule(t, controller, schedule)
+	lastScheduledTime := testScheduleCreationTime.Add(270 * time.Minute)
+	assert.True(t, lastScheduledTime.Equal(current.Status.LastScheduleTime.Time))
+	backups := listTestScheduledBackups(t, controller, schedule)
+	if assert.Len(t, backups, 1) {
+		assert.Equal(t, getScheduledBackupName(schedule, lastScheduledTime), backups[0].Name)
+	}
+}
+
+func Test_RisingWaveBackupScheduleController_Suspend(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	schedule := newTestRisingWaveBackupSchedule(risingwave, func(schedule *risingwavev1alpha1.RisingWaveBackupSchedule) {
+		schedule.Spec.Suspend = pointer.Bool(true)
+	})
+	controller, recorder, clock := newTestRisingWaveBackupScheduleController(t, &fakeMetaServer{}, risingwave, schedule)
+
+	clock.SetTime(testScheduleCreationTime.Add(time.Hour))
+	_, current := reconcileRisingWaveBackupSchedule(t, controller, schedule)
+	assert.Nil(t, current.Status.LastScheduleTime)
+	assert.Empty(t, listTestScheduledBackups(t, controller, schedule))
+	assert.Empty(t, drainEvents(recorder))
+}
+
+func Test_RisingWaveBackupScheduleController_InvalidSchedule(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	schedule := newTestRisingWaveBackupSchedule(risingwave, func(schedule *risingwavev1alpha1.RisingWaveBackupSchedule) {
+		schedule.Spec.Schedule = "every hour"
+	})
+	controller, recorder, _ := newTestRisingWaveBackupScheduleController(t, &fakeMetaServer{}, risingwave, schedule)
+
+	result, _ := reconcileRisingWaveBackupSchedule(t, controller, schedule)
+	assert.Equal(t, reconcile.Result{}, result)
+	events := drainEvents(recorder)
+	if assert.Len(t, events, 1) {
+		assert.Contains(t, events[0], "Warning InvalidSchedule")
+	}
+}
+
+func Test_RisingWaveBackupScheduleController_Retention(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	schedule := newTestRisingWaveBackupSchedule(risingwave, func(schedule *risingwavev1alpha1.RisingWaveBackupSchedule) {
+		schedule.Spec.Schedule = "0 0 * * *"
+		schedule.Spec.Retention = risingwavev1alpha1.RisingWaveBackupScheduleRetention{
+			Count:  pointer.Int32(2),
+			MaxAge: &metav1.Duration{Duration: 96 * time.Hour},
+		}
+	})
+
+	day := func(n int) time.Time {
+		return time.Date(2023, 6, n, 0, 0, 0, 0, time.UTC)
+	}
+	objects := []client.Object{
+		risingwave,
+		newFakeMetaLeaderPod(risingwave),
+		schedule,
+		newTestScheduledBackup(schedule, day(2), risingwavev1alpha1.RisingWaveBackupPhaseSucceeded, pointer.Int64(1)),
+		newTestScheduledBackup(schedule, day(3), risingwavev1alpha1.RisingWaveBackupPhaseFailed, nil),
+		newTestScheduledBackup(schedule, day(4), risingwavev1alpha1.RisingWaveBackupPhaseSucceeded, pointer.Int64(2)),
+		newTestScheduledBackup(schedule, day(5), risingwavev1alpha1.RisingWaveBackupPhaseFailed, nil),
+		newTestScheduledBackup(schedule, day(6), risingwavev1alpha1.RisingWaveBackupPhaseSucceeded, pointer.Int64(3)),
+		newTestScheduledBackup(schedule, day(7), risingwavev1alpha1.RisingWaveBackupPhaseRunning, nil),
+	}
+
+	server := &fakeMetaServer{}
+	controller, recorder, clock := newTestRisingWaveBackupScheduleController(t, server, objects...)
+	schedule.Status.LastScheduleTime = &metav1.Time{Time: day(7)}
+	if err := controller.Client.Status().Update(context.Background(), schedule); err != nil {
+		t.Fatal(err)
+	}
+
+	// The backup of day 2 is beyond the count, and the failed one of day 3 is older than the max age.
+	clock.SetTime(day(7).Add(time.Hour))
+	_, current := reconcileRisingWaveBackupSchedule(t, controller, schedule)
+	assert.Equal(t, []uint64{1}, server.deletedSnapshots)
+	assert.True(t, day(6).Add(time.Minute).Equal(current.Status.LastSuccessfulTime.Time))
+
+	expectedRuns := []string{
+		getScheduledBackupName(schedule, day(7)),
+		getScheduledBackupName(schedule, day(6)),
+		getScheduledBackupName(schedule, day(5)),
+		getScheduledBackupName(schedule, day(4)),
+	}
+	assert.Equal(t, expectedRuns, lo.Map(current.Status.Runs, func(run risingwavev1alpha1.RisingWaveBackupScheduleRun, _ int) string {
+		return run.Name
+	}))
+	assert.Len(t, listTestScheduledBackups(t, controller, schedule), len(expectedRuns))
+
+	events := drainEvents(recorder)
+	assert.Contains(t, events, "Normal SnapshotsDeleted Deleted expired snapshots [1]")
+	assert.Contains(t, events, fmt.Sprintf("Warning BackupFailed Backup %s failed: ", getScheduledBackupName(schedule, day(5))))
+
+	// Events of the completed backups are recorded only once.
+	_, _ = reconcileRisingWaveBackupSchedule(t, controller, schedule)
+	assert.Empty(t, drainEvents(recorder))
+	assert.Equal(t, []uint64{1}, server.deletedSnapshots)
+}
@@ -0,0 +1,459 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/risingwavelabs/ctrlkit"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
+	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+	pb "github.com/risingwavelabs/risingwave-operator/pkg/controller/proto"
+	"github.com/risingwavelabs/risingwave-operator/pkg/object"
+)
+
+const (
+	RisingWaveComputeScaleInPollInterval = 5 * time.Second
+	RisingWaveComputeScaleInRPCTimeout   = 5 * time.Second
+)
+
+// metaConnection dials the meta leader of a RisingWave on the first use, so that meta is only connected when
+// there's something to do.
+type metaConnection struct {
+	client     client.Reader
+	dial       MetaDialer
+	risingwave *risingwavev1alpha1.RisingWave
+
+	conn *grpc.ClientConn
+}
+
+// Get returns the connection to the meta leader. errMetaLeaderNotFound is returned if there's no leader.
+func (m *metaConnection) Get(ctx context.Context) (*grpc.ClientConn, error) {
+	if m.conn != nil {
+		return m.conn, nil
+	}
+
+	addr, err := getMetaLeaderAddr(ctx, m.client, m.risingwave.Namespace, m.risingwave.Name)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := m.dial(ctx, m.risingwave, addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to meta: %w", err)
+	}
+	m.conn = conn
+	return conn, nil
+}
+
+// Close closes the connection if it has been established.
+func (m *metaConnection) Close() {
+	if m.conn != nil {
+		_ = m.conn.Close()
+	}
+}
+
+// podOrdinal parses the ordinal of the Pod of a StatefulSet from its name.
+func podOrdinal(name string) (int32, bool) {
+	idx := strings.LastIndex(name, "-")
+	if idx < 0 {
+		return 0, false
+	}
+	ordinal, err := strconv.ParseInt(name[idx+1:], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(ordinal), true
+}
+
+// findWorkerPod returns the Pod that the worker runs in. Compute nodes advertise the address of the Pod in the
+// headless Service, while the others advertise the Pod IP, so both are matched.
+func findWorkerPod(worker *pb.WorkerNode, pods []corev1.Pod) *corev1.Pod {
+	host := worker.GetHost().GetHost()
+	if host == "" {
+		return nil
+	}
+	for i := range pods {
+		pod := &pods[i]
+		if host == pod.Status.PodIP || strings.SplitN(host, ".", 2)[0] == pod.Name {
+			return pod
+		}
+	}
+	return nil
+}
+
+func (c *RisingWaveController) listComputePods(ctx context.Context, risingwave *risingwavev1alpha1.RisingWave) ([]corev1.Pod, error) {
+	var podList corev1.PodList
+	if err := c.Client.List(ctx, &podList, client.InNamespace(risingwave.Namespace), client.MatchingLabels{
+		consts.LabelRisingWaveName:      risingwave.Name,
+		consts.LabelRisingWaveComponent: consts.ComponentCompute,
+	}); err != nil {
+		return nil, fmt.Errorf("unable to list compute pods: %w", err)
+	}
+	return podList.Items, nil
+}
+
+// listWorkers lists the workers registered in meta, including the starting ones. All types of workers are
+// listed if the type is nil.
+func listWorkers(ctx context.Context, conn grpc.ClientConnInterface, workerType *pb.WorkerType) ([]*pb.WorkerNode, error) {
+	ctx, cancel := context.WithTimeout(ctx, RisingWaveComputeScaleInRPCTimeout)
+	defer cancel()
+
+	resp, err := pb.NewClusterServiceClient(conn).ListAllNodes(ctx, &pb.ListAllNodesRequest{
+		WorkerType:           workerType,
+		IncludeStartingNodes: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list workers: %w", err)
+	}
+	return resp.Nodes, nil
+}
+
+func updateWorkerSchedulability(ctx context.Context, conn grpc.ClientConnInterface, workerIDs []uint32, schedulability pb.UpdateWorkerNodeSchedulabilityRequest_Schedulability) error {
+	ctx, cancel := context.WithTimeout(ctx, RisingWaveComputeScaleInRPCTimeout)
+	defer cancel()
+
+	_, err := pb.NewClusterServiceClient(conn).UpdateWorkerNodeSchedulability(ctx, &pb.UpdateWorkerNodeSchedulabilityRequest{
+		WorkerIds:      workerIDs,
+		Schedulability: schedulability,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update the schedulability of workers %v: %w", workerIDs, err)
+	}
+	return nil
+}
+
+// migrateActorsFromWorkers asks meta to reschedule the actors on the given workers to the others. It returns
+// true when there's no actor left on the workers.
+func migrateActorsFromWorkers(ctx context.Context, conn grpc.ClientConnInterface, workerIDs []uint32) (bool, error) {
+	scaleClient := pb.NewScaleServiceClient(conn)
+
+	info, err := func() (*pb.GetClusterInfoResponse, error) {
+		ctx, cancel := context.WithTimeout(ctx, RisingWaveComputeScaleInRPCTimeout)
+		defer cancel()
+		return scaleClient.GetClusterInfo(ctx, &pb.GetClusterInfoRequest{})
+	}()
+	if err != nil {
+		return false, fmt.Errorf("unable to get cluster info: %w", err)
+	}
+
+	draining := lo.SliceToMap(workerIDs, func(id uint32) (uint32, struct{}) { return id, struct{}{} })
+	remainingActors := 0
+	fragmentWorkerChanges := make(map[uint32]*pb.GetReschedulePlanRequest_WorkerChanges)
+	for _, tableFragments := range info.TableFragments {
+		for _, actorStatus := range tableFragments.ActorStatus {
+			if _, ok := draining[actorStatus.GetParallelUnit().GetWorkerNodeId()]; ok {
+				remainingActors++
+			}
+		}
+		for fragmentID := range tableFragments.Fragments {
+			fragmentWorkerChanges[fragmentID] = &pb.GetReschedulePlanRequest_WorkerChanges{
+				ExcludeWorkerIds: workerIDs,
+			}
+		}
+	}
+	if remainingActors == 0 {
+		return true, nil
+	}
+
+	plan, err := func() (*pb.GetReschedulePlanResponse, error) {
+		ctx, cancel := context.WithTimeout(ctx, RisingWaveComputeScaleInRPCTimeout)
+		defer cancel()
+		return scaleClient.GetReschedulePlan(ctx, &pb.GetReschedulePlanRequest{
+			Revision: info.Revision,
+			Policy: &pb.GetReschedulePlanRequest_StableResizePolicy_{
+				StableResizePolicy: &pb.GetReschedulePlanRequest_StableResizePolicy{
+					FragmentWorkerChanges: fragmentWorkerChanges,
+				},
+			},
+		})
+	}()
+	if err != nil {
+		return false, fmt.Errorf("unable to get the reschedule plan: %w", err)
+	}
+	if !plan.Success {
+		return false, errors.New("meta failed to generate the reschedule plan")
+	}
+	if len(plan.Reschedules) == 0 {
+		return false, nil
+	}
+
+	resp, err := func() (*pb.RescheduleResponse, error) {
+		ctx, cancel := context.WithTimeout(ctx, RisingWaveComputeScaleInRPCTimeout)
+		defer cancel()
+		return scaleClient.Reschedule(ctx, &pb.RescheduleRequest{
+			Reschedules:              plan.Reschedules,
+			Revision:                 plan.Revision,
+			ResolveNoShuffleUpstream: true,
+		})
+	}()
+	if err != nil {
+		return false, fmt.Errorf("unable to reschedule: %w", err)
+	}
+	if !resp.Success {
+		return false, errors.New("meta failed to reschedule")
+	}
+
+	// Check again in the next round.
+	return false, nil
+}
+
+// drainComputeGroup drains the compute workers of the node group that are about to be removed. It returns
+// true when the workload of the node group is ready to be synced.
+func (c *RisingWaveController) drainComputeGroup(ctx context.Context, logger logr.Logger, risingwaveMgr *object.RisingWaveManager, metaConn *metaConnection,
+	group risingwavev1alpha1.RisingWaveNodeGroup) (bool, error) {
+	enabled := risingwaveMgr.IsGracefulComputeScaleInEnabled()
+
+	var scaleIn *risingwavev1alpha1.RisingWaveComputeScaleInStatus
+	if s := risingwaveMgr.GetComputeScaleIn(group.Name); s != nil {
+		scaleIn = s.DeepCopy()
+	}
+
+	// Restore the workers that are kept after the target is changed, or the graceful scale-in is disabled.
+	if scaleIn != nil && (scaleIn.Replicas != group.Replicas || !enabled) {
+		kept := lo.FilterMap(scaleIn.Workers, func(worker risingwavev1alpha1.RisingWaveComputeWorker, _ int) (uint32, bool) {
+			ordinal, ok := podOrdinal(worker.Pod)
+			return worker.ID, !enabled || (ok && ordinal < group.Replicas)
+		})
+		if len(kept) > 0 {
+			conn, err := metaConn.Get(ctx)
+			if err != nil {
+				return false, err
+			}
+			if err := updateWorkerSchedulability(ctx, conn, kept, pb.UpdateWorkerNodeSchedulabilityRequest_SCHEDULABLE); err != nil {
+				return false, err
+			}
+			logger.Info("Compute workers are schedulable again", "group", group.Name, "workers", kept)
+		}
+		risingwaveMgr.RemoveComputeScaleIn(group.Name)
+		scaleIn = nil
+	}
+
+	if !enabled {
+		return true, nil
+	}
+	if scaleIn != nil && scaleIn.Phase == risingwavev1alpha1.RisingWaveComputeScaleInPhaseDrained {
+		return true, nil
+	}
+
+	if scaleIn == nil {
+		pods, err := c.listComputePods(ctx, risingwaveMgr.RisingWave())
+		if err != nil {
+			return false, err
+		}
+		toRemove := lo.Filter(pods, func(pod corev1.Pod, _ int) bool {
+			ordinal, ok := podOrdinal(pod.Name)
+			return pod.Labels[consts.LabelRisingWaveGroup] == group.Name && ok && ordinal >= group.Replicas
+		})
+		if len(toRemove) == 0 {
+			return true, nil
+		}
+
+		conn, err := metaConn.Get(ctx)
+		if err != nil {
+			return false, err
+		}
+		workers, err := listWorkers(ctx, conn, pb.WorkerType_WORKER_TYPE_COMPUTE_NODE.Enum())
+		if err != nil {
+			return false, err
+		}
+		scaleIn = &risingwavev1alpha1.RisingWaveComputeScaleInStatus{
+			Group:     group.Name,
+			Replicas:  group.Replicas,
+			Phase:     risingwavev1alpha1.RisingWaveComputeScaleInPhaseDraining,
+			StartTime: metav1.Now(),
+		}
+		for _, worker := range workers {
+			if pod := findWorkerPod(worker, toRemove); pod != nil {
+				scaleIn.Workers = append(scaleIn.Workers, risingwavev1alpha1.RisingWaveComputeWorker{
+					ID:   worker.Id,
+					Host: worker.GetHost().GetHost(),
+					Port: worker.GetHost().GetPort(),
+					Pod:  pod.Name,
+				})
+			}
+		}
+		// None of the Pods has registered. Nothing to drain.
+		if len(scaleIn.Workers) == 0 {
+			return true, nil
+		}
+
+		// The actors can't be migrated if there's no other schedulable worker, e.g., when the only node group is
+		// scaled to zero.
+		draining := lo.SliceToMap(scaleIn.Workers, func(worker risingwavev1alpha1.RisingWaveComputeWorker) (uint32, struct{}) {
+			return worker.ID, struct{}{}
+		})
+		if !lo.SomeBy(workers, func(worker *pb.WorkerNode) bool {
+			_, ok := draining[worker.Id]
+			return !ok && !worker.GetProperty().GetIsUnschedulable()
+		}) {
+			logger.Info("No other schedulable compute workers, skip draining", "group", group.Name, "replicas", group.Replicas)
+			return true, nil
+		}
+
+		workerIDs := lo.Map(scaleIn.Workers, func(worker risingwavev1alpha1.RisingWaveComputeWorker, _ int) uint32 { return worker.ID })
+		if err := updateWorkerSchedulability(ctx, conn, workerIDs, pb.UpdateWorkerNodeSchedulabilityRequest_UNSCHEDULABLE); err != nil {
+			return false, err
+		}
+		logger.Info("Start draining compute workers", "group", group.Name, "workers", workerIDs)
+	}
+
+	// Record the scale-in even if the migration fails, so that the workers will be restored when it's cancelled.
+	defer func() {
+		risingwaveMgr.SetComputeScaleIn(*scaleIn)
+	}()
+
+	conn, err := metaConn.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	workerIDs := lo.Map(scaleIn.Workers, func(worker risingwavev1alpha1.RisingWaveComputeWorker, _ int) uint32 { return worker.ID })
+	drained, err := migrateActorsFromWorkers(ctx, conn, workerIDs)
+	if err != nil {
+		return false, err
+	}
+	if drained {
+		logger.Info("Compute workers drained", "group", group.Name, "workers", workerIDs)
+		scaleIn.Phase = risingwavev1alpha1.RisingWaveComputeScaleInPhaseDrained
+	}
+	return drained, nil
+}
+
+// drainComputeWorkersBeforeScaleIn holds the compute workloads being scaled in until the actors on the workers
+// to be removed are migrated to the others. Only the node groups being drained are held, the others are synced
+// as usual.
+func (c *RisingWaveController) drainComputeWorkersBeforeScaleIn(ctx context.Context, logger logr.Logger, risingwaveMgr *object.RisingWaveManager) (ctrl.Result, error) {
+	// The workers are all going to be removed when stopped. No need to drain.
+	if risingwaveMgr.IsStopped() {
+		return ctrlkit.Continue()
+	}
+
+	risingwave := risingwaveMgr.RisingWave()
+	metaConn := &metaConnection{client: c.Client, dial: c.Dial, risingwave: risingwave}
+	defer metaConn.Close()
+
+	allDrained, firstErr := true, error(nil)
+	for _, group := range risingwaveMgr.GetNodeGroups(consts.ComponentCompute) {
+		drained, err := c.drainComputeGroup(ctx, logger, risingwaveMgr, metaConn, group)
+		if err != nil {
+			if errors.Is(err, errMetaLeaderNotFound) {
+				logger.Info("Waiting for the meta leader to drain the compute workers", "group", group.Name)
+			} else {
+				logger.Error(err, "Failed to drain compute workers", "group", group.Name)
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
+		}
+		if !drained {
+			risingwaveMgr.HoldNodeGroup(consts.ComponentCompute, group.Name)
+		}
+		allDrained = allDrained && drained
+	}
+
+	if firstErr != nil {
+		return ctrlkit.RequeueIfErrorAndWrap("unable to drain compute workers", firstErr)
+	}
+	if !allDrained {
+		return ctrlkit.RequeueAfter(RisingWaveComputeScaleInPollInterval)
+	}
+	return ctrlkit.Continue()
+}
+
+// unregisterScaledInComputeWorkers unregisters the drained compute workers from meta after their Pods are gone.
+// The workers of the node groups removed from the spec are also unregistered.
+func (c *RisingWaveController) unregisterScaledInComputeWorkers(ctx context.Context, logger logr.Logger, risingwaveMgr *object.RisingWaveManager) (ctrl.Result, error) {
+	risingwave := risingwaveMgr.RisingWave()
+	scaleIns := lo.Filter(risingwave.Status.ComputeScaleIns, func(scaleIn risingwavev1alpha1.RisingWaveComputeScaleInStatus, _ int) bool {
+		return scaleIn.Phase == risingwavev1alpha1.RisingWaveComputeScaleInPhaseDrained ||
+			risingwaveMgr.GetNodeGroup(consts.ComponentCompute, scaleIn.Group) == nil
+	})
+	if len(scaleIns) == 0 {
+		return ctrlkit.Continue()
+	}
+
+	pods, err := c.listComputePods(ctx, risingwave)
+	if err != nil {
+		return ctrlkit.RequeueIfError(err)
+	}
+	podNames := lo.SliceToMap(pods, func(pod corev1.Pod) (string, struct{}) { return pod.Name, struct{}{} })
+
+	metaConn := &metaConnection{client: c.Client, dial: c.Dial, risingwave: risingwave}
+	defer metaConn.Close()
+
+	waiting := false
+	for _, scaleIn := range scaleIns {
+		if lo.ContainsBy(scaleIn.Workers, func(worker risingwavev1alpha1.RisingWaveComputeWorker) bool {
+			_, ok := podNames[worker.Pod]
+			return ok
+		}) {
+			waiting = true
+			continue
+		}
+
+		if len(scaleIn.Workers) > 0 {
+			conn, err := metaConn.Get(ctx)
+			if err != nil {
+				if errors.Is(err, errMetaLeaderNotFound) {
+					return ctrlkit.RequeueAfter(RisingWaveComputeScaleInPollInterval)
+				}
+				return ctrlkit.RequeueIfErrorAndWrap("unable to unregister compute workers", err)
+			}
+			for _, worker := range scaleIn.Workers {
+				if err := deleteWorkerNode(ctx, conn, worker.Host, worker.Port); err != nil {
+					return ctrlkit.RequeueIfErrorAndWrap("unable to unregister compute workers", err)
+				}
+			}
+			logger.Info("Compute workers unregistered", "group", scaleIn.Group, "workers", lo.Map(scaleIn.Workers,
+				func(worker risingwavev1alpha1.RisingWaveComputeWorker, _ int) uint32 { return worker.ID }))
+		}
+		risingwaveMgr.RemoveComputeScaleIn(scaleIn.Group)
+	}
+
+	if waiting {
+		return ctrlkit.RequeueAfter(RisingWaveComputeScaleInPollInterval)
+	}
+	return ctrlkit.Continue()
+}
+
+// deleteWorkerNode unregisters the worker at the given address from meta. It's fine if it's already gone.
+func deleteWorkerNode(ctx context.Context, conn grpc.ClientConnInterface, host string, port int32) error {
+	ctx, cancel := context.WithTimeout(ctx, RisingWaveComputeScaleInRPCTimeout)
+	defer cancel()
+
+	_, err := pb.NewClusterServiceClient(conn).DeleteWorkerNode(ctx, &pb.DeleteWorkerNodeRequest{
+		Host: &pb.HostAddress{Host: host, Port: port},
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("unable to delete worker %s:%d: %w", host, port, err)
+	}
+	return nil
+}
@@ -0,0 +1,364 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
+	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+	pb "github.com/risingwavelabs/risingwave-operator/pkg/controller/proto"
+	"github.com/risingwavelabs/risingwave-operator/pkg/object"
+	"github.com/risingwavelabs/risingwave-operator/pkg/testutils"
+)
+
+type fakeMetaClusterServer struct {
+	pb.UnimplementedClusterServiceServer
+	pb.UnimplementedScaleServiceServer
+
+	workers       map[uint32]string
+	actors        map[uint32]uint32
+	unschedulable map[uint32]bool
+	deleted       []string
+}
+
+func (s *fakeMetaClusterServer) ListAllNodes(context.Context, *pb.ListAllNodesRequest) (*pb.ListAllNodesResponse, error) {
+	resp := &pb.ListAllNodesResponse{}
+	for id, host := range s.workers {
+		resp.Nodes = append(resp.Nodes, &pb.WorkerNode{
+			Id:   id,
+			Type: pb.WorkerType_WORKER_TYPE_COMPUTE_NODE,
+			Host: &pb.HostAddress{Host: host, Port: 5688},
+			Property: &pb.WorkerNode_Property{
+				IsUnschedulable: s.unschedulable[id],
+			},
+		})
+	}
+	return resp, nil
+}
+
+func (s *fakeMetaClusterServer) UpdateWorkerNodeSchedulability(_ context.Context, req *pb.UpdateWorkerNodeSchedulabilityRequest) (*pb.UpdateWorkerNodeSchedulabilityResponse, error) {
+	for _, id := range req.WorkerIds {
+		s.unschedulable[id] = req.Schedulability == pb.UpdateWorkerNodeSchedulabilityRequest_UNSCHEDULABLE
+	}
+	return &pb.UpdateWorkerNodeSchedulabilityResponse{}, nil
+}
+
+func (s *fakeMetaClusterServer) DeleteWorkerNode(_ context.Context, req *pb.DeleteWorkerNodeRequest) (*pb.DeleteWorkerNodeResponse, error) {
+	s.deleted = append(s.deleted, fmt.Sprintf("%s:%d", req.Host.Host, req.Host.Port))
+	return &pb.DeleteWorkerNodeResponse{}, nil
+}
+
+func (s *fakeMetaClusterServer) GetClusterInfo(context.Context, *pb.GetClusterInfoRequest) (*pb.GetClusterInfoResponse, error) {
+	tableFragments := &pb.TableFragments{
+		TableId:     1,
+		Fragments:   map[uint32]*pb.TableFragments_Fragment{1: {FragmentId: 1}},
+		ActorStatus: map[uint32]*pb.TableFragments_ActorStatus{},
+	}
+	for actorID, workerID := range s.actors {
+		tableFragments.ActorStatus[actorID] = &pb.TableFragments_ActorStatus{
+			ParallelUnit: &pb.ParallelUnit{Id: actorID, WorkerNodeId: workerID},
+		}
+	}
+	return &pb.GetClusterInfoResponse{TableFragments: []*pb.TableFragments{tableFragments}, Revision: 1}, nil
+}
+
+func (s *fakeMetaClusterServer) GetReschedulePlan(_ context.Context, req *pb.GetReschedulePlanRequest) (*pb.GetReschedulePlanResponse, error) {
+	return &pb.GetReschedulePlanResponse{
+		Revision:    req.Revision,
+		Reschedules: map[uint32]*pb.Reschedule{1: {}},
+		Success:     true,
+	}, nil
+}
+
+// Reschedule moves the actors on the unschedulable workers to the first schedulable one.
+func (s *fakeMetaClusterServer) Reschedule(context.Context, *pb.RescheduleRequest) (*pb.RescheduleResponse, error) {
+	ids := make([]uint32, 0, len(s.workers))
+	for id := range s.workers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, target := range ids {
+		if s.unschedulable[target] {
+			continue
+		}
+		for actorID, workerID := range s.actors {
+			if s.unschedulable[workerID] {
+				s.actors[actorID] = target
+			}
+		}
+		return &pb.RescheduleResponse{Success: true, Revision: 2}, nil
+	}
+	return &pb.RescheduleResponse{Success: false}, nil
+}
+
+func newFakeComputePod(risingwave *risingwavev1alpha1.RisingWave, ordinal int) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-compute-%d", risingwave.Name, ordinal),
+			Namespace: risingwave.Namespace,
+			Labels: map[string]string{
+				consts.LabelRisingWaveName:      risingwave.Name,
+				consts.LabelRisingWaveComponent: consts.ComponentCompute,
+				consts.LabelRisingWaveGroup:     "",
+			},
+		},
+	}
+}
+
+func newFakeMetaClusterServer(risingwave *risingwavev1alpha1.RisingWave) *fakeMetaClusterServer {
+	return &fakeMetaClusterServer{
+		workers: map[uint32]string{
+			1: risingwave.Name + "-compute-0.compute.default.svc",
+			2: risingwave.Name + "-compute-1.compute.default.svc",
+		},
+		actors:        map[uint32]uint32{1: 1, 2: 2, 3: 2},
+		unschedulable: map[uint32]bool{},
+	}
+}
+
+func Test_RisingWaveController_GracefulComputeScaleIn(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.EnableGracefulComputeScaleIn = pointer.Bool(true)
+	server := newFakeMetaClusterServer(risingwave)
+	pod0, pod1 := newFakeComputePod(risingwave, 0), newFakeComputePod(risingwave, 1)
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithObjects(risingwave, newFakeMetaLeaderPod(risingwave), pod0, pod1).
+			Build(),
+		Dial: newInMemoryMetaDialer(t, func(grpcServer *grpc.Server) {
+			pb.RegisterClusterServiceServer(grpcServer, server)
+			pb.RegisterScaleServiceServer(grpcServer, server)
+		}),
+	}
+
+	// Start draining the worker of compute-1. The workload is held.
+	mgr := object.NewRisingWaveManager(controller.Client, risingwave, false)
+	result, err := controller.drainComputeWorkersBeforeScaleIn(context.Background(), logr.Discard(), mgr)
+	assert.Nil(t, err)
+	assert.Equal(t, RisingWaveComputeScaleInPollInterval, result.RequeueAfter)
+	assert.True(t, server.unschedulable[2])
+	assert.Equal(t, map[uint32]uint32{1: 1, 2: 1, 3: 1}, server.actors)
+	assert.True(t, mgr.IsNodeGroupHeld(consts.ComponentCompute, ""))
+
+	risingwave = mgr.RisingWaveAfterImage()
+	assert.Equal(t, []risingwavev1alpha1.RisingWaveComputeScaleInStatus{
+		{
+			Group:    "",
+			Replicas: 1,
+			Phase:    risingwavev1alpha1.RisingWaveComputeScaleInPhaseDraining,
+			Workers: []risingwavev1alpha1.RisingWaveComputeWorker{
+				{ID: 2, Host: server.workers[2], Port: 5688, Pod: pod1.Name},
+			},
+			StartTime: risingwave.Status.ComputeScaleIns[0].StartTime,
+		},
+	}, risingwave.Status.ComputeScaleIns)
+
+	// No actor left, the workload can be scaled in.
+	mgr = object.NewRisingWaveManager(controller.Client, risingwave, false)
+	result, err = controller.drainComputeWorkersBeforeScaleIn(context.Background(), logr.Discard(), mgr)
+	assert.Nil(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	assert.False(t, mgr.IsNodeGroupHeld(consts.ComponentCompute, ""))
+	risingwave = mgr.RisingWaveAfterImage()
+	assert.Equal(t, risingwavev1alpha1.RisingWaveComputeScaleInPhaseDrained, risingwave.Status.ComputeScaleIns[0].Phase)
+
+	// Wait before the Pod is gone.
+	mgr = object.NewRisingWaveManager(controller.Client, risingwave, false)
+	result, err = controller.unregisterScaledInComputeWorkers(context.Background(), logr.Discard(), mgr)
+	assert.Nil(t, err)
+	assert.Equal(t, RisingWaveComputeScaleInPollInterval, result.RequeueAfter)
+	assert.Empty(t, server.deleted)
+
+	// Unregister the worker after the Pod is gone.
+	assert.Nil(t, controller.Client.Delete(context.Background(), pod1))
+	result, err = controller.unregisterScaledInComputeWorkers(context.Background(), logr.Discard(), mgr)
+	assert.Nil(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	assert.Equal(t, []string{server.workers[2] + ":5688"}, server.deleted)
+	assert.Empty(t, mgr.RisingWaveAfterImage().Status.ComputeScaleIns)
+}
+
+func Test_RisingWaveController_GracefulComputeScaleInHoldsDrainingGroupOnly(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.EnableGracefulComputeScaleIn = pointer.Bool(true)
+	risingwave.Spec.Components.Compute.NodeGroups = append(risingwave.Spec.Components.Compute.NodeGroups,
+		risingwavev1alpha1.RisingWaveNodeGroup{Name: "other", Replicas: 1})
+	server := newFakeMetaClusterServer(risingwave)
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithObjects(risingwave, newFakeMetaLeaderPod(risingwave), newFakeComputePod(risingwave, 0), newFakeComputePod(risingwave, 1)).
+			Build(),
+		Dial: newInMemoryMetaDialer(t, func(grpcServer *grpc.Server) {
+			pb.RegisterClusterServiceServer(grpcServer, server)
+			pb.RegisterScaleServiceServer(grpcServer, server)
+		}),
+	}
+
+	mgr := object.NewRisingWaveManager(controller.Client, risingwave, false)
+	result, err := controller.drainComputeWorkersBeforeScaleIn(context.Background(), logr.Discard(), mgr)
+	assert.Nil(t, err)
+	assert.Equal(t, RisingWaveComputeScaleInPollInterval, result.RequeueAfter)
+	assert.True(t, mgr.IsNodeGroupHeld(consts.ComponentCompute, ""))
+	assert.False(t, mgr.IsNodeGroupHeld(consts.ComponentCompute, "other"))
+}
+
+func Test_RisingWaveController_GracefulComputeScaleInToZero(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.EnableGracefulComputeScaleIn = pointer.Bool(true)
+	risingwave.Spec.Components.Compute.NodeGroups[0].Replicas = 0
+	risingwave.Spec.Components.Compute.NodeGroups = append(risingwave.Spec.Components.Compute.NodeGroups,
+		risingwavev1alpha1.RisingWaveNodeGroup{Name: "other", Replicas: 1})
+	server := newFakeMetaClusterServer(risingwave)
+	otherPod := newFakeComputePod(risingwave, 0)
+	otherPod.Name = risingwave.Name + "-compute-other-0"
+	otherPod.Labels[consts.LabelRisingWaveGroup] = "other"
+	server.workers[3] = otherPod.Name + ".compute.default.svc"
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithObjects(risingwave, newFakeMetaLeaderPod(risingwave), newFakeComputePod(risingwave, 0), newFakeComputePod(risingwave, 1), otherPod).
+			Build(),
+		Dial: newInMemoryMetaDialer(t, func(grpcServer *grpc.Server) {
+			pb.RegisterClusterServiceServer(grpcServer, server)
+			pb.RegisterScaleServiceServer(grpcServer, server)
+		}),
+	}
+
+	// All the workers of the group are drained to the other group.
+	mgr := object.NewRisingWaveManager(controller.Client, risingwave, false)
+	result, err := controller.drainComputeWorkersBeforeScaleIn(context.Background(), logr.Discard(), mgr)
+	assert.Nil(t, err)
+	assert.Equal(t, RisingWaveComputeScaleInPollInterval, result.RequeueAfter)
+	assert.True(t, mgr.IsNodeGroupHeld(consts.ComponentCompute, ""))
+	assert.False(t, mgr.IsNodeGroupHeld(consts.ComponentCompute, "other"))
+	assert.True(t, server.unschedulable[1])
+	assert.True(t, server.unschedulable[2])
+	assert.Equal(t, map[uint32]uint32{1: 3, 2: 3, 3: 3}, server.actors)
+	if scaleIns := mgr.RisingWaveAfterImage().Status.ComputeScaleIns; assert.Len(t, scaleIns, 1) {
+		assert.Equal(t, int32(0), scaleIns[0].Replicas)
+		assert.Len(t, scaleIns[0].Workers, 2)
+	}
+}
+
+func Test_RisingWaveController_GracefulComputeScaleInToZeroWithoutOtherWorkers(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.EnableGracefulComputeScaleIn = pointer.Bool(true)
+	risingwave.Spec.Components.Compute.NodeGroups[0].Replicas = 0
+	server := newFakeMetaClusterServer(risingwave)
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithObjects(risingwave, newFakeMetaLeaderPod(risingwave), newFakeComputePod(risingwave, 0), newFakeComputePod(risingwave, 1)).
+			Build(),
+		Dial: newInMemoryMetaDialer(t, func(grpcServer *grpc.Server) {
+			pb.RegisterClusterServiceServer(grpcServer, server)
+			pb.RegisterScaleServiceServer(grpcServer, server)
+		}),
+	}
+
+	// Nowhere to migrate the actors, so the workload is scaled in right away.
+	mgr := object.NewRisingWaveManager(controller.Client, risingwave, false)
+	result, err := controller.drainComputeWorkersBeforeScaleIn(context.Background(), logr.Discard(), mgr)
+	assert.Nil(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	assert.False(t, mgr.IsNodeGroupHeld(consts.ComponentCompute, ""))
+	assert.Empty(t, server.unschedulable)
+	assert.Empty(t, mgr.RisingWaveAfterImage().Status.ComputeScaleIns)
+}
+
+func Test_RisingWaveController_GracefulComputeScaleInCancelled(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.EnableGracefulComputeScaleIn = pointer.Bool(true)
+	risingwave.Spec.Components.Compute.NodeGroups[0].Replicas = 2
+	risingwave.Status.ComputeScaleIns = []risingwavev1alpha1.RisingWaveComputeScaleInStatus{
+		{
+			Group:    "",
+			Replicas: 1,
+			Phase:    risingwavev1alpha1.RisingWaveComputeScaleInPhaseDraining,
+			Workers: []risingwavev1alpha1.RisingWaveComputeWorker{
+				{ID: 2, Host: risingwave.Name + "-compute-1.compute.default.svc", Port: 5688, Pod: risingwave.Name + "-compute-1"},
+			},
+		},
+	}
+	server := newFakeMetaClusterServer(risingwave)
+	server.unschedulable[2] = true
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithObjects(risingwave, newFakeMetaLeaderPod(risingwave), newFakeComputePod(risingwave, 0), newFakeComputePod(risingwave, 1)).
+			Build(),
+		Dial: newInMemoryMetaDialer(t, func(grpcServer *grpc.Server) {
+			pb.RegisterClusterServiceServer(grpcServer, server)
+			pb.RegisterScaleServiceServer(grpcServer, server)
+		}),
+	}
+
+	// Scaled back to 2 replicas, the worker is schedulable again.
+	mgr := object.NewRisingWaveManager(controller.Client, risingwave, false)
+	result, err := controller.drainComputeWorkersBeforeScaleIn(context.Background(), logr.Discard(), mgr)
+	assert.Nil(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	assert.False(t, server.unschedulable[2])
+	assert.Empty(t, mgr.RisingWaveAfterImage().Status.ComputeScaleIns)
+	assert.Equal(t, map[uint32]uint32{1: 1, 2: 2, 3: 2}, server.actors)
+}
+
+func Test_RisingWaveController_GracefulComputeScaleInWaitForMetaLeader(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.EnableGracefulComputeScaleIn = pointer.Bool(true)
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithObjects(risingwave, newFakeComputePod(risingwave, 0), newFakeComputePod(risingwave, 1)).
+			Build(),
+	}
+
+	mgr := object.NewRisingWaveManager(controller.Client, risingwave, false)
+	result, err := controller.drainComputeWorkersBeforeScaleIn(context.Background(), logr.Discard(), mgr)
+	assert.Nil(t, err)
+	assert.Equal(t, RisingWaveComputeScaleInPollInterval, result.RequeueAfter)
+	assert.True(t, mgr.IsNodeGroupHeld(consts.ComponentCompute, ""))
+
+	// Nothing to drain if not enabled.
+	risingwave.Spec.EnableGracefulComputeScaleIn = nil
+	mgr = object.NewRisingWaveManager(controller.Client, risingwave, false)
+	result, err = controller.drainComputeWorkersBeforeScaleIn(context.Background(), logr.Discard(), mgr)
+	assert.Nil(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	assert.Empty(t, mgr.RisingWaveAfterImage().Status.ComputeScaleIns)
+}
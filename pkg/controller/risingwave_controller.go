@@ -25,9 +25,13 @@ import (
 	kruiseappsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
 	kruiseappsv1beta1 "github.com/openkruise/kruise-api/apps/v1beta1"
 	"github.com/risingwavelabs/ctrlkit"
+	"github.com/samber/lo"
 	"golang.org/x/time/rate"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -39,13 +43,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	ctrlevent "sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
 	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
 	"github.com/risingwavelabs/risingwave-operator/pkg/event"
+	"github.com/risingwavelabs/risingwave-operator/pkg/factory"
 	"github.com/risingwavelabs/risingwave-operator/pkg/manager"
 	"github.com/risingwavelabs/risingwave-operator/pkg/metrics"
 	"github.com/risingwavelabs/risingwave-operator/pkg/object"
@@ -54,35 +61,59 @@ import (
 
 // Pre-defined actions. Import from manager package.
 const (
+	RisingWaveAction_SyncEtcdService                            = manager.RisingWaveAction_SyncEtcdService
+	RisingWaveAction_SyncEtcdStatefulSet                        = manager.RisingWaveAction_SyncEtcdStatefulSet
+	RisingWaveAction_WaitBeforeEtcdStatefulSetReady             = manager.RisingWaveAction_WaitBeforeEtcdStatefulSetReady
+	RisingWaveAction_SyncMinIOService                           = manager.RisingWaveAction_SyncMinIOService
+	RisingWaveAction_SyncMinIOCredentialsSecret                 = manager.RisingWaveAction_SyncMinIOCredentialsSecret
+	RisingWaveAction_SyncMinIOStatefulSet                       = manager.RisingWaveAction_SyncMinIOStatefulSet
+	RisingWaveAction_WaitBeforeMinIOStatefulSetReady            = manager.RisingWaveAction_WaitBeforeMinIOStatefulSetReady
+	RisingWaveAction_SyncMinIOBucketJob                         = manager.RisingWaveAction_SyncMinIOBucketJob
+	RisingWaveAction_WaitBeforeMinIOBucketJobCompleted          = manager.RisingWaveAction_WaitBeforeMinIOBucketJobCompleted
+	RisingWaveAction_SyncMetaRestoreJob                         = manager.RisingWaveAction_SyncMetaRestoreJob
+	RisingWaveAction_WaitBeforeMetaRestoreJobCompleted          = manager.RisingWaveAction_WaitBeforeMetaRestoreJobCompleted
 	RisingWaveAction_SyncMetaService                            = manager.RisingWaveAction_SyncMetaService
 	RisingWaveAction_SyncMetaStatefulSets                       = manager.RisingWaveAction_SyncMetaStatefulSets
 	RisingWaveAction_SyncMetaAdvancedStatefulSets               = manager.RisingWaveAction_SyncMetaAdvancedStatefulSets
+	RisingWaveAction_SyncMetaLeaderService                      = manager.RisingWaveAction_SyncMetaLeaderService
+	RisingWaveAction_SyncMetaDashboardIngress                   = manager.RisingWaveAction_SyncMetaDashboardIngress
+	RisingWaveAction_SyncMetaPodDisruptionBudgets               = manager.RisingWaveAction_SyncMetaPodDisruptionBudgets
 	RisingWaveAction_WaitBeforeMetaServiceIsAvailable           = manager.RisingWaveAction_WaitBeforeMetaServiceIsAvailable
 	RisingWaveAction_WaitBeforeMetaStatefulSetsReady            = manager.RisingWaveAction_WaitBeforeMetaStatefulSetsReady
 	RisingWaveAction_WaitBeforeMetaAdvancedStatefulSetsReady    = manager.RisingWaveAction_WaitBeforeMetaAdvancedStatefulSetsReady
 	RisingWaveAction_SyncFrontendService                        = manager.RisingWaveAction_SyncFrontendService
 	RisingWaveAction_SyncFrontendDeployments                    = manager.RisingWaveAction_SyncFrontendDeployments
 	RisingWaveAction_SyncFrontendCloneSets                      = manager.RisingWaveAction_SyncFrontendCloneSets
+	RisingWaveAction_SyncFrontendPodDisruptionBudgets           = manager.RisingWaveAction_SyncFrontendPodDisruptionBudgets
 	RisingWaveAction_WaitBeforeFrontendDeploymentsReady         = manager.RisingWaveAction_WaitBeforeFrontendDeploymentsReady
 	RisingWaveAction_WaitBeforeFrontendCloneSetsReady           = manager.RisingWaveAction_WaitBeforeFrontendCloneSetsReady
 	RisingWaveAction_SyncComputeService                         = manager.RisingWaveAction_SyncComputeService
 	RisingWaveAction_SyncComputeStatefulSets                    = manager.RisingWaveAction_SyncComputeStatefulSets
 	RisingWaveAction_SyncComputeAdvancedStatefulSets            = manager.RisingWaveAction_SyncComputeAdvancedStatefulSets
+	RisingWaveAction_SyncComputePodDisruptionBudgets            = manager.RisingWaveAction_SyncComputePodDisruptionBudgets
 	RisingWaveAction_WaitBeforeComputeStatefulSetsReady         = manager.RisingWaveAction_WaitBeforeComputeStatefulSetsReady
 	RisingWaveAction_WaitBeforeComputeAdvancedStatefulSetsReady = manager.RisingWaveAction_WaitBeforeComputeAdvancedStatefulSetsReady
 	RisingWaveAction_SyncCompactorService                       = manager.RisingWaveAction_SyncCompactorService
 	RisingWaveAction_SyncCompactorDeployments                   = manager.RisingWaveAction_SyncCompactorDeployments
 	RisingWaveAction_SyncCompactorCloneSets                     = manager.RisingWaveAction_SyncCompactorCloneSets
+	RisingWaveAction_SyncCompactorPodDisruptionBudgets          = manager.RisingWaveAction_SyncCompactorPodDisruptionBudgets
 	RisingWaveAction_WaitBeforeCompactorDeploymentsReady        = manager.RisingWaveAction_WaitBeforeCompactorDeploymentsReady
 	RisingWaveAction_WaitBeforeCompactorCloneSetsReady          = manager.RisingWaveAction_WaitBeforeCompactorCloneSetsReady
 	RisingWaveAction_SyncConnectorService                       = manager.RisingWaveAction_SyncConnectorService
 	RisingWaveAction_SyncConnectorDeployments                   = manager.RisingWaveAction_SyncConnectorDeployments
 	RisingWaveAction_SyncConnectorCloneSets                     = manager.RisingWaveAction_SyncConnectorCloneSets
+	RisingWaveAction_SyncConnectorPodDisruptionBudgets          = manager.RisingWaveAction_SyncConnectorPodDisruptionBudgets
 	RisingWaveAction_WaitBeforeConnectorDeploymentsReady        = manager.RisingWaveAction_WaitBeforeConnectorDeploymentsReady
 	RisingWaveAction_WaitBeforeConnectorCloneSetsReady          = manager.RisingWaveAction_WaitBeforeConnectorCloneSetsReady
 	RisingWaveAction_SyncConfigConfigMap                        = manager.RisingWaveAction_SyncConfigConfigMap
 	RisingWaveAction_CollectRunningStatisticsAndSyncStatus      = manager.RisingWaveAction_CollectRunningStatisticsAndSyncStatus
 	RisingWaveAction_SyncServiceMonitor                         = manager.RisingWaveAction_SyncServiceMonitor
+	RisingWaveAction_CollectReferencedObjectsHash               = manager.RisingWaveAction_CollectReferencedObjectsHash
+	RisingWaveAction_CheckRolloutProgressDeadline               = manager.RisingWaveAction_CheckRolloutProgressDeadline
+	RisingWaveAction_SyncRevisionConfigMap                      = manager.RisingWaveAction_SyncRevisionConfigMap
+	RisingWaveAction_RollbackWorkloads                          = manager.RisingWaveAction_RollbackWorkloads
+	RisingWaveAction_CollectPodFailuresAndSyncCondition         = manager.RisingWaveAction_CollectPodFailuresAndSyncCondition
+	RisingWaveAction_CollectEndpointsAndSyncStatus              = manager.RisingWaveAction_CollectEndpointsAndSyncStatus
 )
 
 // Actions defined in controller.
@@ -101,8 +132,26 @@ const (
 	RisingWaveAction_MarkConditionUpgradingAsFalse      = "MarkConditionUpgradingAsFalse"
 	RisingWaveAction_BarrierObservedGenerationOutdated  = "BarrierObservedGenerationOutdated"
 	RisingWaveAction_SyncObservedGeneration             = "SyncObservedGeneration"
+	RisingWaveAction_SyncStoresHash                     = "SyncStoresHash"
+	RisingWaveAction_BarrierReferencedObjectsOutdated   = "BarrierReferencedObjectsOutdated"
+	RisingWaveAction_SyncReferencedObjectsHash          = "SyncReferencedObjectsHash"
 	RisingWaveAction_BarrierPrometheusCRDsInstalled     = "BarrierPrometheusCRDsInstalled"
 	RisingWaveAction_ReleaseScaleViewLock               = "ReleaseScaleViewLock"
+	RisingWaveAction_SyncConditionStopped               = "SyncConditionStopped"
+	RisingWaveAction_BarrierMetaStoreRestored           = "BarrierMetaStoreRestored"
+	RisingWaveAction_SyncVersion                        = "SyncVersion"
+	RisingWaveAction_SyncUpgradePhase                   = "SyncUpgradePhase"
+	RisingWaveAction_SyncRollout                        = "SyncRollout"
+	RisingWaveAction_BarrierRolloutFailed               = "BarrierRolloutFailed"
+	RisingWaveAction_ClearRollout                       = "ClearRollout"
+	RisingWaveAction_DrainComputeWorkersBeforeScaleIn   = "DrainComputeWorkersBeforeScaleIn"
+	RisingWaveAction_UnregisterScaledInComputeWorkers   = "UnregisterScaledInComputeWorkers"
+)
+
+// Field indexes of RisingWave.
+const (
+	risingWaveReferencedConfigMapsIndexKey = ".referencedConfigMaps"
+	risingWaveReferencedSecretsIndexKey    = ".referencedSecrets"
 )
 
 // +kubebuilder:rbac:groups=risingwave.risingwavelabs.com,resources=risingwaves,verbs=get;list;watch;create;update;patch;delete
@@ -115,6 +164,10 @@ const (
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;delete;
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 // +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;list;watch;create;delete;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
@@ -124,6 +177,7 @@ type RisingWaveController struct {
 	Client              client.Client
 	Recorder            record.EventRecorder
 	ActionHookFactory   func() ctrlkit.ActionHook
+	Dial                MetaDialer
 	forceUpdateEnabled  bool
 	openKruiseAvailable bool
 	operatorVersion     string
@@ -206,8 +260,9 @@ func (c *RisingWaveController) Reconcile(ctx context.Context, request reconcile.
 }
 
 func (c *RisingWaveController) reactiveWorkflow(risingwaveManger *object.RisingWaveManager, mgr *manager.RisingWaveControllerManager) ctrlkit.Action {
+	// It's still the first time observed if the meta store is being restored.
 	firstTimeObservedBarrier := mgr.NewAction(RisingWaveAction_BarrierFirstTimeObserved, func(ctx context.Context, logger logr.Logger) (ctrl.Result, error) {
-		return ctrlkit.ExitIf(len(risingwaveManger.RisingWave().Status.Conditions) != 0)
+		return ctrlkit.ExitIf(len(risingwaveManger.RisingWave().Status.Conditions) != 0 && !risingwaveManger.IsRestoringMetaStore())
 	})
 	markConditionInitializingAsTrue := mgr.NewAction(RisingWaveAction_MarkConditionInitializingAsTrue, func(ctx context.Context, logger logr.Logger) (ctrl.Result, error) {
 		risingwaveManger.UpdateCondition(risingwavev1alpha1.RisingWaveCondition{
@@ -267,10 +322,44 @@ func (c *RisingWaveController) reactiveWorkflow(risingwaveManger *object.RisingW
 	})
 	syncConfigs := mgr.SyncConfigConfigMap()
 
+	syncManagedEtcd := ctrlkit.ParallelJoin(
+		mgr.SyncEtcdService(),
+		mgr.SyncEtcdStatefulSet(),
+	)
+	managedEtcdReadyBarrier := mgr.WaitBeforeEtcdStatefulSetReady()
+	syncManagedMinIO := ctrlkit.ParallelJoin(
+		mgr.SyncMinIOService(),
+		mgr.SyncMinIOCredentialsSecret(),
+		mgr.SyncMinIOStatefulSet(),
+	)
+	managedMinIOReadyBarrier := ctrlkit.Sequential(
+		mgr.WaitBeforeMinIOStatefulSetReady(),
+		mgr.SyncMinIOBucketJob(),
+		mgr.WaitBeforeMinIOBucketJobCompleted(),
+	)
+	// Restore the meta store from the snapshot with the managed stores ready, before the meta nodes are started.
+	restoreMetaStore := ctrlkit.Sequential(
+		ctrlkit.If(risingwaveManger.IsMetaStoreEtcdManaged(), ctrlkit.Sequential(syncManagedEtcd, managedEtcdReadyBarrier)),
+		ctrlkit.If(risingwaveManger.IsStateStoreMinIOManaged(), ctrlkit.Sequential(syncManagedMinIO, managedMinIOReadyBarrier)),
+		mgr.SyncMetaRestoreJob(),
+		mgr.WaitBeforeMetaRestoreJobCompleted(),
+	)
+	metaStoreRestoredBarrier := mgr.NewAction(RisingWaveAction_BarrierMetaStoreRestored, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
+		return ctrlkit.ExitIf(risingwaveManger.IsRestoringMetaStore())
+	})
 	syncMetaComponent := ctrlkit.ParallelJoin(
 		mgr.SyncMetaService(),
+		mgr.SyncMetaLeaderService(),
+		mgr.SyncMetaDashboardIngress(),
 		mgr.SyncMetaStatefulSets(),
 		ctrlkit.If(c.openKruiseAvailable, mgr.SyncMetaAdvancedStatefulSets()),
+		mgr.SyncMetaPodDisruptionBudgets(),
+	)
+	// Sync the managed etcd and MinIO and wait before they're ready if there are, and then sync the meta.
+	syncManagedStoresAndMetaComponent := ctrlkit.Sequential(
+		ctrlkit.If(risingwaveManger.IsMetaStoreEtcdManaged(), ctrlkit.Sequential(syncManagedEtcd, managedEtcdReadyBarrier)),
+		ctrlkit.If(risingwaveManger.IsStateStoreMinIOManaged(), ctrlkit.Sequential(syncManagedMinIO, managedMinIOReadyBarrier)),
+		syncMetaComponent,
 	)
 	metaComponentReadyBarrier := ctrlkit.Sequential(
 		mgr.WaitBeforeMetaStatefulSetsReady(),
@@ -295,28 +384,64 @@ func (c *RisingWaveController) reactiveWorkflow(risingwaveManger *object.RisingW
 		pointer.BoolDeref(risingwaveManger.RisingWave().Spec.EnableDefaultServiceMonitor, false),
 		ctrlkit.Sequential(prometheusCRDsInstalledBarrier, mgr.SyncServiceMonitor()),
 	)
-	syncOtherComponents := ctrlkit.ParallelJoin(
-		ctrlkit.Sequential(
-			mgr.SyncComputeService(),
-			mgr.SyncComputeStatefulSets(),
-			ctrlkit.If(c.openKruiseAvailable, mgr.SyncComputeAdvancedStatefulSets()),
-		),
-		ctrlkit.ParallelJoin(
-			mgr.SyncCompactorService(),
-			mgr.SyncCompactorDeployments(),
-			ctrlkit.If(c.openKruiseAvailable, mgr.SyncCompactorCloneSets()),
-		),
-		ctrlkit.ParallelJoin(
-			mgr.SyncFrontendService(),
-			mgr.SyncFrontendDeployments(),
-			ctrlkit.If(c.openKruiseAvailable, mgr.SyncFrontendCloneSets()),
-		),
-		ctrlkit.ParallelJoin(
-			mgr.SyncConnectorService(),
-			mgr.SyncConnectorDeployments(),
-			ctrlkit.If(c.openKruiseAvailable, mgr.SyncConnectorCloneSets()),
+	drainComputeWorkersBeforeScaleIn := mgr.NewAction(RisingWaveAction_DrainComputeWorkersBeforeScaleIn, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
+		return c.drainComputeWorkersBeforeScaleIn(ctx, l, risingwaveManger)
+	})
+	unregisterScaledInComputeWorkers := mgr.NewAction(RisingWaveAction_UnregisterScaledInComputeWorkers, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
+		return c.unregisterScaledInComputeWorkers(ctx, l, risingwaveManger)
+	})
+	// Drain the compute workers to be removed before scaling in the workloads if enabled. The node groups being
+	// drained are held, while the others are synced without waiting for the drain.
+	syncComputeComponent := ctrlkit.Sequential(
+		mgr.SyncComputeService(),
+		ctrlkit.Join(
+			drainComputeWorkersBeforeScaleIn,
+			ctrlkit.Sequential(
+				mgr.SyncComputeStatefulSets(),
+				ctrlkit.If(c.openKruiseAvailable, mgr.SyncComputeAdvancedStatefulSets()),
+				mgr.SyncComputePodDisruptionBudgets(),
+			),
 		),
 	)
+	syncCompactorComponent := ctrlkit.ParallelJoin(
+		mgr.SyncCompactorService(),
+		mgr.SyncCompactorDeployments(),
+		ctrlkit.If(c.openKruiseAvailable, mgr.SyncCompactorCloneSets()),
+		mgr.SyncCompactorPodDisruptionBudgets(),
+	)
+	syncFrontendComponent := ctrlkit.ParallelJoin(
+		mgr.SyncFrontendService(),
+		mgr.SyncFrontendDeployments(),
+		ctrlkit.If(c.openKruiseAvailable, mgr.SyncFrontendCloneSets()),
+		mgr.SyncFrontendPodDisruptionBudgets(),
+	)
+	syncConnectorComponent := ctrlkit.ParallelJoin(
+		mgr.SyncConnectorService(),
+		mgr.SyncConnectorDeployments(),
+		ctrlkit.If(c.openKruiseAvailable, mgr.SyncConnectorCloneSets()),
+		mgr.SyncConnectorPodDisruptionBudgets(),
+	)
+	syncFrontendAndConnectorComponents := ctrlkit.ParallelJoin(
+		syncFrontendComponent,
+		syncConnectorComponent,
+	)
+	syncOtherComponents := ctrlkit.ParallelJoin(
+		syncComputeComponent,
+		syncCompactorComponent,
+		syncFrontendAndConnectorComponents,
+	)
+	computeComponentReadyBarrier := ctrlkit.Sequential(
+		mgr.WaitBeforeComputeStatefulSetsReady(),
+		ctrlkit.If(c.openKruiseAvailable, mgr.WaitBeforeComputeAdvancedStatefulSetsReady()),
+	)
+	compactorComponentReadyBarrier := ctrlkit.Sequential(
+		mgr.WaitBeforeCompactorDeploymentsReady(),
+		ctrlkit.If(c.openKruiseAvailable, mgr.WaitBeforeCompactorCloneSetsReady()),
+	)
+	connectorComponentReadyBarrier := ctrlkit.Sequential(
+		mgr.WaitBeforeConnectorDeploymentsReady(),
+		ctrlkit.If(c.openKruiseAvailable, mgr.WaitBeforeConnectorCloneSetsReady()),
+	)
 	otherOpenKruiseComponentsReadyBarrier := ctrlkit.ParallelJoin(
 		mgr.WaitBeforeFrontendCloneSetsReady(),
 		mgr.WaitBeforeComputeAdvancedStatefulSetsReady(),
@@ -331,7 +456,49 @@ func (c *RisingWaveController) reactiveWorkflow(risingwaveManger *object.RisingW
 		mgr.WaitBeforeConnectorDeploymentsReady(),
 		ctrlkit.If(c.openKruiseAvailable, otherOpenKruiseComponentsReadyBarrier),
 	)
-	syncAllComponents := ctrlkit.ParallelJoin(syncConfigs, syncMetaComponent, syncOtherComponents)
+	// When the credentials of the stores are rotated, roll the components one by one in the order of
+	// meta, compute and compactor, so that there are always nodes that are able to access the stores.
+	// It's also used when resuming from the stopped state, to make sure meta is up before the others.
+	syncAllComponentsInOrder := ctrlkit.Sequential(
+		syncManagedStoresAndMetaComponent,
+		metaComponentReadyBarrier,
+		syncComputeComponent,
+		computeComponentReadyBarrier,
+		syncCompactorComponent,
+		compactorComponentReadyBarrier,
+		syncFrontendAndConnectorComponents,
+	)
+	syncUpgradePhase := func(phase risingwavev1alpha1.RisingWaveUpgradePhase, pendingComponents ...string) ctrlkit.Action {
+		return mgr.NewAction(RisingWaveAction_SyncUpgradePhase, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
+			risingwaveManger.SetUpgradePhase(phase, pendingComponents...)
+			return ctrlkit.Continue()
+		})
+	}
+	// When the version is changed, upgrade the components phase by phase in the order of meta, compute,
+	// compactor and connector, and frontend, so that the components are never talking to a meta of an older
+	// version. It also covers the cases of rotating credentials and resuming.
+	syncAllComponentsInVersionOrder := ctrlkit.Sequential(
+		syncUpgradePhase(risingwavev1alpha1.RisingWaveUpgradePhaseMeta,
+			consts.ComponentCompute, consts.ComponentCompactor, consts.ComponentConnector, consts.ComponentFrontend),
+		syncManagedStoresAndMetaComponent,
+		metaComponentReadyBarrier,
+		syncUpgradePhase(risingwavev1alpha1.RisingWaveUpgradePhaseCompute,
+			consts.ComponentCompactor, consts.ComponentConnector, consts.ComponentFrontend),
+		syncComputeComponent,
+		computeComponentReadyBarrier,
+		syncUpgradePhase(risingwavev1alpha1.RisingWaveUpgradePhaseCompactorAndConnector, consts.ComponentFrontend),
+		ctrlkit.ParallelJoin(syncCompactorComponent, syncConnectorComponent),
+		ctrlkit.Join(compactorComponentReadyBarrier, connectorComponentReadyBarrier),
+		syncUpgradePhase(risingwavev1alpha1.RisingWaveUpgradePhaseFrontend),
+		syncFrontendComponent,
+	)
+	syncAllComponents := ctrlkit.IfElse(risingwaveManger.IsVersionUpgrading(),
+		syncAllComponentsInVersionOrder,
+		ctrlkit.IfElse(risingwaveManger.IsStoreCredentialsRotating() || risingwaveManger.IsResuming(),
+			syncAllComponentsInOrder,
+			ctrlkit.ParallelJoin(syncConfigs, syncManagedStoresAndMetaComponent, syncOtherComponents),
+		),
+	)
 	allComponentsReadyBarrier := ctrlkit.Join(metaComponentReadyBarrier, otherComponentsReadyBarrier)
 
 	observedGenerationOutdatedBarrier := mgr.NewAction(RisingWaveAction_BarrierObservedGenerationOutdated, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
@@ -341,16 +508,89 @@ func (c *RisingWaveController) reactiveWorkflow(risingwaveManger *object.RisingW
 		risingwaveManger.SyncObservedGeneration()
 		return ctrlkit.Continue()
 	})
+	syncVersion := mgr.NewAction(RisingWaveAction_SyncVersion, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
+		risingwaveManger.SyncVersion()
+		risingwaveManger.RemoveCondition(risingwavev1alpha1.RisingWaveConditionCanaryInProgress)
+		return ctrlkit.Continue()
+	})
+	syncStoresHash := mgr.NewAction(RisingWaveAction_SyncStoresHash, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
+		risingwaveManger.SyncStoresHash()
+		return ctrlkit.Continue()
+	})
+	referencedObjectsOutdatedBarrier := mgr.NewAction(RisingWaveAction_BarrierReferencedObjectsOutdated, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
+		return ctrlkit.ExitIf(!risingwaveManger.IsReferencedObjectsOutdated())
+	})
+	syncReferencedObjectsHash := mgr.NewAction(RisingWaveAction_SyncReferencedObjectsHash, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
+		risingwaveManger.SyncReferencedObjectsHash()
+		return ctrlkit.Continue()
+	})
+	syncConditionStopped := mgr.NewAction(RisingWaveAction_SyncConditionStopped, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
+		if risingwaveManger.IsStopped() {
+			risingwaveManger.UpdateCondition(risingwavev1alpha1.RisingWaveCondition{
+				Type:   risingwavev1alpha1.RisingWaveConditionStopped,
+				Status: metav1.ConditionTrue,
+			})
+		} else if risingwaveManger.GetCondition(risingwavev1alpha1.RisingWaveConditionStopped) != nil {
+			risingwaveManger.UpdateCondition(risingwavev1alpha1.RisingWaveCondition{
+				Type:   risingwavev1alpha1.RisingWaveConditionStopped,
+				Status: metav1.ConditionFalse,
+			})
+		}
+		return ctrlkit.Continue()
+	})
+	syncRollout := mgr.NewAction(RisingWaveAction_SyncRollout, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
+		// Clear the failure of the previous generation. A new rollout begins.
+		rollout := risingwaveManger.RisingWave().Status.Rollout
+		if rollout != nil && rollout.Failed && !risingwaveManger.IsRolloutFailed() {
+			failedCondition := risingwaveManger.GetCondition(risingwavev1alpha1.RisingWaveConditionFailed)
+			if failedCondition != nil && failedCondition.Reason == "ProgressDeadlineExceeded" {
+				risingwaveManger.RemoveCondition(risingwavev1alpha1.RisingWaveConditionFailed)
+			}
+		}
+		risingwaveManger.SyncRollout()
+		return ctrlkit.Continue()
+	})
+	rolloutFailedBarrier := mgr.NewAction(RisingWaveAction_BarrierRolloutFailed, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
+		return ctrlkit.ExitIf(risingwaveManger.IsRolloutFailed())
+	})
+	clearRollout := mgr.NewAction(RisingWaveAction_ClearRollout, func(ctx context.Context, l logr.Logger) (ctrl.Result, error) {
+		risingwaveManger.ClearRollout()
+		return ctrlkit.Continue()
+	})
+	collectReferencedObjectsHash := mgr.CollectReferencedObjectsHash()
 	syncRunningStatus := ctrlkit.IfElse(risingwaveManger.IsOpenKruiseEnabled(), mgr.CollectOpenKruiseRunningStatisticsAndSyncStatus(), mgr.CollectRunningStatisticsAndSyncStatus())
 	syncAllAndWait := ctrlkit.Sequential(
+		// Never start the components before the meta store is restored.
+		metaStoreRestoredBarrier,
+
+		// Record the start of the rollout, and never sync the workloads again once the rollout of the
+		// current generation has failed.
+		syncRollout,
+		rolloutFailedBarrier,
+
 		// Set .status.observedGeneration = .metadata.generation
 		syncObservedGeneration,
 
+		// Collect the hash of the referenced ConfigMaps and Secrets before rolling out.
+		collectReferencedObjectsHash,
+
 		// Sync ConfigMap, and then all component groups, and wait before the components are ready.
 		// If possible, also sync the service monitor.
 		syncConfigs,
 		syncAllComponents,
 		allComponentsReadyBarrier,
+
+		// Record the version, the stores and the referenced objects that have been rolled out.
+		syncVersion,
+		syncStoresHash,
+		syncReferencedObjectsHash,
+
+		// Record the pod templates as the last known-good revision if auto rollback is enabled.
+		ctrlkit.If(risingwaveManger.IsAutoRollbackEnabled(), mgr.SyncRevisionConfigMap()),
+		clearRollout,
+
+		// Record if the components are stopped or resumed.
+		syncConditionStopped,
 	)
 	sharedSyncAllAndWait := ctrlkit.Shared(syncAllAndWait)
 
@@ -386,9 +626,10 @@ func (c *RisingWaveController) reactiveWorkflow(risingwaveManger *object.RisingW
 	})
 
 	return ctrlkit.ParallelJoin(
-		// => Initializing (Running=false)
+		// (=> Restoring) => Initializing (Running=false)
 		ctrlkit.Sequential(
 			firstTimeObservedBarrier,
+			ctrlkit.If(risingwaveManger.IsRestoringMetaStore(), restoreMetaStore),
 			markConditionInitializingAsTrue,
 			markConditionRunningAsFalse,
 		),
@@ -420,6 +661,17 @@ func (c *RisingWaveController) reactiveWorkflow(risingwaveManger *object.RisingW
 			markConditionUpgradingAsTrue,
 		),
 
+		// Running maintenance, => Upgrading when the referenced ConfigMaps or Secrets are changed
+		ctrlkit.Sequential(
+			conditionRunningIsTrueBarrier,
+
+			rolloutFailedBarrier,
+			collectReferencedObjectsHash,
+			referencedObjectsOutdatedBarrier,
+
+			markConditionUpgradingAsTrue,
+		),
+
 		// Upgrading or Recovering (Running=false)
 		ctrlkit.Sequential(
 			conditionUpgradingIsTrueBarrier,
@@ -429,17 +681,98 @@ func (c *RisingWaveController) reactiveWorkflow(risingwaveManger *object.RisingW
 			markConditionUpgradingAsFalse,
 		),
 
+		// Upgrading, => Failed (Upgrading=false) when the progress deadline is exceeded
+		ctrlkit.Sequential(
+			conditionUpgradingIsTrueBarrier,
+
+			mgr.CheckRolloutProgressDeadline(),
+
+			markConditionUpgradingAsFalse,
+		),
+
+		// Failed, => roll back once if enabled
+		ctrlkit.If(risingwaveManger.IsAutoRollbackEnabled() && risingwaveManger.IsRolloutFailed() &&
+			!risingwaveManger.IsRolloutRolledBack(), mgr.RollbackWorkloads()),
+
 		// Sync running status, such as storage status, component replicas and
-		// if it's not running, turn it to Running=false.
-		syncRunningStatus,
+		// if it's not running, turn it to Running=false. Then report the pod failures
+		// in the condition Failed.
+		ctrlkit.Sequential(
+			syncRunningStatus,
+			mgr.CollectPodFailuresAndSyncCondition(),
+		),
+
+		// Report the meta leader and the endpoints to connect to.
+		mgr.CollectEndpointsAndSyncStatus(),
 
 		// Always sync the service monitor if possible.
 		syncServiceMonitorIfPossible,
 
+		// Unregister the compute workers from meta once they're drained and gone.
+		unregisterScaledInComputeWorkers,
+
 		releaseScaleViewLock,
 	)
 }
 
+func (c *RisingWaveController) referencedConfigMapsAndSecrets(risingwave *risingwavev1alpha1.RisingWave) (configMaps []string, secrets []string) {
+	objectFactory := factory.NewRisingWaveObjectFactory(risingwave, c.Client.Scheme(), c.operatorVersion)
+	reader := object.NewRisingWaveReader(risingwave)
+
+	for _, component := range []string{
+		consts.ComponentMeta,
+		consts.ComponentCompute,
+		consts.ComponentCompactor,
+		consts.ComponentFrontend,
+		consts.ComponentConnector,
+	} {
+		for _, nodeGroup := range reader.GetNodeGroups(component) {
+			groupConfigMaps, groupSecrets := objectFactory.ReferencedConfigMapsAndSecrets(component, nodeGroup.Name)
+			configMaps = append(configMaps, groupConfigMaps...)
+			secrets = append(secrets, groupSecrets...)
+		}
+	}
+
+	return lo.Uniq(configMaps), lo.Uniq(secrets)
+}
+
+// enqueueRisingWavesReferencing returns a map function that enqueues the RisingWaves referencing the object by
+// looking up the given index.
+func (c *RisingWaveController) enqueueRisingWavesReferencing(indexKey string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		var risingwaves risingwavev1alpha1.RisingWaveList
+		if err := c.Client.List(ctx, &risingwaves, client.InNamespace(obj.GetNamespace()),
+			client.MatchingFields{indexKey: obj.GetName()}); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to list RisingWaves referencing the object", "object", utils.GetNamespacedName(obj))
+			return nil
+		}
+
+		return lo.Map(risingwaves.Items, func(risingwave risingwavev1alpha1.RisingWave, _ int) reconcile.Request {
+			return reconcile.Request{NamespacedName: utils.GetNamespacedName(&risingwave)}
+		})
+	}
+}
+
+func (c *RisingWaveController) indexReferencedConfigMapsAndSecrets(mgr ctrl.Manager) error {
+	fieldIndexer := mgr.GetFieldIndexer()
+
+	if err := fieldIndexer.IndexField(context.Background(), &risingwavev1alpha1.RisingWave{}, risingWaveReferencedConfigMapsIndexKey, func(obj client.Object) []string {
+		configMaps, _ := c.referencedConfigMapsAndSecrets(obj.(*risingwavev1alpha1.RisingWave))
+		return configMaps
+	}); err != nil {
+		return fmt.Errorf("unable to index referenced configmaps: %w", err)
+	}
+
+	if err := fieldIndexer.IndexField(context.Background(), &risingwavev1alpha1.RisingWave{}, risingWaveReferencedSecretsIndexKey, func(obj client.Object) []string {
+		_, secrets := c.referencedConfigMapsAndSecrets(obj.(*risingwavev1alpha1.RisingWave))
+		return secrets
+	}); err != nil {
+		return fmt.Errorf("unable to index referenced secrets: %w", err)
+	}
+
+	return nil
+}
+
 // SetupWithManager sets up the controller with a given manager.
 func (c *RisingWaveController) SetupWithManager(mgr ctrl.Manager) error {
 	gvk, err := apiutil.GVKForObject(&risingwavev1alpha1.RisingWave{}, c.Client.Scheme())
@@ -447,6 +780,11 @@ func (c *RisingWaveController) SetupWithManager(mgr ctrl.Manager) error {
 		return fmt.Errorf("unable to find gvk for RisingWave: %w", err)
 	}
 
+	// Index the referenced ConfigMaps and Secrets, so that the RisingWaves can be rolled when they're changed.
+	if err := c.indexReferencedConfigMapsAndSecrets(mgr); err != nil {
+		return err
+	}
+
 	newCtrl := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: 64,
@@ -465,6 +803,10 @@ func (c *RisingWaveController) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&appsv1.StatefulSet{}).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.ConfigMap{}).
+		Owns(&corev1.Secret{}).
+		Owns(&batchv1.Job{}).
+		Owns(&networkingv1.Ingress{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
 		Watches(
 			&risingwavev1alpha1.RisingWaveScaleView{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
@@ -480,6 +822,35 @@ func (c *RisingWaveController) SetupWithManager(mgr ctrl.Manager) error {
 			}),
 			// Watch on only delete events.
 			builder.WithPredicates(utils.DeleteEventFilter),
+		).
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(c.enqueueRisingWavesReferencing(risingWaveReferencedConfigMapsIndexKey)),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(c.enqueueRisingWavesReferencing(risingWaveReferencedSecretsIndexKey)),
+		).
+		Watches(
+			&corev1.Pod{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+				return []reconcile.Request{
+					{
+						NamespacedName: types.NamespacedName{
+							Namespace: obj.GetNamespace(),
+							Name:      obj.GetLabels()[consts.LabelRisingWaveName],
+						},
+					},
+				}
+			}),
+			// Watch on only the changes of the meta roles, to report the leader in the status.
+			builder.WithPredicates(utils.UpdateEventFilter, predicate.Funcs{
+				UpdateFunc: func(e ctrlevent.UpdateEvent) bool {
+					oldLabels, newLabels := e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels()
+					return newLabels[consts.LabelRisingWaveComponent] == consts.ComponentMeta && newLabels[consts.LabelRisingWaveName] != "" &&
+						oldLabels[consts.LabelRisingWaveMetaRole] != newLabels[consts.LabelRisingWaveMetaRole]
+				},
+			}),
 		)
 
 	if c.openKruiseAvailable {
@@ -491,10 +862,11 @@ func (c *RisingWaveController) SetupWithManager(mgr ctrl.Manager) error {
 }
 
 // NewRisingWaveController creates a new RisingWaveController.
-func NewRisingWaveController(client client.Client, recorder record.EventRecorder, openKruiseAvailable, forceUpdateEnabled bool, operatorVersion string) *RisingWaveController {
+func NewRisingWaveController(client client.Client, recorder record.EventRecorder, openKruiseAvailable, forceUpdateEnabled bool, operatorVersion string, dial MetaDialer) *RisingWaveController {
 	return &RisingWaveController{
 		Client:              client,
 		Recorder:            recorder,
+		Dial:                dial,
 		openKruiseAvailable: openKruiseAvailable,
 		forceUpdateEnabled:  forceUpdateEnabled,
 		operatorVersion:     operatorVersion,
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -27,9 +28,14 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -38,6 +44,8 @@ import (
 	"github.com/risingwavelabs/ctrlkit"
 
 	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
+	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+	"github.com/risingwavelabs/risingwave-operator/pkg/factory"
 	"github.com/risingwavelabs/risingwave-operator/pkg/object"
 	"github.com/risingwavelabs/risingwave-operator/pkg/testutils"
 )
@@ -330,3 +338,560 @@ func Test_RisingWaveController_Recovery(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func Test_RisingWaveController_RotatingStoreCredentials(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Status = risingwavev1alpha1.RisingWaveStatus{
+		ObservedGeneration: risingwave.Generation,
+		Conditions: []risingwavev1alpha1.RisingWaveCondition{
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionRunning,
+				Status: metav1.ConditionTrue,
+			},
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionUpgrading,
+				Status: metav1.ConditionTrue,
+			},
+		},
+		StoresHash: "outdated",
+	}
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithStatusSubresource(&risingwavev1alpha1.RisingWave{}).
+			WithObjects(risingwave).
+			Build(),
+		Recorder: record.NewFakeRecorder(defaultRecorderBufferSize),
+		ActionHookFactory: func() ctrlkit.ActionHook {
+			return newActionAsserts(t, map[string]resultErr{
+				RisingWaveAction_BarrierConditionUpgradingIsTrue: newResultErr(ctrlkit.Continue()),
+				RisingWaveAction_SyncMetaStatefulSets:            newResultErr(ctrlkit.Continue()),
+				RisingWaveAction_WaitBeforeMetaStatefulSetsReady: newResultErr(ctrlkit.Exit()),
+			}, false)
+		},
+	}
+
+	logger := zap.New(zap.UseDevMode(true))
+	_, err := controller.Reconcile(log.IntoContext(context.Background(), logger), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: risingwave.Name, Namespace: risingwave.Namespace},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Compute must not be rolled before meta is ready.
+	var computeStatefulSets appsv1.StatefulSetList
+	if err := controller.Client.List(context.Background(), &computeStatefulSets, client.InNamespace(risingwave.Namespace),
+		client.MatchingLabels{consts.LabelRisingWaveComponent: consts.ComponentCompute}); err != nil {
+		t.Fatal(err)
+	}
+	if len(computeStatefulSets.Items) != 0 {
+		t.Fatal("compute synced before meta is ready")
+	}
+
+	var currentRisingwave risingwavev1alpha1.RisingWave
+	if err := controller.Client.Get(context.Background(), types.NamespacedName{
+		Name:      risingwave.Name,
+		Namespace: risingwave.Namespace,
+	}, &currentRisingwave); err != nil {
+		t.Fatal(err)
+	}
+	if currentRisingwave.Status.StoresHash != "outdated" {
+		t.Fatal("stores hash synced before all components are ready")
+	}
+}
+
+func Test_RisingWaveController_Stopped(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.Stopped = pointer.Bool(true)
+	risingwave.Status = risingwavev1alpha1.RisingWaveStatus{
+		ObservedGeneration: risingwave.Generation,
+		Conditions: []risingwavev1alpha1.RisingWaveCondition{
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionRunning,
+				Status: metav1.ConditionTrue,
+			},
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionUpgrading,
+				Status: metav1.ConditionTrue,
+			},
+		},
+	}
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithStatusSubresource(&risingwavev1alpha1.RisingWave{}).
+			WithObjects(risingwave).
+			Build(),
+		Recorder: record.NewFakeRecorder(defaultRecorderBufferSize),
+		ActionHookFactory: func() ctrlkit.ActionHook {
+			return newActionAsserts(t, map[string]resultErr{
+				RisingWaveAction_WaitBeforeMetaStatefulSetsReady:    newResultErr(ctrlkit.Continue()),
+				RisingWaveAction_WaitBeforeComputeStatefulSetsReady: newResultErr(ctrlkit.Continue()),
+				RisingWaveAction_SyncConditionStopped:               newResultErr(ctrlkit.Continue()),
+			}, false)
+		},
+	}
+
+	logger := zap.New(zap.UseDevMode(true))
+	_, err := controller.Reconcile(log.IntoContext(context.Background(), logger), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: risingwave.Name, Namespace: risingwave.Namespace},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var computeStatefulSets appsv1.StatefulSetList
+	if err := controller.Client.List(context.Background(), &computeStatefulSets, client.InNamespace(risingwave.Namespace),
+		client.MatchingLabels{consts.LabelRisingWaveComponent: consts.ComponentCompute}); err != nil {
+		t.Fatal(err)
+	}
+	if len(computeStatefulSets.Items) == 0 {
+		t.Fatal("compute not synced")
+	}
+	for _, sts := range computeStatefulSets.Items {
+		if *sts.Spec.Replicas != 0 {
+			t.Fatal("compute not scaled to zero")
+		}
+	}
+
+	var currentRisingwave risingwavev1alpha1.RisingWave
+	if err := controller.Client.Get(context.Background(), types.NamespacedName{
+		Name:      risingwave.Name,
+		Namespace: risingwave.Namespace,
+	}, &currentRisingwave); err != nil {
+		t.Fatal(err)
+	}
+	if currentRisingwave.Spec.Components.Compute.NodeGroups[0].Replicas == 0 {
+		t.Fatal("replicas in spec changed")
+	}
+
+	risingwaveManager := object.NewRisingWaveManager(nil, &currentRisingwave, false)
+	if !risingwaveManager.DoesConditionExistAndEqual(risingwavev1alpha1.RisingWaveConditionStopped, true) {
+		t.Fatal("Stopped condition not true")
+	}
+	if !risingwaveManager.DoesConditionExistAndEqual(risingwavev1alpha1.RisingWaveConditionUpgrading, false) {
+		t.Fatal("Upgrading condition not false")
+	}
+}
+
+func Test_RisingWaveController_Resuming(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.Stopped = pointer.Bool(false)
+	risingwave.Status = risingwavev1alpha1.RisingWaveStatus{
+		ObservedGeneration: risingwave.Generation,
+		Conditions: []risingwavev1alpha1.RisingWaveCondition{
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionRunning,
+				Status: metav1.ConditionTrue,
+			},
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionUpgrading,
+				Status: metav1.ConditionTrue,
+			},
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionStopped,
+				Status: metav1.ConditionTrue,
+			},
+		},
+	}
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithStatusSubresource(&risingwavev1alpha1.RisingWave{}).
+			WithObjects(risingwave).
+			Build(),
+		Recorder: record.NewFakeRecorder(defaultRecorderBufferSize),
+		ActionHookFactory: func() ctrlkit.ActionHook {
+			return newActionAsserts(t, map[string]resultErr{
+				RisingWaveAction_SyncMetaStatefulSets:            newResultErr(ctrlkit.Continue()),
+				RisingWaveAction_WaitBeforeMetaStatefulSetsReady: newResultErr(ctrlkit.Exit()),
+			}, false)
+		},
+	}
+
+	logger := zap.New(zap.UseDevMode(true))
+	_, err := controller.Reconcile(log.IntoContext(context.Background(), logger), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: risingwave.Name, Namespace: risingwave.Namespace},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Compute must not be brought up before meta is ready.
+	var computeStatefulSets appsv1.StatefulSetList
+	if err := controller.Client.List(context.Background(), &computeStatefulSets, client.InNamespace(risingwave.Namespace),
+		client.MatchingLabels{consts.LabelRisingWaveComponent: consts.ComponentCompute}); err != nil {
+		t.Fatal(err)
+	}
+	if len(computeStatefulSets.Items) != 0 {
+		t.Fatal("compute synced before meta is ready")
+	}
+
+	var currentRisingwave risingwavev1alpha1.RisingWave
+	if err := controller.Client.Get(context.Background(), types.NamespacedName{
+		Name:      risingwave.Name,
+		Namespace: risingwave.Namespace,
+	}, &currentRisingwave); err != nil {
+		t.Fatal(err)
+	}
+
+	risingwaveManager := object.NewRisingWaveManager(nil, &currentRisingwave, false)
+	if !risingwaveManager.DoesConditionExistAndEqual(risingwavev1alpha1.RisingWaveConditionStopped, true) {
+		t.Fatal("Stopped condition changed before all components are ready")
+	}
+}
+
+func Test_RisingWaveController_Restoring(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+		Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+			Endpoint: "etcd:2379",
+		},
+	}
+	risingwave.Spec.RestoreFrom = &risingwavev1alpha1.RisingWaveRestoreFrom{
+		SnapshotID: 1,
+		StateStore: risingwavev1alpha1.RisingWaveStateStoreBackend{
+			MinIO: &risingwavev1alpha1.RisingWaveStateStoreBackendMinIO{
+				Endpoint: "minio:9000",
+				Bucket:   "hummock",
+			},
+		},
+		BackupDirectory: "backup",
+	}
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithStatusSubresource(&risingwavev1alpha1.RisingWave{}, &batchv1.Job{}).
+			WithObjects(risingwave).
+			Build(),
+		Recorder: record.NewFakeRecorder(defaultRecorderBufferSize),
+		ActionHookFactory: func() ctrlkit.ActionHook {
+			return newActionAsserts(t, map[string]resultErr{
+				RisingWaveAction_SyncMetaRestoreJob:                newResultErr(ctrlkit.Continue()),
+				RisingWaveAction_WaitBeforeMetaRestoreJobCompleted: newResultErr(ctrlkit.Exit()),
+			}, false)
+		},
+	}
+
+	reconcileAndGet := func() *risingwavev1alpha1.RisingWave {
+		logger := zap.New(zap.UseDevMode(true))
+		_, err := controller.Reconcile(log.IntoContext(context.Background(), logger), reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: risingwave.Name, Namespace: risingwave.Namespace},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var currentRisingwave risingwavev1alpha1.RisingWave
+		if err := controller.Client.Get(context.Background(), client.ObjectKeyFromObject(risingwave), &currentRisingwave); err != nil {
+			t.Fatal(err)
+		}
+		return &currentRisingwave
+	}
+
+	currentRisingwave := reconcileAndGet()
+
+	// Meta must not be brought up before the meta store is restored.
+	var metaStatefulSets appsv1.StatefulSetList
+	if err := controller.Client.List(context.Background(), &metaStatefulSets, client.InNamespace(risingwave.Namespace),
+		client.MatchingLabels{consts.LabelRisingWaveComponent: consts.ComponentMeta}); err != nil {
+		t.Fatal(err)
+	}
+	if len(metaStatefulSets.Items) != 0 {
+		t.Fatal("meta synced before the meta store is restored")
+	}
+
+	risingwaveManager := object.NewRisingWaveManager(nil, currentRisingwave, false)
+	if !risingwaveManager.DoesConditionExistAndEqual(risingwavev1alpha1.RisingWaveConditionRestored, false) {
+		t.Fatal("Restored condition not false")
+	}
+	if risingwaveManager.GetCondition(risingwavev1alpha1.RisingWaveConditionInitializing) != nil {
+		t.Fatal("Initializing condition set before the meta store is restored")
+	}
+
+	// Complete the restore job.
+	var restoreJob batchv1.Job
+	if err := controller.Client.Get(context.Background(), types.NamespacedName{
+		Name:      risingwave.Name + "-meta-restore",
+		Namespace: risingwave.Namespace,
+	}, &restoreJob); err != nil {
+		t.Fatal(err)
+	}
+	restoreJob.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	if err := controller.Client.Status().Update(context.Background(), &restoreJob); err != nil {
+		t.Fatal(err)
+	}
+
+	controller.ActionHookFactory = func() ctrlkit.ActionHook {
+		return newActionAsserts(t, map[string]resultErr{
+			RisingWaveAction_SyncMetaRestoreJob:                newResultErr(ctrlkit.Continue()),
+			RisingWaveAction_WaitBeforeMetaRestoreJobCompleted: newResultErr(ctrlkit.Continue()),
+			RisingWaveAction_MarkConditionInitializingAsTrue:   newResultErr(ctrlkit.Continue()),
+		}, false)
+	}
+	currentRisingwave = reconcileAndGet()
+
+	risingwaveManager = object.NewRisingWaveManager(nil, currentRisingwave, false)
+	if !risingwaveManager.DoesConditionExistAndEqual(risingwavev1alpha1.RisingWaveConditionRestored, true) {
+		t.Fatal("Restored condition not true")
+	}
+	if !risingwaveManager.DoesConditionExistAndEqual(risingwavev1alpha1.RisingWaveConditionInitializing, true) {
+		t.Fatal("Initializing condition not true")
+	}
+}
+
+func Test_RisingWaveController_VersionUpgrading(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.Image = "ghcr.io/risingwavelabs/risingwave:v1.1.0"
+	risingwave.Status = risingwavev1alpha1.RisingWaveStatus{
+		ObservedGeneration: risingwave.Generation,
+		RolledOutVersion:   "v1.0.0",
+		Conditions: []risingwavev1alpha1.RisingWaveCondition{
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionRunning,
+				Status: metav1.ConditionTrue,
+			},
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionUpgrading,
+				Status: metav1.ConditionTrue,
+			},
+		},
+	}
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithStatusSubresource(&risingwavev1alpha1.RisingWave{}).
+			WithObjects(risingwave).
+			Build(),
+		Recorder: record.NewFakeRecorder(defaultRecorderBufferSize),
+		ActionHookFactory: func() ctrlkit.ActionHook {
+			return newActionAsserts(t, map[string]resultErr{
+				RisingWaveAction_SyncUpgradePhase:                newResultErr(ctrlkit.Continue()),
+				RisingWaveAction_SyncMetaStatefulSets:            newResultErr(ctrlkit.Continue()),
+				RisingWaveAction_WaitBeforeMetaStatefulSetsReady: newResultErr(ctrlkit.Exit()),
+			}, false)
+		},
+	}
+
+	logger := zap.New(zap.UseDevMode(true))
+	_, err := controller.Reconcile(log.IntoContext(context.Background(), logger), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: risingwave.Name, Namespace: risingwave.Namespace},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Compute must not be upgraded before meta is ready.
+	var computeStatefulSets appsv1.StatefulSetList
+	if err := controller.Client.List(context.Background(), &computeStatefulSets, client.InNamespace(risingwave.Namespace),
+		client.MatchingLabels{consts.LabelRisingWaveComponent: consts.ComponentCompute}); err != nil {
+		t.Fatal(err)
+	}
+	if len(computeStatefulSets.Items) != 0 {
+		t.Fatal("compute upgraded before meta is ready")
+	}
+
+	var currentRisingwave risingwavev1alpha1.RisingWave
+	if err := controller.Client.Get(context.Background(), client.ObjectKeyFromObject(risingwave), &currentRisingwave); err != nil {
+		t.Fatal(err)
+	}
+	if currentRisingwave.Status.RolledOutVersion != "v1.0.0" {
+		t.Fatal("version changed before all components are upgraded")
+	}
+	upgrade := currentRisingwave.Status.Upgrade
+	if upgrade == nil || upgrade.Phase != risingwavev1alpha1.RisingWaveUpgradePhaseMeta || upgrade.TargetVersion != "v1.1.0" {
+		t.Fatalf("unexpected upgrade status: %v", upgrade)
+	}
+	if !testutils.DeepEqual(upgrade.PendingComponents, []string{
+		consts.ComponentCompute, consts.ComponentCompactor, consts.ComponentConnector, consts.ComponentFrontend,
+	}) {
+		t.Fatalf("unexpected pending components: %v", upgrade.PendingComponents)
+	}
+}
+
+func Test_RisingWaveController_RolloutFailed(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.UpgradePolicy.ProgressDeadlineSeconds = pointer.Int32(600)
+	risingwave.Status = risingwavev1alpha1.RisingWaveStatus{
+		ObservedGeneration: risingwave.Generation,
+		Conditions: []risingwavev1alpha1.RisingWaveCondition{
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionRunning,
+				Status: metav1.ConditionTrue,
+			},
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionUpgrading,
+				Status: metav1.ConditionTrue,
+			},
+		},
+		Rollout: &risingwavev1alpha1.RisingWaveRolloutStatus{
+			Generation: risingwave.Generation,
+			StartTime:  metav1.NewTime(time.Now().Add(-time.Hour)),
+			Failed:     true,
+		},
+	}
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithStatusSubresource(&risingwavev1alpha1.RisingWave{}).
+			WithObjects(risingwave).
+			Build(),
+		Recorder: record.NewFakeRecorder(defaultRecorderBufferSize),
+		ActionHookFactory: func() ctrlkit.ActionHook {
+			return newActionAsserts(t, map[string]resultErr{
+				RisingWaveAction_BarrierRolloutFailed: newResultErr(ctrlkit.Exit()),
+			}, false)
+		},
+	}
+
+	logger := zap.New(zap.UseDevMode(true))
+	_, err := controller.Reconcile(log.IntoContext(context.Background(), logger), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: risingwave.Name, Namespace: risingwave.Namespace},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Workloads must not be synced once the rollout of the current generation has failed.
+	var metaStatefulSets appsv1.StatefulSetList
+	if err := controller.Client.List(context.Background(), &metaStatefulSets, client.InNamespace(risingwave.Namespace),
+		client.MatchingLabels{consts.LabelRisingWaveComponent: consts.ComponentMeta}); err != nil {
+		t.Fatal(err)
+	}
+	if len(metaStatefulSets.Items) != 0 {
+		t.Fatal("workloads synced after the rollout failed")
+	}
+}
+
+func Test_RisingWaveController_RolloutFailedRollbackOnce(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.UpgradePolicy.AutoRollback = pointer.Bool(true)
+	risingwave.Status = risingwavev1alpha1.RisingWaveStatus{
+		ObservedGeneration: risingwave.Generation,
+		Conditions: []risingwavev1alpha1.RisingWaveCondition{
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionRunning,
+				Status: metav1.ConditionTrue,
+			},
+			{
+				Type:   risingwavev1alpha1.RisingWaveConditionUpgrading,
+				Status: metav1.ConditionTrue,
+			},
+		},
+		Rollout: &risingwavev1alpha1.RisingWaveRolloutStatus{
+			Generation: risingwave.Generation,
+			StartTime:  metav1.NewTime(time.Now().Add(-time.Hour)),
+			Failed:     true,
+		},
+	}
+
+	objectFactory := factory.NewRisingWaveObjectFactory(risingwave, testutils.Scheme, "")
+	computeStatefulSet := objectFactory.NewComputeStatefulSet("")
+	knownGoodTemplate, err := json.Marshal(&computeStatefulSet.Spec.Template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revisionConfigMap := objectFactory.NewRevisionConfigMap(map[string]string{
+		"StatefulSet." + computeStatefulSet.Name: string(knownGoodTemplate),
+	})
+	computeStatefulSet.Spec.Template.Spec.Containers[0].Image = "ghcr.io/risingwavelabs/risingwave:bad"
+
+	controller := &RisingWaveController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithStatusSubresource(&risingwavev1alpha1.RisingWave{}).
+			WithObjects(risingwave, computeStatefulSet, revisionConfigMap).
+			Build(),
+		Recorder: record.NewFakeRecorder(defaultRecorderBufferSize),
+		ActionHookFactory: func() ctrlkit.ActionHook {
+			return newActionAsserts(t, map[string]resultErr{
+				RisingWaveAction_BarrierRolloutFailed: newResultErr(ctrlkit.Exit()),
+			}, false)
+		},
+	}
+
+	reconcileAndGet := func() (*risingwavev1alpha1.RisingWave, *appsv1.StatefulSet) {
+		logger := zap.New(zap.UseDevMode(true))
+		_, err := controller.Reconcile(log.IntoContext(context.Background(), logger), reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: risingwave.Name, Namespace: risingwave.Namespace},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var currentRisingWave risingwavev1alpha1.RisingWave
+		if err := controller.Client.Get(context.Background(), client.ObjectKeyFromObject(risingwave), &currentRisingWave); err != nil {
+			t.Fatal(err)
+		}
+		var currentStatefulSet appsv1.StatefulSet
+		if err := controller.Client.Get(context.Background(), client.ObjectKeyFromObject(computeStatefulSet), &currentStatefulSet); err != nil {
+			t.Fatal(err)
+		}
+		return &currentRisingWave, &currentStatefulSet
+	}
+
+	// The first reconciliation rolls back the workloads and clears the condition Upgrading.
+	currentRisingWave, currentStatefulSet := reconcileAndGet()
+	if image := currentStatefulSet.Spec.Template.Spec.Containers[0].Image; image != risingwave.Spec.Image {
+		t.Fatalf("compute not rolled back, image: %s", image)
+	}
+	if !currentRisingWave.Status.Rollout.RolledBack {
+		t.Fatal("rollback not recorded")
+	}
+	if object.NewRisingWaveReader(currentRisingWave).DoesConditionExistAndEqual(risingwavev1alpha1.RisingWaveConditionUpgrading, true) {
+		t.Fatal("condition Upgrading not cleared after the rollout failed")
+	}
+
+	// The second reconciliation must keep the workloads as they are.
+	currentStatefulSet.Spec.Template.Spec.Containers[0].Image = "ghcr.io/risingwavelabs/risingwave:manual-fix"
+	if err := controller.Client.Update(context.Background(), currentStatefulSet); err != nil {
+		t.Fatal(err)
+	}
+	_, currentStatefulSet = reconcileAndGet()
+	if image := currentStatefulSet.Spec.Template.Spec.Containers[0].Image; image != "ghcr.io/risingwavelabs/risingwave:manual-fix" {
+		t.Fatalf("compute rolled back more than once, image: %s", image)
+	}
+}
+
+func Test_RisingWaveController_EnqueueRisingWavesReferencing(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.Configuration.ConfigMap = &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{
+		Name: "risingwave-config",
+		Key:  "risingwave.toml",
+	}
+	another := testutils.FakeRisingWave()
+	another.Name = "another"
+
+	controller := &RisingWaveController{}
+	controller.Client = fake.NewClientBuilder().
+		WithScheme(testutils.Scheme).
+		WithObjects(risingwave, another).
+		WithIndex(&risingwavev1alpha1.RisingWave{}, risingWaveReferencedConfigMapsIndexKey, func(obj client.Object) []string {
+			configMaps, _ := controller.referencedConfigMapsAndSecrets(obj.(*risingwavev1alpha1.RisingWave))
+			return configMaps
+		}).
+		Build()
+
+	enqueue := controller.enqueueRisingWavesReferencing(risingWaveReferencedConfigMapsIndexKey)
+
+	requests := enqueue(context.Background(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: risingwave.Namespace, Name: "risingwave-config"},
+	})
+	if len(requests) != 1 || requests[0].Name != risingwave.Name {
+		t.Fatalf("unexpected requests: %v", requests)
+	}
+
+	requests = enqueue(context.Background(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: risingwave.Namespace, Name: "irrelevant"},
+	})
+	if len(requests) != 0 {
+		t.Fatalf("unexpected requests: %v", requests)
+	}
+}
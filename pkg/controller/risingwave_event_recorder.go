@@ -87,6 +87,8 @@ func (h *RisingWaveEventRecorder) recordConditionChangingEvents() {
 func (h *RisingWaveEventRecorder) recordStatesWarningEvents() {
 	warningEvents := []consts.RisingWaveEventType{
 		consts.RisingWaveEventTypeUnhealthy,
+		consts.RisingWaveEventTypeUpgradeFailed,
+		consts.RisingWaveEventTypeRolledBack,
 	}
 
 	for _, ev := range warningEvents {
@@ -0,0 +1,232 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/risingwavelabs/ctrlkit"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
+	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+	pb "github.com/risingwavelabs/risingwave-operator/pkg/controller/proto"
+	"github.com/risingwavelabs/risingwave-operator/pkg/metrics"
+	"github.com/risingwavelabs/risingwave-operator/pkg/utils"
+)
+
+const (
+	RisingWaveWorkerRegistrationSyncInterval = 30 * time.Second
+
+	// DefaultRisingWaveOrphanedWorkerDeletionGracePeriod is the default grace period before deleting the orphaned
+	// workers from meta. It should be longer than the time it takes to restart a Pod with the same address.
+	DefaultRisingWaveOrphanedWorkerDeletionGracePeriod = time.Minute
+)
+
+// Components of the workers that are registered in meta by the Pods.
+var workerTypeComponents = map[pb.WorkerType]string{
+	pb.WorkerType_WORKER_TYPE_FRONTEND:     consts.ComponentFrontend,
+	pb.WorkerType_WORKER_TYPE_COMPUTE_NODE: consts.ComponentCompute,
+	pb.WorkerType_WORKER_TYPE_COMPACTOR:    consts.ComponentCompactor,
+}
+
+// RisingWaveWorkerRegistrationController cleans up the workers registered in meta whose Pods are gone, e.g., after
+// the node groups are deleted or the Pods are restarted with new addresses. Otherwise, meta keeps them until they
+// expire.
+type RisingWaveWorkerRegistrationController struct {
+	Client client.Client
+	Dial   MetaDialer
+
+	// DeletionGracePeriod is the period that the workers have been orphaned before being deleted from meta.
+	// DefaultRisingWaveOrphanedWorkerDeletionGracePeriod is used if it's not positive.
+	DeletionGracePeriod time.Duration
+}
+
+// +kubebuilder:rbac:groups=risingwave.risingwavelabs.com,resources=risingwaves,verbs=get;list;watch
+// +kubebuilder:rbac:groups=risingwave.risingwavelabs.com,resources=risingwaves/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+
+// Reconcile implements the reconcile.Reconciler.
+func (c *RisingWaveWorkerRegistrationController) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var risingwave risingwavev1alpha1.RisingWave
+	if err := c.Client.Get(ctx, request.NamespacedName, &risingwave); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info("Not found, abort")
+			return ctrlkit.NoRequeue()
+		}
+		logger.Error(err, "Failed to get risingwave")
+		return ctrlkit.RequeueIfErrorAndWrap("unable to get risingwave", err)
+	}
+
+	// Nothing to do with the deleted, paused or stopped RisingWaves.
+	if _, ok := risingwave.Annotations[consts.AnnotationPauseReconcile]; ok {
+		return ctrlkit.NoRequeue()
+	}
+	if utils.IsDeleted(&risingwave) || pointer.BoolDeref(risingwave.Spec.Stopped, false) {
+		return ctrlkit.NoRequeue()
+	}
+
+	original := risingwave.DeepCopy()
+	result, err := c.syncWorkers(ctx, &risingwave)
+
+	// Only the orphaned workers are patched, so that the status written by the RisingWave controller won't be
+	// overwritten.
+	if !equality.Semantic.DeepEqual(original.Status.OrphanedWorkers, risingwave.Status.OrphanedWorkers) {
+		if patchErr := c.Client.Status().Patch(ctx, &risingwave, client.MergeFrom(original)); patchErr != nil {
+			logger.Error(patchErr, "Failed to patch status of risingwave")
+			return ctrlkit.RequeueIfErrorAndWrap("unable to patch status of risingwave", patchErr)
+		}
+	}
+
+	return result, err
+}
+
+func (c *RisingWaveWorkerRegistrationController) syncWorkers(ctx context.Context, risingwave *risingwavev1alpha1.RisingWave) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	addr, err := getMetaLeaderAddr(ctx, c.Client, risingwave.Namespace, risingwave.Name)
+	if err != nil {
+		if errors.Is(err, errMetaLeaderNotFound) {
+			return ctrlkit.RequeueAfter(RisingWaveWorkerRegistrationSyncInterval)
+		}
+		return ctrlkit.RequeueIfErrorAndWrap("unable to find meta leader", err)
+	}
+
+	conn, err := c.Dial(ctx, risingwave, addr)
+	if err != nil {
+		return ctrlkit.RequeueIfErrorAndWrap("unable to connect to meta", err)
+	}
+	defer conn.Close()
+
+	workers, err := listWorkers(ctx, conn, nil)
+	if err != nil {
+		return ctrlkit.RequeueIfError(err)
+	}
+
+	var podList corev1.PodList
+	if err := c.Client.List(ctx, &podList, client.InNamespace(risingwave.Namespace),
+		client.MatchingLabels{consts.LabelRisingWaveName: risingwave.Name},
+		client.HasLabels{consts.LabelRisingWaveComponent}); err != nil {
+		return ctrlkit.RequeueIfErrorAndWrap("unable to list pods", err)
+	}
+
+	risingwave.Status.OrphanedWorkers, err = syncOrphanedWorkers(ctx, conn, c.deletionGracePeriod(), risingwave.Status.OrphanedWorkers, workers, podList.Items)
+	if err != nil {
+		logger.Error(err, "Failed to delete orphaned workers")
+		return ctrlkit.RequeueIfErrorAndWrap("unable to delete orphaned workers", err)
+	}
+
+	return ctrlkit.RequeueAfter(RisingWaveWorkerRegistrationSyncInterval)
+}
+
+func (c *RisingWaveWorkerRegistrationController) deletionGracePeriod() time.Duration {
+	if c.DeletionGracePeriod > 0 {
+		return c.DeletionGracePeriod
+	}
+	return DefaultRisingWaveOrphanedWorkerDeletionGracePeriod
+}
+
+// syncOrphanedWorkers finds the workers without Pods, and deletes the ones that have been orphaned longer than the
+// grace period from meta. The workers that are still orphaned are returned.
+func syncOrphanedWorkers(ctx context.Context, conn grpc.ClientConnInterface, gracePeriod time.Duration,
+	previous []risingwavev1alpha1.RisingWaveOrphanedWorker, workers []*pb.WorkerNode, pods []corev1.Pod) ([]risingwavev1alpha1.RisingWaveOrphanedWorker, error) {
+	logger := log.FromContext(ctx)
+
+	previousOrphaned := lo.SliceToMap(previous, func(worker risingwavev1alpha1.RisingWaveOrphanedWorker) (uint32, risingwavev1alpha1.RisingWaveOrphanedWorker) {
+		return worker.ID, worker
+	})
+	now := metav1.Now()
+
+	var orphaned []risingwavev1alpha1.RisingWaveOrphanedWorker
+	var errs []error
+	for _, worker := range workers {
+		component, ok := workerTypeComponents[worker.Type]
+		if !ok || findWorkerPod(worker, pods) != nil {
+			continue
+		}
+
+		orphanedWorker, ok := previousOrphaned[worker.Id]
+		if !ok {
+			orphanedWorker = risingwavev1alpha1.RisingWaveOrphanedWorker{
+				ID:                worker.Id,
+				Component:         component,
+				Host:              worker.GetHost().GetHost(),
+				Port:              worker.GetHost().GetPort(),
+				FirstObservedTime: now,
+			}
+		}
+
+		if now.Sub(orphanedWorker.FirstObservedTime.Time) >= gracePeriod {
+			if err := deleteWorkerNode(ctx, conn, orphanedWorker.Host, orphanedWorker.Port); err != nil {
+				errs = append(errs, err)
+			} else {
+				logger.Info("Orphaned worker deleted", "id", orphanedWorker.ID, "component", orphanedWorker.Component,
+					"address", fmt.Sprintf("%s:%d", orphanedWorker.Host, orphanedWorker.Port))
+				continue
+			}
+		}
+
+		orphaned = append(orphaned, orphanedWorker)
+	}
+
+	sort.Slice(orphaned, func(i, j int) bool { return orphaned[i].ID < orphaned[j].ID })
+
+	return orphaned, utilerrors.NewAggregate(errs)
+}
+
+// SetupWithManager sets up the controller with a given manager.
+func (c *RisingWaveWorkerRegistrationController) SetupWithManager(mgr ctrl.Manager) error {
+	gvk, err := apiutil.GVKForObject(&risingwavev1alpha1.RisingWave{}, c.Client.Scheme())
+	if err != nil {
+		return fmt.Errorf("unable to find gvk for RisingWave: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("risingwave-worker-registration").
+		// Sync periodically. The status updates are ignored.
+		For(&risingwavev1alpha1.RisingWave{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(metrics.NewControllerMetricsRecorder(c, "RisingWaveWorkerRegistrationController", gvk))
+}
+
+// NewRisingWaveWorkerRegistrationController creates a new RisingWaveWorkerRegistrationController.
+func NewRisingWaveWorkerRegistrationController(client client.Client, dial MetaDialer, deletionGracePeriod time.Duration) *RisingWaveWorkerRegistrationController {
+	return &RisingWaveWorkerRegistrationController{
+		Client:              client,
+		Dial:                dial,
+		DeletionGracePeriod: deletionGracePeriod,
+	}
+}
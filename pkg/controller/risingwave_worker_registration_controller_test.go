@@ -0,0 +1,170 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
+	pb "github.com/risingwavelabs/risingwave-operator/pkg/controller/proto"
+	"github.com/risingwavelabs/risingwave-operator/pkg/testutils"
+)
+
+func reconcileRisingWaveWorkerRegistration(t *testing.T, controller *RisingWaveWorkerRegistrationController, risingwave *risingwavev1alpha1.RisingWave) (reconcile.Result, *risingwavev1alpha1.RisingWave) {
+	result, err := controller.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: client.ObjectKeyFromObject(risingwave),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var current risingwavev1alpha1.RisingWave
+	if err := controller.Client.Get(context.Background(), client.ObjectKeyFromObject(risingwave), &current); err != nil {
+		t.Fatal(err)
+	}
+	return result, &current
+}
+
+func Test_RisingWaveWorkerRegistrationController(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	server := newFakeMetaClusterServer(risingwave)
+
+	// The Pod of worker 2 is gone.
+	controller := &RisingWaveWorkerRegistrationController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithStatusSubresource(&risingwavev1alpha1.RisingWave{}).
+			WithObjects(risingwave, newFakeMetaLeaderPod(risingwave), newFakeComputePod(risingwave, 0)).
+			Build(),
+		Dial: newInMemoryMetaDialer(t, func(grpcServer *grpc.Server) {
+			pb.RegisterClusterServiceServer(grpcServer, server)
+		}),
+	}
+
+	// Reported but not deleted within the grace period.
+	result, current := reconcileRisingWaveWorkerRegistration(t, controller, risingwave)
+	assert.Equal(t, RisingWaveWorkerRegistrationSyncInterval, result.RequeueAfter)
+	assert.Empty(t, server.deleted)
+	if assert.Len(t, current.Status.OrphanedWorkers, 1) {
+		orphanedWorker := current.Status.OrphanedWorkers[0]
+		assert.Equal(t, uint32(2), orphanedWorker.ID)
+		assert.Equal(t, "compute", orphanedWorker.Component)
+		assert.Equal(t, server.workers[2], orphanedWorker.Host)
+		assert.Equal(t, int32(5688), orphanedWorker.Port)
+	}
+
+	// Deleted after the grace period.
+	current.Status.OrphanedWorkers[0].FirstObservedTime = metav1.NewTime(time.Now().Add(-DefaultRisingWaveOrphanedWorkerDeletionGracePeriod))
+	assert.Nil(t, controller.Client.Status().Update(context.Background(), current))
+	result, current = reconcileRisingWaveWorkerRegistration(t, controller, risingwave)
+	assert.Equal(t, RisingWaveWorkerRegistrationSyncInterval, result.RequeueAfter)
+	assert.Equal(t, []string{server.workers[2] + ":5688"}, server.deleted)
+	assert.Empty(t, current.Status.OrphanedWorkers)
+}
+
+func Test_RisingWaveWorkerRegistrationController_WaitForMetaLeader(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+
+	controller := &RisingWaveWorkerRegistrationController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithStatusSubresource(&risingwavev1alpha1.RisingWave{}).
+			WithObjects(risingwave).
+			Build(),
+	}
+
+	result, current := reconcileRisingWaveWorkerRegistration(t, controller, risingwave)
+	assert.Equal(t, RisingWaveWorkerRegistrationSyncInterval, result.RequeueAfter)
+	assert.Empty(t, current.Status.OrphanedWorkers)
+}
+
+func Test_RisingWaveWorkerRegistrationController_DeletionGracePeriod(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Status.OrphanedWorkers = []risingwavev1alpha1.RisingWaveOrphanedWorker{
+		{
+			ID:                2,
+			Component:         "compute",
+			Host:              risingwave.Name + "-compute-1.compute.default.svc",
+			Port:              5688,
+			FirstObservedTime: metav1.NewTime(time.Now().Add(-DefaultRisingWaveOrphanedWorkerDeletionGracePeriod)),
+		},
+	}
+	server := newFakeMetaClusterServer(risingwave)
+
+	controller := &RisingWaveWorkerRegistrationController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithStatusSubresource(&risingwavev1alpha1.RisingWave{}).
+			WithObjects(risingwave, newFakeMetaLeaderPod(risingwave), newFakeComputePod(risingwave, 0)).
+			Build(),
+		Dial: newInMemoryMetaDialer(t, func(grpcServer *grpc.Server) {
+			pb.RegisterClusterServiceServer(grpcServer, server)
+		}),
+		DeletionGracePeriod: time.Hour,
+	}
+
+	// Kept within the configured grace period.
+	_, current := reconcileRisingWaveWorkerRegistration(t, controller, risingwave)
+	assert.Empty(t, server.deleted)
+	assert.Len(t, current.Status.OrphanedWorkers, 1)
+}
+
+func Test_RisingWaveWorkerRegistrationController_PatchOrphanedWorkersOnly(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	server := newFakeMetaClusterServer(risingwave)
+
+	// The status is changed by the others right after it's read.
+	changed := false
+	controller := &RisingWaveWorkerRegistrationController{
+		Client: fake.NewClientBuilder().
+			WithScheme(testutils.Scheme).
+			WithStatusSubresource(&risingwavev1alpha1.RisingWave{}).
+			WithObjects(risingwave, newFakeMetaLeaderPod(risingwave), newFakeComputePod(risingwave, 0)).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if err := c.Get(ctx, key, obj, opts...); err != nil {
+						return err
+					}
+					if _, ok := obj.(*risingwavev1alpha1.RisingWave); !ok || changed {
+						return nil
+					}
+					changed = true
+					latest := obj.DeepCopyObject().(*risingwavev1alpha1.RisingWave)
+					latest.Status.ObservedGeneration = 10
+					return c.Status().Update(ctx, latest)
+				},
+			}).
+			Build(),
+		Dial: newInMemoryMetaDialer(t, func(grpcServer *grpc.Server) {
+			pb.RegisterClusterServiceServer(grpcServer, server)
+		}),
+	}
+
+	_, current := reconcileRisingWaveWorkerRegistration(t, controller, risingwave)
+	assert.Len(t, current.Status.OrphanedWorkers, 1)
+	assert.Equal(t, int64(10), current.Status.ObservedGeneration)
+}
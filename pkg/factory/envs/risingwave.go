@@ -30,15 +30,26 @@ const (
 	RWEtcdAuth                    = "RW_ETCD_AUTH"
 	RWEtcdUsername                = "RW_ETCD_USERNAME"
 	RWEtcdPassword                = "RW_ETCD_PASSWORD"
+	RWEtcdTLS                     = "RW_ETCD_TLS"
+	RWEtcdTLSCA                   = "RW_ETCD_TLS_CA"
+	RWEtcdTLSCert                 = "RW_ETCD_TLS_CERT"
+	RWEtcdTLSKey                  = "RW_ETCD_TLS_KEY"
+	RWEtcdTLSServerName           = "RW_ETCD_TLS_SERVER_NAME"
 	RWConfigPath                  = "RW_CONFIG_PATH"
 	RWStateStore                  = "RW_STATE_STORE"
 	RWDataDirectory               = "RW_DATA_DIRECTORY"
 	RWWorkerThreads               = "RW_WORKER_THREADS"
 	RWConnectorRPCEndPoint        = "RW_CONNECTOR_RPC_ENDPOINT"
 	RWBackend                     = "RW_BACKEND"
+	RWSQLEndpoint                 = "RW_SQL_ENDPOINT"
+	RWSQLUsername                 = "RW_SQL_USERNAME"
+	RWSQLPassword                 = "RW_SQL_PASSWORD"
+	RWSQLDatabase                 = "RW_SQL_DATABASE"
+	RWSQLURLParams                = "RW_SQL_URL_PARAMS"
 	RWMetaAddr                    = "RW_META_ADDR"
 	RWMetaAddrLegacy              = "RW_META_ADDRESS" // Will deprecate soon.
 	RWPrometheusListenerAddr      = "RW_PROMETHEUS_LISTENER_ADDR"
+	RWHealthCheckListenerAddr     = "RW_HEALTH_CHECK_LISTENER_ADDR"
 	RWParallelism                 = "RW_PARALLELISM"
 	RWTotalMemoryBytes            = "RW_TOTAL_MEMORY_BYTES"
 	RWConnectorNodePrometheusPort = "RW_CONNECTOR_NODE_PROMETHEUS_PORT"
@@ -50,6 +61,11 @@ const (
 	MinIOBucket   = "MINIO_BUCKET"
 	MinIOUsername = "MINIO_USERNAME"
 	MinIOPassword = "MINIO_PASSWORD"
+
+	// Managed MinIO.
+	MinIORootUser         = "MINIO_ROOT_USER"
+	MinIORootPassword     = "MINIO_ROOT_PASSWORD"
+	MinIOClientHostPrefix = "MC_HOST_"
 )
 
 // etcd.
@@ -74,6 +90,7 @@ const (
 	S3CompatibleAccessKeyID     = "AWS_ACCESS_KEY_ID"
 	S3CompatibleSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
 	S3CompatibleEndpoint        = "RW_S3_ENDPOINT"
+	S3CompatibleForcePathStyle  = "RW_IS_FORCE_PATH_STYLE"
 )
 
 // Azure blob.
@@ -92,6 +109,11 @@ const (
 	AliyunOSSAccountKey  = "OSS_ACCESS_KEY_SECRET"
 )
 
+const (
+	// SSLCertFile is the path to the CA bundle that replaces the system trust store.
+	SSLCertFile = "SSL_CERT_FILE"
+)
+
 const (
 	// GoogleApplicationCredentials for GCS service.
 	GoogleApplicationCredentials = "GOOGLE_APPLICATION_CREDENTIALS"
@@ -19,7 +19,9 @@ package factory
 import (
 	"fmt"
 	"math"
+	"net/url"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -27,10 +29,15 @@ import (
 	kruisepubs "github.com/openkruise/kruise-api/apps/pub"
 	kruiseappsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
 	kruiseappsv1beta1 "github.com/openkruise/kruise-api/apps/v1beta1"
+	"github.com/pelletier/go-toml/v2"
 	prometheusv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/samber/lo"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -41,6 +48,7 @@ import (
 	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
 	"github.com/risingwavelabs/risingwave-operator/pkg/factory/envs"
 	"github.com/risingwavelabs/risingwave-operator/pkg/object"
+	"github.com/risingwavelabs/risingwave-operator/pkg/utils"
 )
 
 const (
@@ -50,8 +58,56 @@ const (
 	risingwaveExecutablePath  = "/risingwave/bin/risingwave"
 	risingwaveConfigMountPath = "/risingwave/config"
 	risingwaveConfigFileName  = "risingwave.toml"
+
+	// The earliest version known to serve the gRPC health service on meta, compute and compactor, and the HTTP
+	// health check on frontend.
+	risingwaveHealthCheckMinVersion = "v2.0.0"
+
+	risingWaveEtcdTLSVolume    = "risingwave-etcd-tls"
+	risingwaveEtcdTLSMountPath = "/risingwave/etcd-tls"
+	risingwaveEtcdTLSCAFile    = "ca.crt"
+	risingwaveEtcdTLSCertFile  = "tls.crt"
+	risingwaveEtcdTLSKeyFile   = "tls.key"
+
+	risingWaveS3CAVolume    = "risingwave-s3-ca"
+	risingwaveS3CAMountPath = "/risingwave/s3-ca"
+	risingwaveS3CAFile      = "ca.crt"
+
+	etcdDataVolume    = "data"
+	etcdDataMountPath = "/var/lib/etcd"
+
+	minioDataVolume    = "data"
+	minioDataMountPath = "/data"
+	minioRootUser      = "risingwave"
+	minioClientAlias   = "minio"
 )
 
+// s3CompatiblePreset is the default endpoint, region and addressing style of a well-known S3-compatible service.
+type s3CompatiblePreset struct {
+	endpoint        string
+	region          string
+	addressingStyle risingwavev1alpha1.RisingWaveS3AddressingStyle
+}
+
+var s3CompatiblePresets = map[risingwavev1alpha1.RisingWaveS3CompatibleProvider]s3CompatiblePreset{
+	risingwavev1alpha1.RisingWaveS3CompatibleProviderHuaweiOBS: {
+		endpoint:        "obs.${REGION}.myhuaweicloud.com",
+		addressingStyle: risingwavev1alpha1.RisingWaveS3AddressingStyleVirtualHosted,
+	},
+	risingwavev1alpha1.RisingWaveS3CompatibleProviderTencentCOS: {
+		endpoint:        "cos.${REGION}.myqcloud.com",
+		addressingStyle: risingwavev1alpha1.RisingWaveS3AddressingStyleVirtualHosted,
+	},
+	risingwavev1alpha1.RisingWaveS3CompatibleProviderCephRGW: {
+		region:          "us-east-1",
+		addressingStyle: risingwavev1alpha1.RisingWaveS3AddressingStylePath,
+	},
+	risingwavev1alpha1.RisingWaveS3CompatibleProviderCloudflareR2: {
+		region:          "auto",
+		addressingStyle: risingwavev1alpha1.RisingWaveS3AddressingStylePath,
+	},
+}
+
 var (
 	aliyunOSSEndpoint         = fmt.Sprintf("https://oss-$(%s).aliyuncs.com", envs.AliyunOSSRegion)
 	internalAliyunOSSEndpoint = fmt.Sprintf("https://oss-$(%s)-internal.aliyuncs.com", envs.AliyunOSSRegion)
@@ -82,6 +138,10 @@ func (f *RisingWaveObjectFactory) isStateStoreS3Compatible() bool {
 	return f.risingwave.Spec.StateStore.S3 != nil && len(f.risingwave.Spec.StateStore.S3.Endpoint) > 0
 }
 
+func (f *RisingWaveObjectFactory) isStateStoreS3CompatibleCAEnabled() bool {
+	return f.risingwave.Spec.StateStore.S3Compatible != nil && f.risingwave.Spec.StateStore.S3Compatible.CA != nil
+}
+
 func (f *RisingWaveObjectFactory) isStateStoreGCS() bool {
 	return f.risingwave.Spec.StateStore.GCS != nil
 }
@@ -106,6 +166,10 @@ func (f *RisingWaveObjectFactory) isStateStoreMinIO() bool {
 	return f.risingwave.Spec.StateStore.MinIO != nil
 }
 
+func (f *RisingWaveObjectFactory) isStateStoreMinIOManaged() bool {
+	return f.isStateStoreMinIO() && f.risingwave.Spec.StateStore.MinIO.Managed != nil
+}
+
 func (f *RisingWaveObjectFactory) isStateStoreLocalDisk() bool {
 	return f.risingwave.Spec.StateStore.LocalDisk != nil
 }
@@ -118,10 +182,43 @@ func (f *RisingWaveObjectFactory) isMetaStoreEtcd() bool {
 	return f.risingwave.Spec.MetaStore.Etcd != nil
 }
 
+func (f *RisingWaveObjectFactory) isMetaStorePostgreSQL() bool {
+	return f.risingwave.Spec.MetaStore.PostgreSQL != nil
+}
+
+func (f *RisingWaveObjectFactory) isMetaStoreMySQL() bool {
+	return f.risingwave.Spec.MetaStore.MySQL != nil
+}
+
+func (f *RisingWaveObjectFactory) isMetaStoreSQLite() bool {
+	return f.risingwave.Spec.MetaStore.SQLite != nil
+}
+
+func (f *RisingWaveObjectFactory) isMetaStoreEtcdManaged() bool {
+	return f.isMetaStoreEtcd() && f.risingwave.Spec.MetaStore.Etcd.Managed != nil
+}
+
+func (f *RisingWaveObjectFactory) isMetaStoreEtcdTLSEnabled() bool {
+	return f.isMetaStoreEtcd() && f.risingwave.Spec.MetaStore.Etcd.TLS != nil
+}
+
 func (f *RisingWaveObjectFactory) isFullKubernetesAddr() bool {
 	return pointer.BoolDeref(f.risingwave.Spec.EnableFullKubernetesAddr, false)
 }
 
+func (f *RisingWaveObjectFactory) isStopped() bool {
+	return pointer.BoolDeref(f.risingwave.Spec.Stopped, false)
+}
+
+// replicasOfNodeGroup returns the replicas of the workload of the node group. It's always 0 when the RisingWave is
+// stopped, but the replicas in the spec are kept untouched so that they can be restored on resuming.
+func (f *RisingWaveObjectFactory) replicasOfNodeGroup(nodeGroup *risingwavev1alpha1.RisingWaveNodeGroup) *int32 {
+	if f.isStopped() {
+		return pointer.Int32(0)
+	}
+	return pointer.Int32(nodeGroup.Replicas)
+}
+
 func (f *RisingWaveObjectFactory) hummockConnectionStr() string {
 	stateStore := f.risingwave.Spec.StateStore
 	switch {
@@ -133,9 +230,11 @@ func (f *RisingWaveObjectFactory) hummockConnectionStr() string {
 	case f.isStateStoreS3Compatible():
 		bucket := stateStore.S3.Bucket
 		return fmt.Sprintf("hummock+s3://%s", bucket)
+	case stateStore.S3Compatible != nil:
+		return fmt.Sprintf("hummock+s3://%s", stateStore.S3Compatible.Bucket)
 	case stateStore.MinIO != nil:
 		minio := stateStore.MinIO
-		return fmt.Sprintf("hummock+minio://$(%s):$(%s)@%s/%s", envs.MinIOUsername, envs.MinIOPassword, minio.Endpoint, minio.Bucket)
+		return fmt.Sprintf("hummock+minio://$(%s):$(%s)@%s/%s", envs.MinIOUsername, envs.MinIOPassword, f.minioEndpoint(), minio.Bucket)
 	case f.isStateStoreGCS():
 		return fmt.Sprintf("hummock+gcs://%s@%s", stateStore.GCS.Bucket, stateStore.GCS.Root)
 	case f.isStateStoreAliyunOSS():
@@ -179,6 +278,10 @@ func (f *RisingWaveObjectFactory) componentName(component, group string) string
 		return f.risingwave.Name + "-connector" + groupSuffix(group)
 	case consts.ComponentConfig:
 		return f.risingwave.Name + "-default-config"
+	case consts.ComponentEtcd:
+		return f.risingwave.Name + "-etcd"
+	case consts.ComponentMinIO:
+		return f.risingwave.Name + "-minio"
 	default:
 		panic("never reach here")
 	}
@@ -269,7 +372,29 @@ func (f *RisingWaveObjectFactory) newService(component string, serviceType corev
 	}
 }
 
+// etcdMemberAddr returns the address of the i-th member of the managed etcd with the given port.
+func (f *RisingWaveObjectFactory) etcdMemberAddr(i int32, port int32) string {
+	return fmt.Sprintf("%s-%d.%s:%d", f.componentName(consts.ComponentEtcd, ""), i, f.componentAddr(consts.ComponentEtcd, ""), port)
+}
+
+func (f *RisingWaveObjectFactory) etcdEndpoint() string {
+	etcd := f.risingwave.Spec.MetaStore.Etcd
+	if etcd.Managed == nil {
+		return etcd.Endpoint
+	}
+
+	endpoints := make([]string, 0, etcd.Managed.Replicas)
+	for i := int32(0); i < etcd.Managed.Replicas; i++ {
+		endpoints = append(endpoints, f.etcdMemberAddr(i, consts.EtcdClientPort))
+	}
+	return strings.Join(endpoints, ",")
+}
+
 func (f *RisingWaveObjectFactory) envsForEtcd() []corev1.EnvVar {
+	return append(f.envsForEtcdCredentials(), f.envsForEtcdTLS()...)
+}
+
+func (f *RisingWaveObjectFactory) envsForEtcdCredentials() []corev1.EnvVar {
 	credentials := f.risingwave.Spec.MetaStore.Etcd.RisingWaveEtcdCredentials
 
 	// Empty secret indicates no authentication.
@@ -323,6 +448,159 @@ func (f *RisingWaveObjectFactory) envsForEtcd() []corev1.EnvVar {
 	}
 }
 
+func (f *RisingWaveObjectFactory) envsForEtcdTLS() []corev1.EnvVar {
+	if !f.isMetaStoreEtcdTLSEnabled() {
+		return []corev1.EnvVar{}
+	}
+
+	tls := f.risingwave.Spec.MetaStore.Etcd.TLS
+
+	envVars := make([]corev1.EnvVar, 0, 4)
+	if tls.CA != nil {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  envs.RWEtcdTLSCA,
+			Value: path.Join(risingwaveEtcdTLSMountPath, risingwaveEtcdTLSCAFile),
+		})
+	}
+	if tls.ClientCert != nil && tls.ClientKey != nil {
+		envVars = append(envVars, []corev1.EnvVar{
+			{
+				Name:  envs.RWEtcdTLSCert,
+				Value: path.Join(risingwaveEtcdTLSMountPath, risingwaveEtcdTLSCertFile),
+			},
+			{
+				Name:  envs.RWEtcdTLSKey,
+				Value: path.Join(risingwaveEtcdTLSMountPath, risingwaveEtcdTLSKeyFile),
+			},
+		}...)
+	}
+	if tls.ServerName != "" {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  envs.RWEtcdTLSServerName,
+			Value: tls.ServerName,
+		})
+	}
+
+	return envVars
+}
+
+func (f *RisingWaveObjectFactory) etcdTLSVolume() corev1.Volume {
+	tls := f.risingwave.Spec.MetaStore.Etcd.TLS
+
+	projectSecretKey := func(selector *corev1.SecretKeySelector, file string) corev1.VolumeProjection {
+		return corev1.VolumeProjection{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: selector.LocalObjectReference,
+				Items: []corev1.KeyToPath{
+					{
+						Key:  selector.Key,
+						Path: file,
+					},
+				},
+				Optional: selector.Optional,
+			},
+		}
+	}
+
+	sources := make([]corev1.VolumeProjection, 0, 3)
+	if tls.CA != nil {
+		sources = append(sources, projectSecretKey(tls.CA, risingwaveEtcdTLSCAFile))
+	}
+	if tls.ClientCert != nil && tls.ClientKey != nil {
+		sources = append(sources,
+			projectSecretKey(tls.ClientCert, risingwaveEtcdTLSCertFile),
+			projectSecretKey(tls.ClientKey, risingwaveEtcdTLSKeyFile),
+		)
+	}
+
+	return corev1.Volume{
+		Name: risingWaveEtcdTLSVolume,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: sources,
+			},
+		},
+	}
+}
+
+func (f *RisingWaveObjectFactory) volumeMountForEtcdTLS() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      risingWaveEtcdTLSVolume,
+		MountPath: risingwaveEtcdTLSMountPath,
+		ReadOnly:  true,
+	}
+}
+
+func envsForDBCredentials(usernameEnv, passwordEnv string, credentials *risingwavev1alpha1.RisingWaveDBCredentials) []corev1.EnvVar {
+	secretRef := corev1.LocalObjectReference{
+		Name: credentials.SecretName,
+	}
+
+	return []corev1.EnvVar{
+		{
+			Name: usernameEnv,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: secretRef,
+					Key:                  credentials.UsernameKeyRef,
+				},
+			},
+		},
+		{
+			Name: passwordEnv,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: secretRef,
+					Key:                  credentials.PasswordKeyRef,
+				},
+			},
+		},
+	}
+}
+
+// sqlURLParams encodes the options of the SQL meta store into the query string of the connection URL.
+func sqlURLParams(options map[string]string) string {
+	query := url.Values{}
+	for k, v := range options {
+		query.Set(k, v)
+	}
+	return query.Encode()
+}
+
+// envsForSQLMetaStore returns the environment variables of the SQL meta store. The endpoint, database, and
+// credentials are passed separately, and RisingWave escapes the credentials when building the connection URL, so
+// that any character is allowed in the username and password. The database and credentials are optional, e.g.,
+// SQLite only takes the path of the database file as the endpoint.
+func envsForSQLMetaStore(backend, endpoint, database string, options map[string]string, credentials *risingwavev1alpha1.RisingWaveDBCredentials) []corev1.EnvVar {
+	var envVars []corev1.EnvVar
+	if credentials != nil {
+		envVars = envsForDBCredentials(envs.RWSQLUsername, envs.RWSQLPassword, credentials)
+	}
+	envVars = append(envVars, []corev1.EnvVar{
+		{
+			Name:  envs.RWBackend,
+			Value: backend,
+		},
+		{
+			Name:  envs.RWSQLEndpoint,
+			Value: endpoint,
+		},
+	}...)
+	if database != "" {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  envs.RWSQLDatabase,
+			Value: database,
+		})
+	}
+	if len(options) > 0 {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  envs.RWSQLURLParams,
+			Value: sqlURLParams(options),
+		})
+	}
+	return envVars
+}
+
 func (f *RisingWaveObjectFactory) envsForMetaArgs() []corev1.EnvVar {
 	metaStore := &f.risingwave.Spec.MetaStore
 	stateStore := f.risingwave.Spec.StateStore
@@ -376,7 +654,7 @@ func (f *RisingWaveObjectFactory) envsForMetaArgs() []corev1.EnvVar {
 			},
 			{
 				Name:  envs.RWEtcdEndpoints,
-				Value: metaStore.Etcd.Endpoint,
+				Value: f.etcdEndpoint(),
 			},
 		}...)
 		credentials := f.risingwave.Spec.MetaStore.Etcd.RisingWaveEtcdCredentials
@@ -386,6 +664,20 @@ func (f *RisingWaveObjectFactory) envsForMetaArgs() []corev1.EnvVar {
 				Value: "true",
 			})
 		}
+		if f.isMetaStoreEtcdTLSEnabled() {
+			envVars = append(envVars, corev1.EnvVar{
+				Name:  envs.RWEtcdTLS,
+				Value: "true",
+			})
+		}
+	case f.isMetaStorePostgreSQL():
+		postgresql := metaStore.PostgreSQL
+		envVars = append(envVars, envsForSQLMetaStore("postgres", fmt.Sprintf("%s:%d", postgresql.Host, postgresql.Port), postgresql.Database, postgresql.Options, &postgresql.RisingWaveDBCredentials)...)
+	case f.isMetaStoreMySQL():
+		mysql := metaStore.MySQL
+		envVars = append(envVars, envsForSQLMetaStore("mysql", fmt.Sprintf("%s:%d", mysql.Host, mysql.Port), mysql.Database, mysql.Options, &mysql.RisingWaveDBCredentials)...)
+	case f.isMetaStoreSQLite():
+		envVars = append(envVars, envsForSQLMetaStore("sqlite", metaStore.SQLite.Path, "", nil, nil)...)
 	default:
 		panic("unsupported meta storage type")
 	}
@@ -419,6 +711,10 @@ func (f *RisingWaveObjectFactory) envsForFrontendArgs() []corev1.EnvVar {
 			Name:  envs.RWPrometheusListenerAddr,
 			Value: fmt.Sprintf("0.0.0.0:%d", consts.FrontendMetricsPort),
 		},
+		{
+			Name:  envs.RWHealthCheckListenerAddr,
+			Value: fmt.Sprintf("0.0.0.0:%d", consts.FrontendHealthPort),
+		},
 	}
 }
 
@@ -500,9 +796,34 @@ func (f *RisingWaveObjectFactory) envsForCompactorArgs() []corev1.EnvVar {
 	}
 }
 
+func (f *RisingWaveObjectFactory) minioCredentialsSecretName() string {
+	return f.componentName(consts.ComponentMinIO, "") + "-credentials"
+}
+
+func (f *RisingWaveObjectFactory) minioCredentials() risingwavev1alpha1.RisingWaveMinIOCredentials {
+	minio := f.risingwave.Spec.StateStore.MinIO
+	if minio.Managed == nil {
+		return minio.RisingWaveMinIOCredentials
+	}
+
+	return risingwavev1alpha1.RisingWaveMinIOCredentials{
+		SecretName:     f.minioCredentialsSecretName(),
+		UsernameKeyRef: consts.SecretKeyMinIOUsername,
+		PasswordKeyRef: consts.SecretKeyMinIOPassword,
+	}
+}
+
+func (f *RisingWaveObjectFactory) minioEndpoint() string {
+	minio := f.risingwave.Spec.StateStore.MinIO
+	if minio.Managed == nil {
+		return minio.Endpoint
+	}
+	return fmt.Sprintf("%s:%d", f.componentAddr(consts.ComponentMinIO, ""), consts.MinIOAPIPort)
+}
+
 func (f *RisingWaveObjectFactory) envsForMinIO() []corev1.EnvVar {
 	stateStore := &f.risingwave.Spec.StateStore
-	credentials := &stateStore.MinIO.RisingWaveMinIOCredentials
+	credentials := f.minioCredentials()
 
 	secretRef := corev1.LocalObjectReference{
 		Name: credentials.SecretName,
@@ -511,7 +832,7 @@ func (f *RisingWaveObjectFactory) envsForMinIO() []corev1.EnvVar {
 	return []corev1.EnvVar{
 		{
 			Name:  envs.MinIOEndpoint,
-			Value: stateStore.MinIO.Endpoint,
+			Value: f.minioEndpoint(),
 		},
 		{
 			Name:  envs.MinIOBucket,
@@ -663,6 +984,81 @@ func envsForS3Compatible(region, endpoint, bucket string, credentials risingwave
 	}
 }
 
+func (f *RisingWaveObjectFactory) envsForS3CompatibleStateStore() []corev1.EnvVar {
+	s3Compatible := f.risingwave.Spec.StateStore.S3Compatible
+	preset := s3CompatiblePresets[s3Compatible.Provider]
+
+	endpoint := strings.TrimSpace(lo.Ternary(s3Compatible.Endpoint != "", s3Compatible.Endpoint, preset.endpoint))
+	region := lo.Ternary(s3Compatible.Region != "", s3Compatible.Region, preset.region)
+	addressingStyle := s3Compatible.AddressingStyle
+	if addressingStyle == "" {
+		addressingStyle = lo.Ternary(preset.addressingStyle != "", preset.addressingStyle, risingwavev1alpha1.RisingWaveS3AddressingStylePath)
+	}
+
+	// Interpret the variables.
+	endpoint = strings.ReplaceAll(endpoint, "${REGION}", fmt.Sprintf("$(%s)", envs.S3CompatibleRegion))
+
+	scheme := "https://"
+	if strings.HasPrefix(endpoint, "http://") {
+		scheme = "http://"
+	}
+	endpoint = strings.TrimPrefix(endpoint, scheme)
+
+	// Virtual-hosted style addresses the bucket with the host.
+	if addressingStyle == risingwavev1alpha1.RisingWaveS3AddressingStyleVirtualHosted {
+		endpoint = fmt.Sprintf("$(%s).%s", envs.S3CompatibleBucket, endpoint)
+	}
+
+	envVars := envsForS3Compatible(region, scheme+endpoint, s3Compatible.Bucket, s3Compatible.RisingWaveS3Credentials)
+	envVars = append(envVars, corev1.EnvVar{
+		Name:  envs.S3CompatibleForcePathStyle,
+		Value: strconv.FormatBool(addressingStyle == risingwavev1alpha1.RisingWaveS3AddressingStylePath),
+	})
+
+	if s3Compatible.CA != nil {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  envs.SSLCertFile,
+			Value: path.Join(risingwaveS3CAMountPath, risingwaveS3CAFile),
+		})
+	}
+
+	return envVars
+}
+
+func (f *RisingWaveObjectFactory) s3CompatibleCAVolume() corev1.Volume {
+	ca := f.risingwave.Spec.StateStore.S3Compatible.CA
+
+	return corev1.Volume{
+		Name: risingWaveS3CAVolume,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: ca.Name,
+				Items: []corev1.KeyToPath{
+					{
+						Key:  ca.Key,
+						Path: risingwaveS3CAFile,
+					},
+				},
+				Optional: ca.Optional,
+			},
+		},
+	}
+}
+
+func (f *RisingWaveObjectFactory) volumeMountsForStateStore() []corev1.VolumeMount {
+	if !f.isStateStoreS3CompatibleCAEnabled() {
+		return nil
+	}
+
+	return []corev1.VolumeMount{
+		{
+			Name:      risingWaveS3CAVolume,
+			MountPath: risingwaveS3CAMountPath,
+			ReadOnly:  true,
+		},
+	}
+}
+
 func (f *RisingWaveObjectFactory) envsForGCS() []corev1.EnvVar {
 	gcs := f.risingwave.Spec.StateStore.GCS
 	useWorkloadIdentity := pointer.BoolDeref(gcs.UseWorkloadIdentity, false)
@@ -781,6 +1177,8 @@ func (f *RisingWaveObjectFactory) envsForStateStore() []corev1.EnvVar {
 		return f.envsForMinIO()
 	case f.isStateStoreS3() || f.isStateStoreS3Compatible():
 		return f.envsForS3()
+	case f.risingwave.Spec.StateStore.S3Compatible != nil:
+		return f.envsForS3CompatibleStateStore()
 	case f.isStateStoreGCS():
 		return f.envsForGCS()
 	case f.isStateStoreAliyunOSS():
@@ -798,13 +1196,59 @@ func (f *RisingWaveObjectFactory) envsForStateStore() []corev1.EnvVar {
 	}
 }
 
-func (f *RisingWaveObjectFactory) risingWaveConfigVolume(nodeGroup *risingwavev1alpha1.RisingWaveNodeGroup) corev1.Volume {
-	configSrc := &f.risingwave.Spec.Configuration.RisingWaveNodeConfiguration
+// configurationOfNodeGroup returns the key in the config ConfigMap, the configuration source and the settings in
+// order of precedence for the node group. The source of the node group takes place of the global one if specified,
+// while the settings of the node group override the global ones.
+func (f *RisingWaveObjectFactory) configurationOfNodeGroup(component string, nodeGroup *risingwavev1alpha1.RisingWaveNodeGroup) (string, *risingwavev1alpha1.RisingWaveNodeConfiguration, []*risingwavev1alpha1.RisingWaveConfigurationSettings) {
+	key, configSrc := risingWaveConfigMapKey, &f.risingwave.Spec.Configuration.RisingWaveNodeConfiguration
+
+	var settings []*risingwavev1alpha1.RisingWaveConfigurationSettings
+	if configSrc.Settings != nil {
+		settings = append(settings, configSrc.Settings)
+	}
+
 	if nodeGroup.Configuration != nil {
-		configSrc = nodeGroup.Configuration
+		if nodeGroup.Configuration.ConfigMap != nil || nodeGroup.Configuration.Secret != nil {
+			configSrc = nodeGroup.Configuration
+		}
+		if nodeGroup.Configuration.Settings != nil {
+			settings = append(settings, nodeGroup.Configuration.Settings)
+		}
+		if configSrc == nodeGroup.Configuration || nodeGroup.Configuration.Settings != nil {
+			key = fmt.Sprintf("risingwave-%s%s.toml", component, groupSuffix(nodeGroup.Name))
+		}
 	}
 
-	if configSrc.ConfigMap != nil {
+	return key, configSrc, settings
+}
+
+// isConfigurationRendered tells whether the configuration is rendered into the config ConfigMap by the operator.
+// Configurations from a Secret are never rendered to avoid leaking the sensitive values.
+func isConfigurationRendered(configSrc *risingwavev1alpha1.RisingWaveNodeConfiguration, settings []*risingwavev1alpha1.RisingWaveConfigurationSettings) bool {
+	return len(settings) > 0 && configSrc.Secret == nil
+}
+
+func (f *RisingWaveObjectFactory) risingWaveConfigVolume(component string, nodeGroup *risingwavev1alpha1.RisingWaveNodeGroup) corev1.Volume {
+	key, configSrc, settings := f.configurationOfNodeGroup(component, nodeGroup)
+
+	if isConfigurationRendered(configSrc, settings) {
+		return corev1.Volume{
+			Name: risingWaveConfigVolume,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: f.componentName(consts.ComponentConfig, ""),
+					},
+					Items: []corev1.KeyToPath{
+						{
+							Key:  key,
+							Path: risingwaveConfigFileName,
+						},
+					},
+				},
+			},
+		}
+	} else if configSrc.ConfigMap != nil {
 		return corev1.Volume{
 			Name: risingWaveConfigVolume,
 			VolumeSource: corev1.VolumeSource{
@@ -992,41 +1436,81 @@ func basicSetupRisingWaveContainer(container *corev1.Container, component *risin
 		}, func(env *corev1.EnvVar) bool { return env.Name == envs.RWWorkerThreads })
 	}
 
-	// Set the default probes.
-	container.StartupProbe = &corev1.Probe{
-		InitialDelaySeconds: 5,
-		PeriodSeconds:       5,
-		TimeoutSeconds:      5,
-		FailureThreshold:    12,
-		ProbeHandler: corev1.ProbeHandler{
-			TCPSocket: &corev1.TCPSocketAction{
-				Port: intstr.FromString(consts.PortService),
-			},
+	// Set the default container settings.
+	container.Stdin = false
+	container.StdinOnce = false
+	container.TTY = false
+}
+
+// probeHandlersForComponent returns the default handlers of the liveness (also the startup) probe and the readiness
+// probe of the RisingWave container. Since v2.0.0, the meta, compute and compactor nodes serve the gRPC health service
+// on the service port, which reports serving only after the node has been started and registered, and the frontend
+// serves the health check over HTTP on the health port after it's able to serve queries. The containers of the
+// earlier or unknown versions, e.g., latest and the nightly builds, are probed over TCP on the service port.
+func probeHandlersForComponent(container *corev1.Container, component string) (liveness corev1.ProbeHandler, readiness corev1.ProbeHandler) {
+	tcpHandler := corev1.ProbeHandler{
+		TCPSocket: &corev1.TCPSocketAction{
+			Port: intstr.FromString(consts.PortService),
 		},
 	}
-	container.LivenessProbe = &corev1.Probe{
-		InitialDelaySeconds: 2,
-		PeriodSeconds:       10,
-		ProbeHandler: corev1.ProbeHandler{
-			TCPSocket: &corev1.TCPSocketAction{
-				Port: intstr.FromString(consts.PortService),
-			},
-		},
+	if !utils.IsVersionAtLeast(utils.GetVersionFromImage(container.Image), risingwaveHealthCheckMinVersion) {
+		return tcpHandler, tcpHandler
 	}
-	container.ReadinessProbe = &corev1.Probe{
-		InitialDelaySeconds: 2,
-		PeriodSeconds:       10,
-		ProbeHandler: corev1.ProbeHandler{
-			TCPSocket: &corev1.TCPSocketAction{
-				Port: intstr.FromString(consts.PortService),
+
+	switch component {
+	case consts.ComponentMeta, consts.ComponentCompute, consts.ComponentCompactor:
+		// The gRPC probe only accepts a port number, so look up the one of the named service port.
+		servicePort, ok := lo.Find(container.Ports, func(port corev1.ContainerPort) bool {
+			return port.Name == consts.PortService
+		})
+		if !ok {
+			return tcpHandler, tcpHandler
+		}
+		grpcHandler := corev1.ProbeHandler{
+			GRPC: &corev1.GRPCAction{
+				Port: servicePort.ContainerPort,
 			},
-		},
+		}
+		return grpcHandler, grpcHandler
+	case consts.ComponentFrontend:
+		return tcpHandler, corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: "/health",
+				Port: intstr.FromString(consts.PortHealth),
+			},
+		}
+	default:
+		return tcpHandler, tcpHandler
 	}
+}
 
-	// Set the default container settings.
-	container.Stdin = false
-	container.StdinOnce = false
-	container.TTY = false
+// setupProbesForRisingWaveContainer sets the default probes of the component unless they are set in the node group.
+func setupProbesForRisingWaveContainer(container *corev1.Container, component string) {
+	liveness, readiness := probeHandlersForComponent(container, component)
+
+	if container.StartupProbe == nil {
+		container.StartupProbe = &corev1.Probe{
+			InitialDelaySeconds: 5,
+			PeriodSeconds:       5,
+			TimeoutSeconds:      5,
+			FailureThreshold:    12,
+			ProbeHandler:        liveness,
+		}
+	}
+	if container.LivenessProbe == nil {
+		container.LivenessProbe = &corev1.Probe{
+			InitialDelaySeconds: 2,
+			PeriodSeconds:       10,
+			ProbeHandler:        liveness,
+		}
+	}
+	if container.ReadinessProbe == nil {
+		container.ReadinessProbe = &corev1.Probe{
+			InitialDelaySeconds: 2,
+			PeriodSeconds:       10,
+			ProbeHandler:        readiness,
+		}
+	}
 }
 
 func (f *RisingWaveObjectFactory) setupMetaContainer(container *corev1.Container) {
@@ -1048,6 +1532,11 @@ func (f *RisingWaveObjectFactory) setupMetaContainer(container *corev1.Container
 			return a.Name == b.Name
 		})
 	}
+	for _, volumeMount := range f.volumeMountsForStateStore() {
+		container.VolumeMounts = mergeListWhenKeyEquals(container.VolumeMounts, volumeMount, func(a, b *corev1.VolumeMount) bool {
+			return a.MountPath == b.MountPath
+		})
+	}
 	if f.isMetaStoreEtcd() {
 		for _, env := range f.envsForEtcd() {
 			container.Env = mergeListWhenKeyEquals(container.Env, env, func(a, b *corev1.EnvVar) bool {
@@ -1059,6 +1548,12 @@ func (f *RisingWaveObjectFactory) setupMetaContainer(container *corev1.Container
 	container.VolumeMounts = mergeListWhenKeyEquals(container.VolumeMounts, f.volumeMountForConfig(), func(a, b *corev1.VolumeMount) bool {
 		return a.MountPath == b.MountPath
 	})
+
+	if f.isMetaStoreEtcdTLSEnabled() {
+		container.VolumeMounts = mergeListWhenKeyEquals(container.VolumeMounts, f.volumeMountForEtcdTLS(), func(a, b *corev1.VolumeMount) bool {
+			return a.MountPath == b.MountPath
+		})
+	}
 }
 
 func rollingUpdateOrDefault(rollingUpdate *risingwavev1alpha1.RisingWaveNodeGroupRollingUpdate) risingwavev1alpha1.RisingWaveNodeGroupRollingUpdate {
@@ -1138,6 +1633,9 @@ func newPodSpecFromNodeGroupTemplate(template *risingwavev1alpha1.RisingWaveNode
 				VolumeMounts:    template.Spec.VolumeMounts,
 				VolumeDevices:   template.Spec.VolumeDevices,
 				SecurityContext: template.Spec.RisingWaveNodeContainer.SecurityContext,
+				LivenessProbe:   template.Spec.LivenessProbe,
+				ReadinessProbe:  template.Spec.ReadinessProbe,
+				StartupProbe:    template.Spec.StartupProbe,
 			},
 		}, template.Spec.AdditionalContainers...),
 		EnableServiceLinks:            pointer.Bool(false),
@@ -1186,10 +1684,25 @@ func (f *RisingWaveObjectFactory) buildPodTemplateFromNodeGroup(component string
 	}
 
 	// Inject RisingWave's config volume.
-	podTemplate.Spec.Volumes = mergeListWhenKeyEquals(podTemplate.Spec.Volumes, f.risingWaveConfigVolume(nodeGroup), func(a, b *corev1.Volume) bool {
+	podTemplate.Spec.Volumes = mergeListWhenKeyEquals(podTemplate.Spec.Volumes, f.risingWaveConfigVolume(component, nodeGroup), func(a, b *corev1.Volume) bool {
 		return a.Name == b.Name
 	})
 
+	// Inject the etcd TLS volume for meta.
+	if component == consts.ComponentMeta && f.isMetaStoreEtcdTLSEnabled() {
+		podTemplate.Spec.Volumes = mergeListWhenKeyEquals(podTemplate.Spec.Volumes, f.etcdTLSVolume(), func(a, b *corev1.Volume) bool {
+			return a.Name == b.Name
+		})
+	}
+
+	// Inject the CA volume of the S3-compatible state store for meta, compute and compactor.
+	if lo.Contains([]string{consts.ComponentMeta, consts.ComponentCompute, consts.ComponentCompactor}, component) &&
+		f.isStateStoreS3CompatibleCAEnabled() {
+		podTemplate.Spec.Volumes = mergeListWhenKeyEquals(podTemplate.Spec.Volumes, f.s3CompatibleCAVolume(), func(a, b *corev1.Volume) bool {
+			return a.Name == b.Name
+		})
+	}
+
 	// Run container setup for RisingWave's container.
 	setupRisingWaveContainer(&podTemplate.Spec.Containers[0])
 
@@ -1203,7 +1716,7 @@ func (f *RisingWaveObjectFactory) newDeployment(component string, nodeGroup *ris
 	return &appsv1.Deployment{
 		ObjectMeta: f.getObjectMetaForComponentGroupLevelResources(component, nodeGroup.Name, true),
 		Spec: appsv1.DeploymentSpec{
-			Replicas: pointer.Int32(nodeGroup.Replicas),
+			Replicas: f.replicasOfNodeGroup(nodeGroup),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: f.podLabelsOrSelectorsForComponentGroup(component, nodeGroup.Name),
 			},
@@ -1224,7 +1737,7 @@ func (f *RisingWaveObjectFactory) newCloneSet(component string, nodeGroup *risin
 	return &kruiseappsv1alpha1.CloneSet{
 		ObjectMeta: f.getObjectMetaForComponentGroupLevelResources(component, nodeGroup.Name, true),
 		Spec: kruiseappsv1alpha1.CloneSetSpec{
-			Replicas: pointer.Int32(nodeGroup.Replicas),
+			Replicas: f.replicasOfNodeGroup(nodeGroup),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: f.podLabelsOrSelectorsForComponentGroup(component, nodeGroup.Name),
 			},
@@ -1262,7 +1775,7 @@ func (f *RisingWaveObjectFactory) newStatefulSet(component string, nodeGroup *ri
 		ObjectMeta: f.getObjectMetaForComponentGroupLevelResources(component, nodeGroup.Name, true),
 		Spec: appsv1.StatefulSetSpec{
 			ServiceName:    f.componentName(component, ""),
-			Replicas:       pointer.Int32(nodeGroup.Replicas),
+			Replicas:       f.replicasOfNodeGroup(nodeGroup),
 			UpdateStrategy: buildUpgradeStrategyForStatefulSet(nodeGroup.UpgradeStrategy),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: f.podLabelsOrSelectorsForComponentGroup(component, nodeGroup.Name),
@@ -1285,7 +1798,7 @@ func (f *RisingWaveObjectFactory) newAdvancedStatefulSet(component string, nodeG
 	return &kruiseappsv1beta1.StatefulSet{
 		ObjectMeta: f.getObjectMetaForComponentGroupLevelResources(component, nodeGroup.Name, true),
 		Spec: kruiseappsv1beta1.StatefulSetSpec{
-			Replicas:       pointer.Int32(nodeGroup.Replicas),
+			Replicas:       f.replicasOfNodeGroup(nodeGroup),
 			ServiceName:    f.componentName(component, ""),
 			UpdateStrategy: buildUpgradeStrategyForAdvancedStatefulSet(nodeGroup.UpgradeStrategy),
 			Selector: &metav1.LabelSelector{
@@ -1325,6 +1838,7 @@ func (f *RisingWaveObjectFactory) newPodTemplateSpecFromNodeGroupByComponent(com
 	return f.buildPodTemplateFromNodeGroup(component, nodeGroup, func(container *corev1.Container) {
 		basicSetupRisingWaveContainer(container, componentPtr)
 		containerModifier(container)
+		setupProbesForRisingWaveContainer(container, component)
 	})
 }
 
@@ -1342,6 +1856,22 @@ func newWorkloadObjectForComponentNodeGroup[T client.Object](f *RisingWaveObject
 	return mustSetControllerReference(f.risingwave, workloadObj, f.scheme)
 }
 
+// ReferencedConfigMapsAndSecrets returns the names of the ConfigMaps and Secrets referenced by the Pods of the
+// specified component and group.
+func (f *RisingWaveObjectFactory) ReferencedConfigMapsAndSecrets(component, group string) (configMaps []string, secrets []string) {
+	nodeGroup := object.NewRisingWaveReader(f.risingwave).GetNodeGroup(component, group)
+	template := f.newPodTemplateSpecFromNodeGroupByComponent(component, f.overrideFieldsOfNodeGroup(nodeGroup))
+	configMaps, secrets = utils.GetReferencedConfigMapsAndSecrets(&template.Spec)
+
+	// The ConfigMap merged into the rendered configuration is referenced indirectly.
+	if _, configSrc, settings := f.configurationOfNodeGroup(component, nodeGroup); isConfigurationRendered(configSrc, settings) && configSrc.ConfigMap != nil {
+		configMaps = append(configMaps, configSrc.ConfigMap.Name)
+		sort.Strings(configMaps)
+		configMaps = lo.Uniq(configMaps)
+	}
+	return configMaps, secrets
+}
+
 func (f *RisingWaveObjectFactory) portsForFrontendContainer() []corev1.ContainerPort {
 	return []corev1.ContainerPort{
 		{
@@ -1354,6 +1884,11 @@ func (f *RisingWaveObjectFactory) portsForFrontendContainer() []corev1.Container
 			Protocol:      corev1.ProtocolTCP,
 			ContainerPort: consts.FrontendMetricsPort,
 		},
+		{
+			Name:          consts.PortHealth,
+			Protocol:      corev1.ProtocolTCP,
+			ContainerPort: consts.FrontendHealthPort,
+		},
 	}
 }
 
@@ -1413,6 +1948,11 @@ func (f *RisingWaveObjectFactory) setupComputeContainer(container *corev1.Contai
 			return a.Name == b.Name
 		})
 	}
+	for _, volumeMount := range f.volumeMountsForStateStore() {
+		container.VolumeMounts = mergeListWhenKeyEquals(container.VolumeMounts, volumeMount, func(a, b *corev1.VolumeMount) bool {
+			return a.MountPath == b.MountPath
+		})
+	}
 
 	container.VolumeMounts = mergeListWhenKeyEquals(container.VolumeMounts, f.volumeMountForConfig(), func(a, b *corev1.VolumeMount) bool {
 		return a.MountPath == b.MountPath
@@ -1453,6 +1993,11 @@ func (f *RisingWaveObjectFactory) setupCompactorContainer(container *corev1.Cont
 			return a.Name == b.Name
 		})
 	}
+	for _, volumeMount := range f.volumeMountsForStateStore() {
+		container.VolumeMounts = mergeListWhenKeyEquals(container.VolumeMounts, volumeMount, func(a, b *corev1.VolumeMount) bool {
+			return a.MountPath == b.MountPath
+		})
+	}
 
 	container.VolumeMounts = mergeListWhenKeyEquals(container.VolumeMounts, f.volumeMountForConfig(), func(a, b *corev1.VolumeMount) bool {
 		return a.MountPath == b.MountPath
@@ -1544,10 +2089,79 @@ func (f *RisingWaveObjectFactory) NewMetaService() *corev1.Service {
 	return mustSetControllerReference(f.risingwave, metaSvc, f.scheme)
 }
 
-// NewFrontendService creates a new Service for the frontend.
-func (f *RisingWaveObjectFactory) NewFrontendService() *corev1.Service {
-	frontendSvc := f.newService(consts.ComponentFrontend, f.risingwave.Spec.FrontendServiceType, []corev1.ServicePort{
-		{
+func (f *RisingWaveObjectFactory) metaLeaderServiceName() string {
+	return f.risingwave.Name + "-meta-leader"
+}
+
+// NewMetaLeaderService creates a new Service that selects only the meta leader.
+func (f *RisingWaveObjectFactory) NewMetaLeaderService() *corev1.Service {
+	metaLeaderSvc := f.newService(consts.ComponentMeta, corev1.ServiceTypeClusterIP, []corev1.ServicePort{
+		{
+			Name:       consts.PortService,
+			Protocol:   corev1.ProtocolTCP,
+			Port:       consts.MetaServicePort,
+			TargetPort: intstr.FromString(consts.PortService),
+		},
+		{
+			Name:       consts.PortDashboard,
+			Protocol:   corev1.ProtocolTCP,
+			Port:       consts.MetaDashboardPort,
+			TargetPort: intstr.FromString(consts.PortDashboard),
+		},
+	})
+	metaLeaderSvc.Name = f.metaLeaderServiceName()
+
+	// The role label is maintained by the MetaPodRoleLabeler.
+	metaLeaderSvc.Spec.Selector[consts.LabelRisingWaveMetaRole] = consts.MetaRoleLeader
+
+	return mustSetControllerReference(f.risingwave, metaLeaderSvc, f.scheme)
+}
+
+// NewMetaDashboardIngress creates a new Ingress that routes to the dashboard port of the meta leader Service.
+func (f *RisingWaveObjectFactory) NewMetaDashboardIngress() *networkingv1.Ingress {
+	ingressSpec := f.risingwave.Spec.MetaLeaderService.DashboardIngress
+
+	pathType := networkingv1.PathTypePrefix
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: f.getObjectMetaForGeneralResources(f.risingwave.Name+"-meta-dashboard", true),
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: ingressSpec.IngressClassName,
+			TLS:              ingressSpec.TLS,
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: ingressSpec.Host,
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     lo.If(ingressSpec.Path == "", "/").Else(ingressSpec.Path),
+									PathType: &pathType,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: f.metaLeaderServiceName(),
+											Port: networkingv1.ServiceBackendPort{Name: consts.PortDashboard},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Inject additional metadata.
+	ingress.ObjectMeta.Labels = mergeMap(ingress.ObjectMeta.Labels, ingressSpec.AdditionalMetadata.Labels)
+	ingress.ObjectMeta.Annotations = mergeMap(ingress.ObjectMeta.Annotations, ingressSpec.AdditionalMetadata.Annotations)
+
+	return mustSetControllerReference(f.risingwave, ingress, f.scheme)
+}
+
+// NewFrontendService creates a new Service for the frontend.
+func (f *RisingWaveObjectFactory) NewFrontendService() *corev1.Service {
+	frontendSvc := f.newService(consts.ComponentFrontend, f.risingwave.Spec.FrontendServiceType, []corev1.ServicePort{
+		{
 			Name:       consts.PortService,
 			Protocol:   corev1.ProtocolTCP,
 			Port:       consts.FrontendServicePort,
@@ -1752,15 +2366,704 @@ func (f *RisingWaveObjectFactory) NewComputeAdvancedStatefulSet(group string) *k
 	return newWorkloadObjectForComponentNodeGroup(f, consts.ComponentCompute, group, f.newAdvancedStatefulSet)
 }
 
-// NewConfigConfigMap creates a new ConfigMap with the specified string value for risingwave.toml.
-func (f *RisingWaveObjectFactory) NewConfigConfigMap(val string) *corev1.ConfigMap {
+// podDisruptionBudgetOrDefault returns the PodDisruptionBudget spec of the node group, or the default of the component
+// if neither the minAvailable nor the maxUnavailable is set. Meta keeps a majority of the replicas available so that
+// the quorum is preserved, and the others allow one Pod to be unavailable at a time.
+func podDisruptionBudgetOrDefault(component string, nodeGroup *risingwavev1alpha1.RisingWaveNodeGroup) risingwavev1alpha1.RisingWaveNodeGroupPodDisruptionBudget {
+	pdb := lo.FromPtr(nodeGroup.PodDisruptionBudget)
+	if pdb.MinAvailable != nil || pdb.MaxUnavailable != nil {
+		return pdb
+	}
+
+	if component == consts.ComponentMeta {
+		return risingwavev1alpha1.RisingWaveNodeGroupPodDisruptionBudget{
+			MinAvailable: lo.ToPtr(intstr.FromInt(int(nodeGroup.Replicas/2 + 1))),
+		}
+	}
+	return risingwavev1alpha1.RisingWaveNodeGroupPodDisruptionBudget{
+		MaxUnavailable: lo.ToPtr(intstr.FromInt(1)),
+	}
+}
+
+func (f *RisingWaveObjectFactory) newPodDisruptionBudget(component, group string) *policyv1.PodDisruptionBudget {
+	nodeGroup := object.NewRisingWaveReader(f.risingwave).GetNodeGroup(component, group)
+	pdb := podDisruptionBudgetOrDefault(component, nodeGroup)
+
+	podDisruptionBudget := &policyv1.PodDisruptionBudget{
+		ObjectMeta: f.getObjectMetaForComponentGroupLevelResources(component, group, true),
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: f.podLabelsOrSelectorsForComponentGroup(component, group),
+			},
+			MinAvailable:   pdb.MinAvailable,
+			MaxUnavailable: pdb.MaxUnavailable,
+		},
+	}
+
+	return mustSetControllerReference(f.risingwave, podDisruptionBudget, f.scheme)
+}
+
+// NewMetaPodDisruptionBudget creates a new PodDisruptionBudget for the meta component and specified group.
+func (f *RisingWaveObjectFactory) NewMetaPodDisruptionBudget(group string) *policyv1.PodDisruptionBudget {
+	return f.newPodDisruptionBudget(consts.ComponentMeta, group)
+}
+
+// NewFrontendPodDisruptionBudget creates a new PodDisruptionBudget for the frontend component and specified group.
+func (f *RisingWaveObjectFactory) NewFrontendPodDisruptionBudget(group string) *policyv1.PodDisruptionBudget {
+	return f.newPodDisruptionBudget(consts.ComponentFrontend, group)
+}
+
+// NewComputePodDisruptionBudget creates a new PodDisruptionBudget for the compute component and specified group.
+func (f *RisingWaveObjectFactory) NewComputePodDisruptionBudget(group string) *policyv1.PodDisruptionBudget {
+	return f.newPodDisruptionBudget(consts.ComponentCompute, group)
+}
+
+// NewCompactorPodDisruptionBudget creates a new PodDisruptionBudget for the compactor component and specified group.
+func (f *RisingWaveObjectFactory) NewCompactorPodDisruptionBudget(group string) *policyv1.PodDisruptionBudget {
+	return f.newPodDisruptionBudget(consts.ComponentCompactor, group)
+}
+
+// NewConnectorPodDisruptionBudget creates a new PodDisruptionBudget for the connector component and specified group.
+func (f *RisingWaveObjectFactory) NewConnectorPodDisruptionBudget(group string) *policyv1.PodDisruptionBudget {
+	return f.newPodDisruptionBudget(consts.ComponentConnector, group)
+}
+
+func configMapSourceContent(configMapSrc *risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource, configMaps map[string]*corev1.ConfigMap) (string, error) {
+	var val string
+	var found bool
+	if cm := configMaps[configMapSrc.Name]; cm != nil {
+		val, found = cm.Data[configMapSrc.Key]
+	}
+	if !found && !pointer.BoolDeref(configMapSrc.Optional, false) {
+		return "", fmt.Errorf("key %s not found in configmap %s", configMapSrc.Key, configMapSrc.Name)
+	}
+	return val, nil
+}
+
+func renderConfiguration(configSrc *risingwavev1alpha1.RisingWaveNodeConfiguration, settings []*risingwavev1alpha1.RisingWaveConfigurationSettings, configMaps map[string]*corev1.ConfigMap) (string, error) {
+	var docs []string
+	if configSrc.ConfigMap != nil {
+		base, err := configMapSourceContent(configSrc.ConfigMap, configMaps)
+		if err != nil {
+			return "", err
+		}
+		docs = append(docs, base)
+	}
+	for _, s := range settings {
+		doc, err := toml.Marshal(s)
+		if err != nil {
+			return "", err
+		}
+		docs = append(docs, string(doc))
+	}
+
+	r, err := utils.MergeTOML(docs...)
+	if err != nil {
+		return "", fmt.Errorf("unable to merge the configurations: %w", err)
+	}
+	return r, nil
+}
+
+func (f *RisingWaveObjectFactory) allNodeGroups() map[string][]risingwavev1alpha1.RisingWaveNodeGroup {
+	reader := object.NewRisingWaveReader(f.risingwave)
+	return map[string][]risingwavev1alpha1.RisingWaveNodeGroup{
+		consts.ComponentMeta:      reader.GetNodeGroups(consts.ComponentMeta),
+		consts.ComponentFrontend:  reader.GetNodeGroups(consts.ComponentFrontend),
+		consts.ComponentCompute:   reader.GetNodeGroups(consts.ComponentCompute),
+		consts.ComponentCompactor: reader.GetNodeGroups(consts.ComponentCompactor),
+		consts.ComponentConnector: reader.GetNodeGroups(consts.ComponentConnector),
+	}
+}
+
+// ConfigMapsToMerge returns the names of the ConfigMaps whose contents are merged into the config ConfigMap. They
+// must be provided to NewConfigConfigMap.
+func (f *RisingWaveObjectFactory) ConfigMapsToMerge() []string {
+	var configMaps []string
+	for component, nodeGroups := range f.allNodeGroups() {
+		for i := range nodeGroups {
+			_, configSrc, settings := f.configurationOfNodeGroup(component, &nodeGroups[i])
+			if isConfigurationRendered(configSrc, settings) && configSrc.ConfigMap != nil {
+				configMaps = append(configMaps, configSrc.ConfigMap.Name)
+			}
+		}
+	}
+	sort.Strings(configMaps)
+	return lo.Uniq(configMaps)
+}
+
+// NewConfigConfigMap creates a new ConfigMap with the configurations rendered for the node groups. The `risingwave.toml`
+// is rendered from the global configuration and the others are rendered for the node groups with their own
+// configurations. The provided ConfigMaps must contain the ones returned by ConfigMapsToMerge.
+func (f *RisingWaveObjectFactory) NewConfigConfigMap(configMaps map[string]*corev1.ConfigMap) (*corev1.ConfigMap, error) {
+	data := map[string]string{
+		risingWaveConfigMapKey: "",
+	}
+
+	rendered := make(map[string]bool)
+	for component, nodeGroups := range f.allNodeGroups() {
+		for i := range nodeGroups {
+			key, configSrc, settings := f.configurationOfNodeGroup(component, &nodeGroups[i])
+			if !isConfigurationRendered(configSrc, settings) {
+				continue
+			}
+			if rendered[key] {
+				continue
+			}
+			rendered[key] = true
+
+			val, err := renderConfiguration(configSrc, settings, configMaps)
+			if err != nil {
+				return nil, fmt.Errorf("unable to render configuration for %s group %s: %w", component, nodeGroups[i].Name, err)
+			}
+			data[key] = val
+		}
+	}
+
 	risingwaveConfigConfigMap := &corev1.ConfigMap{
 		ObjectMeta: f.getObjectMetaForComponentLevelResources(consts.ComponentConfig, false), // not synced
-		Data: map[string]string{
-			risingWaveConfigMapKey: val,
+		Data:       data,
+	}
+	return mustSetControllerReference(f.risingwave, risingwaveConfigConfigMap, f.scheme), nil
+}
+
+// NewRevisionConfigMap creates a new ConfigMap to record the given pod templates of the workloads as the last
+// known-good revision.
+func (f *RisingWaveObjectFactory) NewRevisionConfigMap(podTemplates map[string]string) *corev1.ConfigMap {
+	revisionConfigMap := &corev1.ConfigMap{
+		ObjectMeta: f.getObjectMetaForGeneralResources(f.risingwave.Name+"-revision", false), // not synced
+		Data:       podTemplates,
+	}
+	return mustSetControllerReference(f.risingwave, revisionConfigMap, f.scheme)
+}
+
+// NewEtcdService creates a new headless Service for the managed etcd.
+func (f *RisingWaveObjectFactory) NewEtcdService() *corev1.Service {
+	etcdSvc := f.newService(consts.ComponentEtcd, corev1.ServiceTypeClusterIP, []corev1.ServicePort{
+		{
+			Name:       consts.PortClient,
+			Protocol:   corev1.ProtocolTCP,
+			Port:       consts.EtcdClientPort,
+			TargetPort: intstr.FromString(consts.PortClient),
+		},
+		{
+			Name:       consts.PortPeer,
+			Protocol:   corev1.ProtocolTCP,
+			Port:       consts.EtcdPeerPort,
+			TargetPort: intstr.FromString(consts.PortPeer),
+		},
+	})
+
+	// Set the ClusterIP to None to make it a headless service.
+	etcdSvc.Spec.ClusterIP = corev1.ClusterIPNone
+
+	// Members must be able to discover each other before they are ready.
+	etcdSvc.Spec.PublishNotReadyAddresses = true
+
+	return mustSetControllerReference(f.risingwave, etcdSvc, f.scheme)
+}
+
+func (f *RisingWaveObjectFactory) argsForEtcd() []string {
+	managed := f.risingwave.Spec.MetaStore.Etcd.Managed
+	name := f.componentName(consts.ComponentEtcd, "")
+	memberAddr := fmt.Sprintf("$(%s).%s", envs.PodName, f.componentAddr(consts.ComponentEtcd, ""))
+
+	initialCluster := make([]string, 0, managed.Replicas)
+	for i := int32(0); i < managed.Replicas; i++ {
+		initialCluster = append(initialCluster, fmt.Sprintf("%s-%d=http://%s", name, i, f.etcdMemberAddr(i, consts.EtcdPeerPort)))
+	}
+
+	return []string{
+		fmt.Sprintf("--name=$(%s)", envs.PodName),
+		"--data-dir=" + path.Join(etcdDataMountPath, "data"),
+		fmt.Sprintf("--listen-client-urls=http://0.0.0.0:%d", consts.EtcdClientPort),
+		fmt.Sprintf("--advertise-client-urls=http://%s:%d", memberAddr, consts.EtcdClientPort),
+		fmt.Sprintf("--listen-peer-urls=http://0.0.0.0:%d", consts.EtcdPeerPort),
+		fmt.Sprintf("--initial-advertise-peer-urls=http://%s:%d", memberAddr, consts.EtcdPeerPort),
+		"--initial-cluster=" + strings.Join(initialCluster, ","),
+		"--initial-cluster-state=new",
+		"--initial-cluster-token=" + name,
+		// Recommended settings for RisingWave.
+		"--max-txn-ops=999999",
+		"--max-request-bytes=10485760",
+		"--auto-compaction-mode=periodic",
+		"--auto-compaction-retention=1m",
+		"--snapshot-count=10000",
+	}
+}
+
+// NewEtcdStatefulSet creates a new StatefulSet for the managed etcd.
+func (f *RisingWaveObjectFactory) NewEtcdStatefulSet() *appsv1.StatefulSet {
+	managed := f.risingwave.Spec.MetaStore.Etcd.Managed
+	podLabels := f.podLabelsOrSelectorsForComponent(consts.ComponentEtcd)
+
+	healthProbe := corev1.ProbeHandler{
+		HTTPGet: &corev1.HTTPGetAction{
+			Path: "/health",
+			Port: intstr.FromString(consts.PortClient),
+		},
+	}
+
+	podTemplate := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: mergeMap(podLabels, f.getInheritedLabels()),
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:    "etcd",
+					Image:   managed.Image,
+					Command: []string{"/usr/local/bin/etcd"},
+					Args:    f.argsForEtcd(),
+					Env: []corev1.EnvVar{
+						{
+							Name: envs.PodName,
+							ValueFrom: &corev1.EnvVarSource{
+								FieldRef: &corev1.ObjectFieldSelector{
+									FieldPath: "metadata.name",
+								},
+							},
+						},
+						{
+							Name: envs.PodNamespace,
+							ValueFrom: &corev1.EnvVarSource{
+								FieldRef: &corev1.ObjectFieldSelector{
+									FieldPath: "metadata.namespace",
+								},
+							},
+						},
+					},
+					Ports: []corev1.ContainerPort{
+						{
+							Name:          consts.PortClient,
+							Protocol:      corev1.ProtocolTCP,
+							ContainerPort: consts.EtcdClientPort,
+						},
+						{
+							Name:          consts.PortPeer,
+							Protocol:      corev1.ProtocolTCP,
+							ContainerPort: consts.EtcdPeerPort,
+						},
+					},
+					Resources: managed.Resources,
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      etcdDataVolume,
+							MountPath: etcdDataMountPath,
+						},
+					},
+					ReadinessProbe: &corev1.Probe{
+						InitialDelaySeconds: 5,
+						PeriodSeconds:       10,
+						TimeoutSeconds:      5,
+						ProbeHandler:        healthProbe,
+					},
+					LivenessProbe: &corev1.Probe{
+						InitialDelaySeconds: 15,
+						PeriodSeconds:       10,
+						TimeoutSeconds:      5,
+						FailureThreshold:    6,
+						ProbeHandler:        healthProbe,
+					},
+				},
+			},
+		},
+	}
+
+	keepPodSpecConsistent(&podTemplate.Spec)
+
+	etcdSts := &appsv1.StatefulSet{
+		ObjectMeta: f.getObjectMetaForComponentLevelResources(consts.ComponentEtcd, true),
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    pointer.Int32(managed.Replicas),
+			ServiceName: f.componentName(consts.ComponentEtcd, ""),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: podLabels,
+			},
+			// Start all members at the same time to bootstrap the cluster.
+			PodManagementPolicy: appsv1.ParallelPodManagement,
+			Template:            podTemplate,
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				newDataVolumeClaimTemplate(etcdDataVolume, managed.StorageSize, managed.StorageClassName),
+			},
+			PersistentVolumeClaimRetentionPolicy: dataVolumeClaimRetentionPolicy(),
+		},
+	}
+
+	return mustSetControllerReference(f.risingwave, etcdSts, f.scheme)
+}
+
+func newDataVolumeClaimTemplate(name string, size resource.Quantity, storageClassName *string) corev1.PersistentVolumeClaim {
+	return corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+			},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: size,
+				},
+			},
+			StorageClassName: storageClassName,
+		},
+	}
+}
+
+// dataVolumeClaimRetentionPolicy returns the retention policy of the data volume claims of the stores managed by
+// the operator. The PVCs are deleted together with the RisingWave but kept when scaled in.
+func dataVolumeClaimRetentionPolicy() *appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy {
+	return &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+		WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+		WhenScaled:  appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+	}
+}
+
+// NewMinIOService creates a new headless Service for the managed MinIO.
+func (f *RisingWaveObjectFactory) NewMinIOService() *corev1.Service {
+	minioSvc := f.newService(consts.ComponentMinIO, corev1.ServiceTypeClusterIP, []corev1.ServicePort{
+		{
+			Name:       consts.PortAPI,
+			Protocol:   corev1.ProtocolTCP,
+			Port:       consts.MinIOAPIPort,
+			TargetPort: intstr.FromString(consts.PortAPI),
+		},
+		{
+			Name:       consts.PortConsole,
+			Protocol:   corev1.ProtocolTCP,
+			Port:       consts.MinIOConsolePort,
+			TargetPort: intstr.FromString(consts.PortConsole),
+		},
+	})
+
+	// Set the ClusterIP to None to make it a headless service.
+	minioSvc.Spec.ClusterIP = corev1.ClusterIPNone
+
+	// Servers of a distributed MinIO must be able to discover each other before they are ready.
+	minioSvc.Spec.PublishNotReadyAddresses = true
+
+	return mustSetControllerReference(f.risingwave, minioSvc, f.scheme)
+}
+
+// NewMinIOCredentialsSecret creates a new Secret with the root credentials of the managed MinIO.
+func (f *RisingWaveObjectFactory) NewMinIOCredentialsSecret(password string) *corev1.Secret {
+	objectMeta := f.getObjectMetaForComponentLevelResources(consts.ComponentMinIO, false)
+	objectMeta.Name = f.minioCredentialsSecretName()
+
+	minioSecret := &corev1.Secret{
+		ObjectMeta: objectMeta,
+		Type:       corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			consts.SecretKeyMinIOUsername: []byte(minioRootUser),
+			consts.SecretKeyMinIOPassword: []byte(password),
+		},
+	}
+
+	return mustSetControllerReference(f.risingwave, minioSecret, f.scheme)
+}
+
+func (f *RisingWaveObjectFactory) envsForMinIORootCredentials() []corev1.EnvVar {
+	secretRef := corev1.LocalObjectReference{
+		Name: f.minioCredentialsSecretName(),
+	}
+
+	return []corev1.EnvVar{
+		{
+			Name: envs.MinIORootUser,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: secretRef,
+					Key:                  consts.SecretKeyMinIOUsername,
+				},
+			},
+		},
+		{
+			Name: envs.MinIORootPassword,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: secretRef,
+					Key:                  consts.SecretKeyMinIOPassword,
+				},
+			},
+		},
+		{
+			Name: envs.PodNamespace,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.namespace",
+				},
+			},
+		},
+	}
+}
+
+func (f *RisingWaveObjectFactory) argsForMinIO() []string {
+	managed := f.risingwave.Spec.StateStore.MinIO.Managed
+
+	// Single-node MinIO serves the local directory, while the distributed MinIO uses the expansion
+	// notation to list all the servers, e.g., http://minio-{0...3}.minio/data.
+	dataDir := minioDataMountPath
+	if managed.Replicas > 1 {
+		dataDir = fmt.Sprintf("http://%s-{0...%d}.%s%s", f.componentName(consts.ComponentMinIO, ""),
+			managed.Replicas-1, f.componentAddr(consts.ComponentMinIO, ""), minioDataMountPath)
+	}
+
+	return []string{
+		"server",
+		dataDir,
+		fmt.Sprintf("--address=:%d", consts.MinIOAPIPort),
+		fmt.Sprintf("--console-address=:%d", consts.MinIOConsolePort),
+	}
+}
+
+// NewMinIOStatefulSet creates a new StatefulSet for the managed MinIO.
+func (f *RisingWaveObjectFactory) NewMinIOStatefulSet() *appsv1.StatefulSet {
+	managed := f.risingwave.Spec.StateStore.MinIO.Managed
+	podLabels := f.podLabelsOrSelectorsForComponent(consts.ComponentMinIO)
+
+	podTemplate := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: mergeMap(podLabels, f.getInheritedLabels()),
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:    "minio",
+					Image:   managed.Image,
+					Command: []string{"minio"},
+					Args:    f.argsForMinIO(),
+					Env:     f.envsForMinIORootCredentials(),
+					Ports: []corev1.ContainerPort{
+						{
+							Name:          consts.PortAPI,
+							Protocol:      corev1.ProtocolTCP,
+							ContainerPort: consts.MinIOAPIPort,
+						},
+						{
+							Name:          consts.PortConsole,
+							Protocol:      corev1.ProtocolTCP,
+							ContainerPort: consts.MinIOConsolePort,
+						},
+					},
+					Resources: managed.Resources,
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      minioDataVolume,
+							MountPath: minioDataMountPath,
+						},
+					},
+					ReadinessProbe: &corev1.Probe{
+						InitialDelaySeconds: 5,
+						PeriodSeconds:       10,
+						TimeoutSeconds:      5,
+						ProbeHandler: corev1.ProbeHandler{
+							HTTPGet: &corev1.HTTPGetAction{
+								Path: "/minio/health/ready",
+								Port: intstr.FromString(consts.PortAPI),
+							},
+						},
+					},
+					LivenessProbe: &corev1.Probe{
+						InitialDelaySeconds: 15,
+						PeriodSeconds:       10,
+						TimeoutSeconds:      5,
+						FailureThreshold:    6,
+						ProbeHandler: corev1.ProbeHandler{
+							HTTPGet: &corev1.HTTPGetAction{
+								Path: "/minio/health/live",
+								Port: intstr.FromString(consts.PortAPI),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
-	return mustSetControllerReference(f.risingwave, risingwaveConfigConfigMap, f.scheme)
+
+	keepPodSpecConsistent(&podTemplate.Spec)
+
+	minioSts := &appsv1.StatefulSet{
+		ObjectMeta: f.getObjectMetaForComponentLevelResources(consts.ComponentMinIO, true),
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    pointer.Int32(managed.Replicas),
+			ServiceName: f.componentName(consts.ComponentMinIO, ""),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: podLabels,
+			},
+			// Start all servers at the same time to bootstrap the distributed MinIO.
+			PodManagementPolicy: appsv1.ParallelPodManagement,
+			Template:            podTemplate,
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				newDataVolumeClaimTemplate(minioDataVolume, managed.StorageSize, managed.StorageClassName),
+			},
+			PersistentVolumeClaimRetentionPolicy: dataVolumeClaimRetentionPolicy(),
+		},
+	}
+
+	return mustSetControllerReference(f.risingwave, minioSts, f.scheme)
+}
+
+// NewMinIOBucketJob creates a new Job to create the bucket in the managed MinIO.
+func (f *RisingWaveObjectFactory) NewMinIOBucketJob() *batchv1.Job {
+	minio := f.risingwave.Spec.StateStore.MinIO
+
+	// The job isn't synced after it's created because the pod template of Job is immutable.
+	objectMeta := f.getObjectMetaForComponentLevelResources(consts.ComponentMinIO, false)
+	objectMeta.Name = f.componentName(consts.ComponentMinIO, "") + "-bucket"
+
+	podTemplate := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			// Don't label the pods with the component, or they will be selected by the MinIO service.
+			Labels: mergeMap(map[string]string{
+				consts.LabelRisingWaveName: f.risingwave.Name,
+			}, f.getInheritedLabels()),
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyOnFailure,
+			Containers: []corev1.Container{
+				{
+					Name:    "mc",
+					Image:   minio.Managed.ClientImage,
+					Command: []string{"mc"},
+					Args:    []string{"mb", "--ignore-existing", minioClientAlias + "/" + minio.Bucket},
+					// The alias of the MinIO client is configured with the environment variable MC_HOST_<alias>.
+					Env: append(f.envsForMinIORootCredentials(), corev1.EnvVar{
+						Name: envs.MinIOClientHostPrefix + minioClientAlias,
+						Value: fmt.Sprintf("http://$(%s):$(%s)@%s", envs.MinIORootUser, envs.MinIORootPassword,
+							f.minioEndpoint()),
+					}),
+				},
+			},
+		},
+	}
+
+	keepPodSpecConsistent(&podTemplate.Spec)
+
+	bucketJob := &batchv1.Job{
+		ObjectMeta: objectMeta,
+		Spec: batchv1.JobSpec{
+			BackoffLimit: pointer.Int32(10),
+			Template:     podTemplate,
+		},
+	}
+
+	return mustSetControllerReference(f.risingwave, bucketJob, f.scheme)
+}
+
+// restoreSourceFactory returns a factory of the RisingWave with the state store replaced by the source one to restore
+// from, to build the connection string and the environment variables of the source state store.
+func (f *RisingWaveObjectFactory) restoreSourceFactory() *RisingWaveObjectFactory {
+	risingwave := f.risingwave.DeepCopy()
+	risingwave.Spec.StateStore = risingwave.Spec.RestoreFrom.StateStore
+	return NewRisingWaveObjectFactory(risingwave, f.scheme, f.operatorVersion)
+}
+
+// argsForRestoreSQLMetaStore returns the arguments of the SQL meta store for the restore command. The credentials are
+// referenced with the environment variables, which are escaped by RisingWave as in envsForSQLMetaStore.
+func argsForRestoreSQLMetaStore(backend, host string, port int32, database string, options map[string]string) []string {
+	args := []string{"--meta-store-type", backend,
+		"--sql-endpoint", fmt.Sprintf("%s:%d", host, port),
+		"--sql-username", fmt.Sprintf("$(%s)", envs.RWSQLUsername),
+		"--sql-password", fmt.Sprintf("$(%s)", envs.RWSQLPassword),
+		"--sql-database", database,
+	}
+	if len(options) > 0 {
+		args = append(args, "--sql-url-params", sqlURLParams(options))
+	}
+	return args
+}
+
+func (f *RisingWaveObjectFactory) argsAndEnvsForRestoreMetaStore() ([]string, []corev1.EnvVar) {
+	metaStore := &f.risingwave.Spec.MetaStore
+
+	switch {
+	case f.isMetaStoreEtcd():
+		args := []string{"--meta-store-type", "etcd", "--etcd-endpoints", f.etcdEndpoint()}
+		envVars := f.envsForEtcdCredentials()
+		if len(envVars) > 0 {
+			args = append(args, "--etcd-auth",
+				"--etcd-username", fmt.Sprintf("$(%s)", envs.RWEtcdUsername),
+				"--etcd-password", fmt.Sprintf("$(%s)", envs.RWEtcdPassword),
+			)
+		}
+		return args, envVars
+	case f.isMetaStorePostgreSQL():
+		postgresql := metaStore.PostgreSQL
+		return argsForRestoreSQLMetaStore("postgres", postgresql.Host, postgresql.Port, postgresql.Database, postgresql.Options),
+			envsForDBCredentials(envs.RWSQLUsername, envs.RWSQLPassword, &postgresql.RisingWaveDBCredentials)
+	case f.isMetaStoreMySQL():
+		mysql := metaStore.MySQL
+		return argsForRestoreSQLMetaStore("mysql", mysql.Host, mysql.Port, mysql.Database, mysql.Options),
+			envsForDBCredentials(envs.RWSQLUsername, envs.RWSQLPassword, &mysql.RisingWaveDBCredentials)
+	default:
+		panic("unsupported meta storage type for restoring")
+	}
+}
+
+// NewMetaRestoreJob creates a new Job to restore the meta store from the meta snapshot in spec.restoreFrom.
+func (f *RisingWaveObjectFactory) NewMetaRestoreJob() *batchv1.Job {
+	restoreFrom := f.risingwave.Spec.RestoreFrom
+	source := f.restoreSourceFactory()
+
+	// The job isn't synced after it's created because the pod template of Job is immutable.
+	objectMeta := f.getObjectMetaForComponentLevelResources(consts.ComponentMeta, false)
+	objectMeta.Name = f.componentName(consts.ComponentMeta, "") + "-restore"
+
+	metaStoreArgs, metaStoreEnvVars := f.argsAndEnvsForRestoreMetaStore()
+	args := append([]string{"ctl", "meta", "restore-meta"}, metaStoreArgs...)
+	args = append(args,
+		"--meta-snapshot-id", strconv.FormatInt(restoreFrom.SnapshotID, 10),
+		"--backup-storage-url", strings.TrimPrefix(source.hummockConnectionStr(), "hummock+"),
+		"--backup-storage-directory", restoreFrom.BackupDirectory,
+		"--hummock-storage-url", strings.TrimPrefix(f.hummockConnectionStr(), "hummock+"),
+		"--hummock-storage-directory", f.risingwave.Spec.StateStore.DataDirectory,
+	)
+
+	// The environment variables of the source state store take precedence when they're of the same type.
+	envVars := metaStoreEnvVars
+	for _, env := range append(f.envsForStateStore(), source.envsForStateStore()...) {
+		envVars = mergeListWhenKeyEquals(envVars, env, func(a, b *corev1.EnvVar) bool {
+			return a.Name == b.Name
+		})
+	}
+
+	podTemplate := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			// Don't label the pods with the component, or they will be selected as meta pods.
+			Labels: mergeMap(map[string]string{
+				consts.LabelRisingWaveName: f.risingwave.Name,
+			}, f.getInheritedLabels()),
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyOnFailure,
+			Containers: []corev1.Container{
+				{
+					Name:         "restore",
+					Image:        f.risingwave.Spec.Image,
+					Command:      []string{risingwaveExecutablePath},
+					Args:         args,
+					Env:          envVars,
+					VolumeMounts: source.volumeMountsForStateStore(),
+				},
+			},
+		},
+	}
+	if source.isStateStoreS3CompatibleCAEnabled() {
+		podTemplate.Spec.Volumes = []corev1.Volume{source.s3CompatibleCAVolume()}
+	}
+
+	keepPodSpecConsistent(&podTemplate.Spec)
+
+	restoreJob := &batchv1.Job{
+		ObjectMeta: objectMeta,
+		Spec: batchv1.JobSpec{
+			BackoffLimit: pointer.Int32(3),
+			Template:     podTemplate,
+		},
+	}
+
+	return mustSetControllerReference(f.risingwave, restoreJob, f.scheme)
 }
 
 // NewServiceMonitor creates a new ServiceMonitor.
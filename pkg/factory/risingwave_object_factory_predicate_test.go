@@ -965,6 +965,18 @@ func stateStoreStatefulsetPredicates() []predicate[*appsv1.StatefulSet, stateSto
 				return listContainsByKey(obj.Spec.Template.Spec.Containers[0].Env, tc.envs, func(t *corev1.EnvVar) string { return t.Name }, deepEqual[corev1.EnvVar])
 			},
 		},
+		{
+			Name: "volumes-contains",
+			Fn: func(obj *appsv1.StatefulSet, tc stateStoresTestCase) bool {
+				return listContainsByKey(obj.Spec.Template.Spec.Volumes, tc.volumes, func(t *corev1.Volume) string { return t.Name }, deepEqual[corev1.Volume])
+			},
+		},
+		{
+			Name: "volume-mounts-contains",
+			Fn: func(obj *appsv1.StatefulSet, tc stateStoresTestCase) bool {
+				return listContainsByKey(obj.Spec.Template.Spec.Containers[0].VolumeMounts, tc.volumeMounts, func(t *corev1.VolumeMount) string { return t.MountPath }, deepEqual[corev1.VolumeMount])
+			},
+		},
 	}
 }
 
@@ -991,9 +1003,7 @@ func configMapPredicates() []predicate[*corev1.ConfigMap, configMapTestCase] {
 		{
 			Name: "configmap-data-match",
 			Fn: func(obj *corev1.ConfigMap, tc configMapTestCase) bool {
-				return mapEquals(obj.Data, map[string]string{
-					risingWaveConfigMapKey: lo.If(tc.configVal == "", "").Else(tc.configVal),
-				})
+				return mapEquals(obj.Data, tc.expectedData)
 			},
 		},
 	}
@@ -1007,6 +1017,18 @@ func metaStorePredicates() []predicate[*appsv1.StatefulSet, metaStoreTestCase] {
 				return listContainsByKey(obj.Spec.Template.Spec.Containers[0].Env, tc.envs, func(t *corev1.EnvVar) string { return t.Name }, deepEqual[corev1.EnvVar])
 			},
 		},
+		{
+			Name: "volumes-contains",
+			Fn: func(obj *appsv1.StatefulSet, tc metaStoreTestCase) bool {
+				return listContainsByKey(obj.Spec.Template.Spec.Volumes, tc.volumes, func(t *corev1.Volume) string { return t.Name }, deepEqual[corev1.Volume])
+			},
+		},
+		{
+			Name: "volume-mounts-contains",
+			Fn: func(obj *appsv1.StatefulSet, tc metaStoreTestCase) bool {
+				return listContainsByKey(obj.Spec.Template.Spec.Containers[0].VolumeMounts, tc.volumeMounts, func(t *corev1.VolumeMount) string { return t.MountPath }, deepEqual[corev1.VolumeMount])
+			},
+		},
 	}
 }
 
@@ -2880,8 +2880,10 @@ func serviceMetadataTestCases() map[string]serviceMetadataTestCase {
 
 type stateStoresTestCase struct {
 	baseTestCase
-	stateStore risingwavev1alpha1.RisingWaveStateStoreBackend
-	envs       []corev1.EnvVar
+	stateStore   risingwavev1alpha1.RisingWaveStateStoreBackend
+	envs         []corev1.EnvVar
+	volumes      []corev1.Volume
+	volumeMounts []corev1.VolumeMount
 }
 
 func stateStoreTestCases() map[string]stateStoresTestCase {
@@ -3209,6 +3211,163 @@ func stateStoreTestCases() map[string]stateStoresTestCase {
 				},
 			},
 		},
+		"s3-compatible-huawei-obs": {
+			stateStore: risingwavev1alpha1.RisingWaveStateStoreBackend{
+				S3Compatible: &risingwavev1alpha1.RisingWaveStateStoreBackendS3Compatible{
+					Provider: risingwavev1alpha1.RisingWaveS3CompatibleProviderHuaweiOBS,
+					Bucket:   "s3-hummock01",
+					Region:   "cn-north-4",
+					RisingWaveS3Credentials: risingwavev1alpha1.RisingWaveS3Credentials{
+						SecretName:         "s3-creds",
+						AccessKeyRef:       consts.SecretKeyAWSS3AccessKeyID,
+						SecretAccessKeyRef: consts.SecretKeyAWSS3SecretAccessKey,
+					},
+				},
+			},
+			envs: []corev1.EnvVar{
+				{
+					Name:  "RW_STATE_STORE",
+					Value: "hummock+s3://s3-hummock01",
+				},
+				{
+					Name:  "AWS_REGION",
+					Value: "cn-north-4",
+				},
+				{
+					Name:  "RW_S3_ENDPOINT",
+					Value: "https://$(AWS_S3_BUCKET).obs.$(AWS_REGION).myhuaweicloud.com",
+				},
+				{
+					Name:  "RW_IS_FORCE_PATH_STYLE",
+					Value: "false",
+				},
+				{
+					Name: "AWS_ACCESS_KEY_ID",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "s3-creds",
+							},
+							Key: consts.SecretKeyAWSS3AccessKeyID,
+						},
+					},
+				},
+			},
+		},
+		"s3-compatible-tencent-cos-path-style": {
+			stateStore: risingwavev1alpha1.RisingWaveStateStoreBackend{
+				S3Compatible: &risingwavev1alpha1.RisingWaveStateStoreBackendS3Compatible{
+					Provider:        risingwavev1alpha1.RisingWaveS3CompatibleProviderTencentCOS,
+					Bucket:          "s3-hummock01",
+					Region:          "ap-guangzhou",
+					AddressingStyle: risingwavev1alpha1.RisingWaveS3AddressingStylePath,
+					RisingWaveS3Credentials: risingwavev1alpha1.RisingWaveS3Credentials{
+						SecretName:         "s3-creds",
+						AccessKeyRef:       consts.SecretKeyAWSS3AccessKeyID,
+						SecretAccessKeyRef: consts.SecretKeyAWSS3SecretAccessKey,
+					},
+				},
+			},
+			envs: []corev1.EnvVar{
+				{
+					Name:  "RW_S3_ENDPOINT",
+					Value: "https://cos.$(AWS_REGION).myqcloud.com",
+				},
+				{
+					Name:  "RW_IS_FORCE_PATH_STYLE",
+					Value: "true",
+				},
+			},
+		},
+		"s3-compatible-cloudflare-r2": {
+			stateStore: risingwavev1alpha1.RisingWaveStateStoreBackend{
+				S3Compatible: &risingwavev1alpha1.RisingWaveStateStoreBackendS3Compatible{
+					Provider: risingwavev1alpha1.RisingWaveS3CompatibleProviderCloudflareR2,
+					Bucket:   "s3-hummock01",
+					Endpoint: "account-id.r2.cloudflarestorage.com",
+					RisingWaveS3Credentials: risingwavev1alpha1.RisingWaveS3Credentials{
+						SecretName:         "s3-creds",
+						AccessKeyRef:       consts.SecretKeyAWSS3AccessKeyID,
+						SecretAccessKeyRef: consts.SecretKeyAWSS3SecretAccessKey,
+					},
+				},
+			},
+			envs: []corev1.EnvVar{
+				{
+					Name:  "AWS_REGION",
+					Value: "auto",
+				},
+				{
+					Name:  "RW_S3_ENDPOINT",
+					Value: "https://account-id.r2.cloudflarestorage.com",
+				},
+				{
+					Name:  "RW_IS_FORCE_PATH_STYLE",
+					Value: "true",
+				},
+			},
+		},
+		"s3-compatible-ceph-rgw-with-ca": {
+			stateStore: risingwavev1alpha1.RisingWaveStateStoreBackend{
+				S3Compatible: &risingwavev1alpha1.RisingWaveStateStoreBackendS3Compatible{
+					Provider: risingwavev1alpha1.RisingWaveS3CompatibleProviderCephRGW,
+					Bucket:   "s3-hummock01",
+					Endpoint: "http://ceph-rgw.storage:7480",
+					CA: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "ceph-ca",
+						},
+						Key: "ca.pem",
+					},
+					RisingWaveS3Credentials: risingwavev1alpha1.RisingWaveS3Credentials{
+						SecretName:         "s3-creds",
+						AccessKeyRef:       consts.SecretKeyAWSS3AccessKeyID,
+						SecretAccessKeyRef: consts.SecretKeyAWSS3SecretAccessKey,
+					},
+				},
+			},
+			envs: []corev1.EnvVar{
+				{
+					Name:  "AWS_REGION",
+					Value: "us-east-1",
+				},
+				{
+					Name:  "RW_S3_ENDPOINT",
+					Value: "http://ceph-rgw.storage:7480",
+				},
+				{
+					Name:  "RW_IS_FORCE_PATH_STYLE",
+					Value: "true",
+				},
+				{
+					Name:  "SSL_CERT_FILE",
+					Value: "/risingwave/s3-ca/ca.crt",
+				},
+			},
+			volumes: []corev1.Volume{
+				{
+					Name: "risingwave-s3-ca",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: "ceph-ca",
+							Items: []corev1.KeyToPath{
+								{
+									Key:  "ca.pem",
+									Path: "ca.crt",
+								},
+							},
+						},
+					},
+				},
+			},
+			volumeMounts: []corev1.VolumeMount{
+				{
+					Name:      "risingwave-s3-ca",
+					MountPath: "/risingwave/s3-ca",
+					ReadOnly:  true,
+				},
+			},
+		},
 		"s3-compatible-virtual-hosted-style": {
 			stateStore: risingwavev1alpha1.RisingWaveStateStoreBackend{
 				S3: &risingwavev1alpha1.RisingWaveStateStoreBackendS3{
@@ -3449,17 +3608,139 @@ func stateStoreTestCases() map[string]stateStoresTestCase {
 }
 
 type configMapTestCase struct {
-	risingwave *risingwavev1alpha1.RisingWave
-	configVal  string
+	risingwave   *risingwavev1alpha1.RisingWave
+	patch        func(r *risingwavev1alpha1.RisingWave)
+	configMaps   map[string]*corev1.ConfigMap
+	expectedData map[string]string
 }
 
 func configMapTestCases() map[string]configMapTestCase {
+	withComputeGroup := func(configuration *risingwavev1alpha1.RisingWaveNodeConfiguration) func(r *risingwavev1alpha1.RisingWave) {
+		return func(r *risingwavev1alpha1.RisingWave) {
+			r.Spec.Components.Compute.NodeGroups = []risingwavev1alpha1.RisingWaveNodeGroup{
+				{Name: "", Replicas: 1},
+				{Name: "g1", Replicas: 1, Configuration: configuration},
+			}
+		}
+	}
+
 	return map[string]configMapTestCase{
 		"empty-val": {
-			configVal: "",
+			expectedData: map[string]string{
+				risingWaveConfigMapKey: "",
+			},
 		},
-		"non-empty-val": {
-			configVal: "a",
+		"configmap-only": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.ConfigMap = &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{
+					Name: "a",
+					Key:  "b",
+				}
+				r.Spec.Components.Compute.NodeGroups = []risingwavev1alpha1.RisingWaveNodeGroup{{Name: "", Replicas: 1}}
+			},
+			expectedData: map[string]string{
+				risingWaveConfigMapKey: "",
+			},
+		},
+		"global-settings": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.Settings = &risingwavev1alpha1.RisingWaveConfigurationSettings{
+					Server: &risingwavev1alpha1.RisingWaveServerSettings{
+						HeartbeatIntervalMs: pointer.Int32(2000),
+					},
+				}
+				r.Spec.Components.Compute.NodeGroups = []risingwavev1alpha1.RisingWaveNodeGroup{{Name: "", Replicas: 1}}
+			},
+			expectedData: map[string]string{
+				risingWaveConfigMapKey: "[server]\nheartbeat_interval_ms = 2000\n",
+			},
+		},
+		"global-settings-merged-with-configmap": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.ConfigMap = &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{
+					Name: "a",
+					Key:  "b",
+				}
+				r.Spec.Configuration.Settings = &risingwavev1alpha1.RisingWaveConfigurationSettings{
+					Server: &risingwavev1alpha1.RisingWaveServerSettings{
+						HeartbeatIntervalMs: pointer.Int32(2000),
+					},
+				}
+				r.Spec.Components.Compute.NodeGroups = []risingwavev1alpha1.RisingWaveNodeGroup{{Name: "", Replicas: 1}}
+			},
+			configMaps: map[string]*corev1.ConfigMap{
+				"a": {
+					Data: map[string]string{
+						"b": "[server]\nheartbeat_interval_ms = 1000\nmetrics_level = 'Info'\n",
+					},
+				},
+			},
+			expectedData: map[string]string{
+				risingWaveConfigMapKey: "[server]\nheartbeat_interval_ms = 2000\nmetrics_level = 'Info'\n",
+			},
+		},
+		"node-group-settings-override": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.Settings = &risingwavev1alpha1.RisingWaveConfigurationSettings{
+					Storage: &risingwavev1alpha1.RisingWaveStorageSettings{
+						BlockCacheCapacityMB: pointer.Int32(512),
+						DataFileCache: &risingwavev1alpha1.RisingWaveFileCacheSettings{
+							Dir: pointer.String("/cache"),
+						},
+					},
+				}
+				withComputeGroup(&risingwavev1alpha1.RisingWaveNodeConfiguration{
+					Settings: &risingwavev1alpha1.RisingWaveConfigurationSettings{
+						Storage: &risingwavev1alpha1.RisingWaveStorageSettings{
+							DataFileCache: &risingwavev1alpha1.RisingWaveFileCacheSettings{
+								CapacityMB: pointer.Int32(1024),
+							},
+						},
+					},
+				})(r)
+			},
+			expectedData: map[string]string{
+				risingWaveConfigMapKey:       "[storage]\nblock_cache_capacity_mb = 512\n\n[storage.data_file_cache]\ndir = '/cache'\n",
+				"risingwave-compute-g1.toml": "[storage]\nblock_cache_capacity_mb = 512\n\n[storage.data_file_cache]\ncapacity_mb = 1024\ndir = '/cache'\n",
+			},
+		},
+		"node-group-configmap-with-global-settings": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.Settings = &risingwavev1alpha1.RisingWaveConfigurationSettings{
+					Streaming: &risingwavev1alpha1.RisingWaveStreamingSettings{
+						InFlightBarrierNums: pointer.Int32(100),
+					},
+				}
+				withComputeGroup(&risingwavev1alpha1.RisingWaveNodeConfiguration{
+					ConfigMap: &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{
+						Name:     "c",
+						Key:      "risingwave.toml",
+						Optional: pointer.Bool(true),
+					},
+				})(r)
+			},
+			expectedData: map[string]string{
+				risingWaveConfigMapKey:       "[streaming]\nin_flight_barrier_nums = 100\n",
+				"risingwave-compute-g1.toml": "[streaming]\nin_flight_barrier_nums = 100\n",
+			},
+		},
+		"node-group-secret-not-rendered": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				withComputeGroup(&risingwavev1alpha1.RisingWaveNodeConfiguration{
+					Secret: &risingwavev1alpha1.RisingWaveNodeConfigurationSecretSource{
+						Name: "s",
+						Key:  "risingwave.toml",
+					},
+					Settings: &risingwavev1alpha1.RisingWaveConfigurationSettings{
+						Server: &risingwavev1alpha1.RisingWaveServerSettings{
+							TelemetryEnabled: pointer.Bool(false),
+						},
+					},
+				})(r)
+			},
+			expectedData: map[string]string{
+				risingWaveConfigMapKey: "",
+			},
 		},
 	}
 }
@@ -3597,8 +3878,10 @@ func inheritedLabelsTestCases() map[string]inheritedLabelsTestCase {
 }
 
 type metaStoreTestCase struct {
-	metaStore risingwavev1alpha1.RisingWaveMetaStoreBackend
-	envs      []corev1.EnvVar
+	metaStore    risingwavev1alpha1.RisingWaveMetaStoreBackend
+	envs         []corev1.EnvVar
+	volumes      []corev1.Volume
+	volumeMounts []corev1.VolumeMount
 }
 
 func metaStoreTestCases() map[string]metaStoreTestCase {
@@ -3675,5 +3958,245 @@ func metaStoreTestCases() map[string]metaStoreTestCase {
 				},
 			},
 		},
+		"etcd-mtls": {
+			metaStore: risingwavev1alpha1.RisingWaveMetaStoreBackend{
+				Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+					Endpoint: "etcd:1234",
+					TLS: &risingwavev1alpha1.RisingWaveEtcdTLS{
+						CA: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "etcd-ca",
+							},
+							Key: "ca.pem",
+						},
+						ClientCert: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "etcd-client",
+							},
+							Key: "client.pem",
+						},
+						ClientKey: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "etcd-client",
+							},
+							Key: "client-key.pem",
+						},
+						ServerName: "etcd.example.com",
+					},
+				},
+			},
+			envs: []corev1.EnvVar{
+				{
+					Name:  "RW_BACKEND",
+					Value: "etcd",
+				},
+				{
+					Name:  "RW_ETCD_ENDPOINTS",
+					Value: "etcd:1234",
+				},
+				{
+					Name:  "RW_ETCD_TLS",
+					Value: "true",
+				},
+				{
+					Name:  "RW_ETCD_TLS_CA",
+					Value: "/risingwave/etcd-tls/ca.crt",
+				},
+				{
+					Name:  "RW_ETCD_TLS_CERT",
+					Value: "/risingwave/etcd-tls/tls.crt",
+				},
+				{
+					Name:  "RW_ETCD_TLS_KEY",
+					Value: "/risingwave/etcd-tls/tls.key",
+				},
+				{
+					Name:  "RW_ETCD_TLS_SERVER_NAME",
+					Value: "etcd.example.com",
+				},
+			},
+			volumes: []corev1.Volume{
+				{
+					Name: "risingwave-etcd-tls",
+					VolumeSource: corev1.VolumeSource{
+						Projected: &corev1.ProjectedVolumeSource{
+							Sources: []corev1.VolumeProjection{
+								{
+									Secret: &corev1.SecretProjection{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: "etcd-ca",
+										},
+										Items: []corev1.KeyToPath{
+											{
+												Key:  "ca.pem",
+												Path: "ca.crt",
+											},
+										},
+									},
+								},
+								{
+									Secret: &corev1.SecretProjection{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: "etcd-client",
+										},
+										Items: []corev1.KeyToPath{
+											{
+												Key:  "client.pem",
+												Path: "tls.crt",
+											},
+										},
+									},
+								},
+								{
+									Secret: &corev1.SecretProjection{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: "etcd-client",
+										},
+										Items: []corev1.KeyToPath{
+											{
+												Key:  "client-key.pem",
+												Path: "tls.key",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			volumeMounts: []corev1.VolumeMount{
+				{
+					Name:      "risingwave-etcd-tls",
+					MountPath: "/risingwave/etcd-tls",
+					ReadOnly:  true,
+				},
+			},
+		},
+		"postgresql": {
+			metaStore: risingwavev1alpha1.RisingWaveMetaStoreBackend{
+				PostgreSQL: &risingwavev1alpha1.RisingWaveMetaStoreBackendPostgreSQL{
+					Host:     "postgres",
+					Port:     5432,
+					Database: "risingwave",
+					RisingWaveDBCredentials: risingwavev1alpha1.RisingWaveDBCredentials{
+						SecretName:     "postgres-credentials",
+						UsernameKeyRef: "username",
+						PasswordKeyRef: "password",
+					},
+					Options: map[string]string{
+						"sslmode":         "require",
+						"connect_timeout": "10",
+					},
+				},
+			},
+			envs: []corev1.EnvVar{
+				{
+					Name: "RW_SQL_USERNAME",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "postgres-credentials",
+							},
+							Key: "username",
+						},
+					},
+				},
+				{
+					Name: "RW_SQL_PASSWORD",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "postgres-credentials",
+							},
+							Key: "password",
+						},
+					},
+				},
+				{
+					Name:  "RW_BACKEND",
+					Value: "postgres",
+				},
+				{
+					Name:  "RW_SQL_ENDPOINT",
+					Value: "postgres:5432",
+				},
+				{
+					Name:  "RW_SQL_DATABASE",
+					Value: "risingwave",
+				},
+				{
+					Name:  "RW_SQL_URL_PARAMS",
+					Value: "connect_timeout=10&sslmode=require",
+				},
+			},
+		},
+		"mysql": {
+			metaStore: risingwavev1alpha1.RisingWaveMetaStoreBackend{
+				MySQL: &risingwavev1alpha1.RisingWaveMetaStoreBackendMySQL{
+					Host:     "mysql",
+					Port:     3306,
+					Database: "risingwave",
+					RisingWaveDBCredentials: risingwavev1alpha1.RisingWaveDBCredentials{
+						SecretName:     "mysql-credentials",
+						UsernameKeyRef: "user",
+						PasswordKeyRef: "pass",
+					},
+				},
+			},
+			envs: []corev1.EnvVar{
+				{
+					Name: "RW_SQL_USERNAME",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "mysql-credentials",
+							},
+							Key: "user",
+						},
+					},
+				},
+				{
+					Name: "RW_SQL_PASSWORD",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "mysql-credentials",
+							},
+							Key: "pass",
+						},
+					},
+				},
+				{
+					Name:  "RW_BACKEND",
+					Value: "mysql",
+				},
+				{
+					Name:  "RW_SQL_ENDPOINT",
+					Value: "mysql:3306",
+				},
+				{
+					Name:  "RW_SQL_DATABASE",
+					Value: "risingwave",
+				},
+			},
+		},
+		"sqlite": {
+			metaStore: risingwavev1alpha1.RisingWaveMetaStoreBackend{
+				SQLite: &risingwavev1alpha1.RisingWaveMetaStoreBackendSQLite{
+					Path: "/data/meta.db",
+				},
+			},
+			envs: []corev1.EnvVar{
+				{
+					Name:  "RW_BACKEND",
+					Value: "sqlite",
+				},
+				{
+					Name:  "RW_SQL_ENDPOINT",
+					Value: "/data/meta.db",
+				},
+			},
+		},
 	}
 }
@@ -31,7 +31,10 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/risingwavelabs/ctrlkit"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -103,6 +106,34 @@ func (s *RisingWaveControllerManagerState) GetCompactorDeployments(ctx context.C
 	return validated, nil
 }
 
+// GetCompactorPodDisruptionBudgets lists compactorPodDisruptionBudgets with the following selectors:
+//   - labels/risingwave/component=compactor
+//   - labels/risingwave/name=${target.Name}
+//   - owned
+func (s *RisingWaveControllerManagerState) GetCompactorPodDisruptionBudgets(ctx context.Context) ([]policyv1.PodDisruptionBudget, error) {
+	var compactorPodDisruptionBudgetsList policyv1.PodDisruptionBudgetList
+
+	matchingLabels := map[string]string{
+		"risingwave/component": "compactor",
+		"risingwave/name":      s.target.Name,
+	}
+
+	err := s.List(ctx, &compactorPodDisruptionBudgetsList, client.InNamespace(s.target.Namespace),
+		client.MatchingLabels(matchingLabels))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get state 'compactorPodDisruptionBudgets': %w", err)
+	}
+
+	var validated []policyv1.PodDisruptionBudget
+	for _, obj := range compactorPodDisruptionBudgetsList.Items {
+		if ctrlkit.ValidateOwnership(&obj, s.target) {
+			validated = append(validated, obj)
+		}
+	}
+
+	return validated, nil
+}
+
 // GetCompactorService gets compactorService with name equals to ${target.Name}-compactor.
 func (s *RisingWaveControllerManagerState) GetCompactorService(ctx context.Context) (*corev1.Service, error) {
 	var compactorService corev1.Service
@@ -124,6 +155,24 @@ func (s *RisingWaveControllerManagerState) GetCompactorService(ctx context.Conte
 	return &compactorService, nil
 }
 
+// GetComponentPods lists componentPods with the following selectors:
+//   - labels/risingwave/name=${target.Name}
+func (s *RisingWaveControllerManagerState) GetComponentPods(ctx context.Context) ([]corev1.Pod, error) {
+	var componentPodsList corev1.PodList
+
+	matchingLabels := map[string]string{
+		"risingwave/name": s.target.Name,
+	}
+
+	err := s.List(ctx, &componentPodsList, client.InNamespace(s.target.Namespace),
+		client.MatchingLabels(matchingLabels))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get state 'componentPods': %w", err)
+	}
+
+	return componentPodsList.Items, nil
+}
+
 // GetComputeAdvancedStatefulSets lists computeAdvancedStatefulSets with the following selectors:
 //   - labels/risingwave/component=compute
 //   - labels/risingwave/name=${target.Name}
@@ -152,6 +201,34 @@ func (s *RisingWaveControllerManagerState) GetComputeAdvancedStatefulSets(ctx co
 	return validated, nil
 }
 
+// GetComputePodDisruptionBudgets lists computePodDisruptionBudgets with the following selectors:
+//   - labels/risingwave/component=compute
+//   - labels/risingwave/name=${target.Name}
+//   - owned
+func (s *RisingWaveControllerManagerState) GetComputePodDisruptionBudgets(ctx context.Context) ([]policyv1.PodDisruptionBudget, error) {
+	var computePodDisruptionBudgetsList policyv1.PodDisruptionBudgetList
+
+	matchingLabels := map[string]string{
+		"risingwave/component": "compute",
+		"risingwave/name":      s.target.Name,
+	}
+
+	err := s.List(ctx, &computePodDisruptionBudgetsList, client.InNamespace(s.target.Namespace),
+		client.MatchingLabels(matchingLabels))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get state 'computePodDisruptionBudgets': %w", err)
+	}
+
+	var validated []policyv1.PodDisruptionBudget
+	for _, obj := range computePodDisruptionBudgetsList.Items {
+		if ctrlkit.ValidateOwnership(&obj, s.target) {
+			validated = append(validated, obj)
+		}
+	}
+
+	return validated, nil
+}
+
 // GetComputeService gets computeService with name equals to ${target.Name}-compute.
 func (s *RisingWaveControllerManagerState) GetComputeService(ctx context.Context) (*corev1.Service, error) {
 	var computeService corev1.Service
@@ -278,6 +355,34 @@ func (s *RisingWaveControllerManagerState) GetConnectorDeployments(ctx context.C
 	return validated, nil
 }
 
+// GetConnectorPodDisruptionBudgets lists connectorPodDisruptionBudgets with the following selectors:
+//   - labels/risingwave/component=connector
+//   - labels/risingwave/name=${target.Name}
+//   - owned
+func (s *RisingWaveControllerManagerState) GetConnectorPodDisruptionBudgets(ctx context.Context) ([]policyv1.PodDisruptionBudget, error) {
+	var connectorPodDisruptionBudgetsList policyv1.PodDisruptionBudgetList
+
+	matchingLabels := map[string]string{
+		"risingwave/component": "connector",
+		"risingwave/name":      s.target.Name,
+	}
+
+	err := s.List(ctx, &connectorPodDisruptionBudgetsList, client.InNamespace(s.target.Namespace),
+		client.MatchingLabels(matchingLabels))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get state 'connectorPodDisruptionBudgets': %w", err)
+	}
+
+	var validated []policyv1.PodDisruptionBudget
+	for _, obj := range connectorPodDisruptionBudgetsList.Items {
+		if ctrlkit.ValidateOwnership(&obj, s.target) {
+			validated = append(validated, obj)
+		}
+	}
+
+	return validated, nil
+}
+
 // GetConnectorService gets connectorService with name equals to ${target.Name}-connector.
 func (s *RisingWaveControllerManagerState) GetConnectorService(ctx context.Context) (*corev1.Service, error) {
 	var connectorService corev1.Service
@@ -299,6 +404,48 @@ func (s *RisingWaveControllerManagerState) GetConnectorService(ctx context.Conte
 	return &connectorService, nil
 }
 
+// GetEtcdService gets etcdService with name equals to ${target.Name}-etcd.
+func (s *RisingWaveControllerManagerState) GetEtcdService(ctx context.Context) (*corev1.Service, error) {
+	var etcdService corev1.Service
+
+	err := s.Get(ctx, types.NamespacedName{
+		Namespace: s.target.Namespace,
+		Name:      s.target.Name + "-etcd",
+	}, &etcdService)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get state 'etcdService': %w", err)
+	}
+	if !ctrlkit.ValidateOwnership(&etcdService, s.target) {
+		return nil, fmt.Errorf("unable to get state 'etcdService': object not owned by target")
+	}
+
+	return &etcdService, nil
+}
+
+// GetEtcdStatefulSet gets etcdStatefulSet with name equals to ${target.Name}-etcd.
+func (s *RisingWaveControllerManagerState) GetEtcdStatefulSet(ctx context.Context) (*appsv1.StatefulSet, error) {
+	var etcdStatefulSet appsv1.StatefulSet
+
+	err := s.Get(ctx, types.NamespacedName{
+		Namespace: s.target.Namespace,
+		Name:      s.target.Name + "-etcd",
+	}, &etcdStatefulSet)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get state 'etcdStatefulSet': %w", err)
+	}
+	if !ctrlkit.ValidateOwnership(&etcdStatefulSet, s.target) {
+		return nil, fmt.Errorf("unable to get state 'etcdStatefulSet': object not owned by target")
+	}
+
+	return &etcdStatefulSet, nil
+}
+
 // GetFrontendCloneSets lists frontendCloneSets with the following selectors:
 //   - labels/risingwave/component=frontend
 //   - labels/risingwave/name=${target.Name}
@@ -355,6 +502,34 @@ func (s *RisingWaveControllerManagerState) GetFrontendDeployments(ctx context.Co
 	return validated, nil
 }
 
+// GetFrontendPodDisruptionBudgets lists frontendPodDisruptionBudgets with the following selectors:
+//   - labels/risingwave/component=frontend
+//   - labels/risingwave/name=${target.Name}
+//   - owned
+func (s *RisingWaveControllerManagerState) GetFrontendPodDisruptionBudgets(ctx context.Context) ([]policyv1.PodDisruptionBudget, error) {
+	var frontendPodDisruptionBudgetsList policyv1.PodDisruptionBudgetList
+
+	matchingLabels := map[string]string{
+		"risingwave/component": "frontend",
+		"risingwave/name":      s.target.Name,
+	}
+
+	err := s.List(ctx, &frontendPodDisruptionBudgetsList, client.InNamespace(s.target.Namespace),
+		client.MatchingLabels(matchingLabels))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get state 'frontendPodDisruptionBudgets': %w", err)
+	}
+
+	var validated []policyv1.PodDisruptionBudget
+	for _, obj := range frontendPodDisruptionBudgetsList.Items {
+		if ctrlkit.ValidateOwnership(&obj, s.target) {
+			validated = append(validated, obj)
+		}
+	}
+
+	return validated, nil
+}
+
 // GetFrontendService gets frontendService with name equals to ${target.Name}-frontend.
 func (s *RisingWaveControllerManagerState) GetFrontendService(ctx context.Context) (*corev1.Service, error) {
 	var frontendService corev1.Service
@@ -404,6 +579,97 @@ func (s *RisingWaveControllerManagerState) GetMetaAdvancedStatefulSets(ctx conte
 	return validated, nil
 }
 
+// GetMetaDashboardIngress gets metaDashboardIngress with name equals to ${target.Name}-meta-dashboard.
+func (s *RisingWaveControllerManagerState) GetMetaDashboardIngress(ctx context.Context) (*networkingv1.Ingress, error) {
+	var metaDashboardIngress networkingv1.Ingress
+
+	err := s.Get(ctx, types.NamespacedName{
+		Namespace: s.target.Namespace,
+		Name:      s.target.Name + "-meta-dashboard",
+	}, &metaDashboardIngress)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get state 'metaDashboardIngress': %w", err)
+	}
+	if !ctrlkit.ValidateOwnership(&metaDashboardIngress, s.target) {
+		return nil, fmt.Errorf("unable to get state 'metaDashboardIngress': object not owned by target")
+	}
+
+	return &metaDashboardIngress, nil
+}
+
+// GetMetaLeaderService gets metaLeaderService with name equals to ${target.Name}-meta-leader.
+func (s *RisingWaveControllerManagerState) GetMetaLeaderService(ctx context.Context) (*corev1.Service, error) {
+	var metaLeaderService corev1.Service
+
+	err := s.Get(ctx, types.NamespacedName{
+		Namespace: s.target.Namespace,
+		Name:      s.target.Name + "-meta-leader",
+	}, &metaLeaderService)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get state 'metaLeaderService': %w", err)
+	}
+	if !ctrlkit.ValidateOwnership(&metaLeaderService, s.target) {
+		return nil, fmt.Errorf("unable to get state 'metaLeaderService': object not owned by target")
+	}
+
+	return &metaLeaderService, nil
+}
+
+// GetMetaPodDisruptionBudgets lists metaPodDisruptionBudgets with the following selectors:
+//   - labels/risingwave/component=meta
+//   - labels/risingwave/name=${target.Name}
+//   - owned
+func (s *RisingWaveControllerManagerState) GetMetaPodDisruptionBudgets(ctx context.Context) ([]policyv1.PodDisruptionBudget, error) {
+	var metaPodDisruptionBudgetsList policyv1.PodDisruptionBudgetList
+
+	matchingLabels := map[string]string{
+		"risingwave/component": "meta",
+		"risingwave/name":      s.target.Name,
+	}
+
+	err := s.List(ctx, &metaPodDisruptionBudgetsList, client.InNamespace(s.target.Namespace),
+		client.MatchingLabels(matchingLabels))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get state 'metaPodDisruptionBudgets': %w", err)
+	}
+
+	var validated []policyv1.PodDisruptionBudget
+	for _, obj := range metaPodDisruptionBudgetsList.Items {
+		if ctrlkit.ValidateOwnership(&obj, s.target) {
+			validated = append(validated, obj)
+		}
+	}
+
+	return validated, nil
+}
+
+// GetMetaRestoreJob gets metaRestoreJob with name equals to ${target.Name}-meta-restore.
+func (s *RisingWaveControllerManagerState) GetMetaRestoreJob(ctx context.Context) (*batchv1.Job, error) {
+	var metaRestoreJob batchv1.Job
+
+	err := s.Get(ctx, types.NamespacedName{
+		Namespace: s.target.Namespace,
+		Name:      s.target.Name + "-meta-restore",
+	}, &metaRestoreJob)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get state 'metaRestoreJob': %w", err)
+	}
+	if !ctrlkit.ValidateOwnership(&metaRestoreJob, s.target) {
+		return nil, fmt.Errorf("unable to get state 'metaRestoreJob': object not owned by target")
+	}
+
+	return &metaRestoreJob, nil
+}
+
 // GetMetaService gets metaService with name equals to ${target.Name}-meta.
 func (s *RisingWaveControllerManagerState) GetMetaService(ctx context.Context) (*corev1.Service, error) {
 	var metaService corev1.Service
@@ -453,6 +719,111 @@ func (s *RisingWaveControllerManagerState) GetMetaStatefulSets(ctx context.Conte
 	return validated, nil
 }
 
+// GetMinioBucketJob gets minioBucketJob with name equals to ${target.Name}-minio-bucket.
+func (s *RisingWaveControllerManagerState) GetMinioBucketJob(ctx context.Context) (*batchv1.Job, error) {
+	var minioBucketJob batchv1.Job
+
+	err := s.Get(ctx, types.NamespacedName{
+		Namespace: s.target.Namespace,
+		Name:      s.target.Name + "-minio-bucket",
+	}, &minioBucketJob)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get state 'minioBucketJob': %w", err)
+	}
+	if !ctrlkit.ValidateOwnership(&minioBucketJob, s.target) {
+		return nil, fmt.Errorf("unable to get state 'minioBucketJob': object not owned by target")
+	}
+
+	return &minioBucketJob, nil
+}
+
+// GetMinioCredentialsSecret gets minioCredentialsSecret with name equals to ${target.Name}-minio-credentials.
+func (s *RisingWaveControllerManagerState) GetMinioCredentialsSecret(ctx context.Context) (*corev1.Secret, error) {
+	var minioCredentialsSecret corev1.Secret
+
+	err := s.Get(ctx, types.NamespacedName{
+		Namespace: s.target.Namespace,
+		Name:      s.target.Name + "-minio-credentials",
+	}, &minioCredentialsSecret)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get state 'minioCredentialsSecret': %w", err)
+	}
+	if !ctrlkit.ValidateOwnership(&minioCredentialsSecret, s.target) {
+		return nil, fmt.Errorf("unable to get state 'minioCredentialsSecret': object not owned by target")
+	}
+
+	return &minioCredentialsSecret, nil
+}
+
+// GetMinioService gets minioService with name equals to ${target.Name}-minio.
+func (s *RisingWaveControllerManagerState) GetMinioService(ctx context.Context) (*corev1.Service, error) {
+	var minioService corev1.Service
+
+	err := s.Get(ctx, types.NamespacedName{
+		Namespace: s.target.Namespace,
+		Name:      s.target.Name + "-minio",
+	}, &minioService)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get state 'minioService': %w", err)
+	}
+	if !ctrlkit.ValidateOwnership(&minioService, s.target) {
+		return nil, fmt.Errorf("unable to get state 'minioService': object not owned by target")
+	}
+
+	return &minioService, nil
+}
+
+// GetMinioStatefulSet gets minioStatefulSet with name equals to ${target.Name}-minio.
+func (s *RisingWaveControllerManagerState) GetMinioStatefulSet(ctx context.Context) (*appsv1.StatefulSet, error) {
+	var minioStatefulSet appsv1.StatefulSet
+
+	err := s.Get(ctx, types.NamespacedName{
+		Namespace: s.target.Namespace,
+		Name:      s.target.Name + "-minio",
+	}, &minioStatefulSet)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get state 'minioStatefulSet': %w", err)
+	}
+	if !ctrlkit.ValidateOwnership(&minioStatefulSet, s.target) {
+		return nil, fmt.Errorf("unable to get state 'minioStatefulSet': object not owned by target")
+	}
+
+	return &minioStatefulSet, nil
+}
+
+// GetRevisionConfigMap gets revisionConfigMap with name equals to ${target.Name}-revision.
+func (s *RisingWaveControllerManagerState) GetRevisionConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
+	var revisionConfigMap corev1.ConfigMap
+
+	err := s.Get(ctx, types.NamespacedName{
+		Namespace: s.target.Namespace,
+		Name:      s.target.Name + "-revision",
+	}, &revisionConfigMap)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get state 'revisionConfigMap': %w", err)
+	}
+	if !ctrlkit.ValidateOwnership(&revisionConfigMap, s.target) {
+		return nil, fmt.Errorf("unable to get state 'revisionConfigMap': object not owned by target")
+	}
+
+	return &revisionConfigMap, nil
+}
+
 // GetServiceMonitor gets serviceMonitor with name equals to risingwave-${target.Name}.
 func (s *RisingWaveControllerManagerState) GetServiceMonitor(ctx context.Context) (*monitoringv1.ServiceMonitor, error) {
 	var serviceMonitor monitoringv1.ServiceMonitor
@@ -484,6 +855,39 @@ func NewRisingWaveControllerManagerState(reader client.Reader, target *risingwav
 
 // RisingWaveControllerManagerImpl declares the implementation interface for RisingWaveControllerManager.
 type RisingWaveControllerManagerImpl interface {
+	// SyncEtcdService creates or updates the service for the managed etcd.
+	SyncEtcdService(ctx context.Context, logger logr.Logger, etcdService *corev1.Service) (ctrl.Result, error)
+
+	// SyncEtcdStatefulSet creates or updates the StatefulSet for the managed etcd.
+	SyncEtcdStatefulSet(ctx context.Context, logger logr.Logger, etcdStatefulSet *appsv1.StatefulSet) (ctrl.Result, error)
+
+	// WaitBeforeEtcdStatefulSetReady waits (aborts the workflow) before the managed etcd StatefulSet is ready.
+	WaitBeforeEtcdStatefulSetReady(ctx context.Context, logger logr.Logger, etcdStatefulSet *appsv1.StatefulSet) (ctrl.Result, error)
+
+	// SyncMinIOService creates or updates the service for the managed MinIO.
+	SyncMinIOService(ctx context.Context, logger logr.Logger, minioService *corev1.Service) (ctrl.Result, error)
+
+	// SyncMinIOCredentialsSecret creates the secret of the root credentials for the managed MinIO if it doesn't exist.
+	SyncMinIOCredentialsSecret(ctx context.Context, logger logr.Logger, minioCredentialsSecret *corev1.Secret) (ctrl.Result, error)
+
+	// SyncMinIOStatefulSet creates or updates the StatefulSet for the managed MinIO.
+	SyncMinIOStatefulSet(ctx context.Context, logger logr.Logger, minioStatefulSet *appsv1.StatefulSet) (ctrl.Result, error)
+
+	// WaitBeforeMinIOStatefulSetReady waits (aborts the workflow) before the managed MinIO StatefulSet is ready.
+	WaitBeforeMinIOStatefulSetReady(ctx context.Context, logger logr.Logger, minioStatefulSet *appsv1.StatefulSet) (ctrl.Result, error)
+
+	// SyncMinIOBucketJob creates the job to create the bucket in the managed MinIO if it doesn't exist.
+	SyncMinIOBucketJob(ctx context.Context, logger logr.Logger, minioBucketJob *batchv1.Job) (ctrl.Result, error)
+
+	// WaitBeforeMinIOBucketJobCompleted waits (aborts the workflow) before the bucket is created in the managed MinIO.
+	WaitBeforeMinIOBucketJobCompleted(ctx context.Context, logger logr.Logger, minioBucketJob *batchv1.Job) (ctrl.Result, error)
+
+	// SyncMetaRestoreJob creates the job to restore the meta store from a meta snapshot if it doesn't exist.
+	SyncMetaRestoreJob(ctx context.Context, logger logr.Logger, metaRestoreJob *batchv1.Job) (ctrl.Result, error)
+
+	// WaitBeforeMetaRestoreJobCompleted waits (aborts the workflow) before the meta store is restored.
+	WaitBeforeMetaRestoreJobCompleted(ctx context.Context, logger logr.Logger, metaRestoreJob *batchv1.Job) (ctrl.Result, error)
+
 	// SyncMetaService creates or updates the service for meta nodes.
 	SyncMetaService(ctx context.Context, logger logr.Logger, metaService *corev1.Service) (ctrl.Result, error)
 
@@ -493,6 +897,18 @@ type RisingWaveControllerManagerImpl interface {
 	// SyncMetaAdvancedStatefulSets creates or updates the CloneSets for meta nodes.
 	SyncMetaAdvancedStatefulSets(ctx context.Context, logger logr.Logger, metaAdvancedStatefulSets []appsv1beta1.StatefulSet) (ctrl.Result, error)
 
+	// SyncMetaLeaderService creates or updates the service for the meta leader if it's enabled, or deletes it
+	// otherwise.
+	SyncMetaLeaderService(ctx context.Context, logger logr.Logger, metaLeaderService *corev1.Service) (ctrl.Result, error)
+
+	// SyncMetaDashboardIngress creates or updates the ingress for the meta dashboard if it's enabled, or deletes
+	// it otherwise.
+	SyncMetaDashboardIngress(ctx context.Context, logger logr.Logger, metaDashboardIngress *networkingv1.Ingress) (ctrl.Result, error)
+
+	// SyncMetaPodDisruptionBudgets creates or updates the PodDisruptionBudgets for meta nodes of the groups with it
+	// enabled, and deletes the others.
+	SyncMetaPodDisruptionBudgets(ctx context.Context, logger logr.Logger, metaPodDisruptionBudgets []policyv1.PodDisruptionBudget) (ctrl.Result, error)
+
 	// WaitBeforeMetaServiceIsAvailable waits (aborts the workflow) before the meta service is available.
 	WaitBeforeMetaServiceIsAvailable(ctx context.Context, logger logr.Logger, metaService *corev1.Service) (ctrl.Result, error)
 
@@ -511,6 +927,10 @@ type RisingWaveControllerManagerImpl interface {
 	// SyncFrontendCloneSets creates or updates the Deployments for frontend nodes.
 	SyncFrontendCloneSets(ctx context.Context, logger logr.Logger, frontendCloneSets []appsv1alpha1.CloneSet) (ctrl.Result, error)
 
+	// SyncFrontendPodDisruptionBudgets creates or updates the PodDisruptionBudgets for frontend nodes of the groups with it
+	// enabled, and deletes the others.
+	SyncFrontendPodDisruptionBudgets(ctx context.Context, logger logr.Logger, frontendPodDisruptionBudgets []policyv1.PodDisruptionBudget) (ctrl.Result, error)
+
 	// WaitBeforeFrontendDeploymentsReady waits (aborts the workflow) before the frontend Deployments are ready.
 	WaitBeforeFrontendDeploymentsReady(ctx context.Context, logger logr.Logger, frontendDeployments []appsv1.Deployment) (ctrl.Result, error)
 
@@ -526,6 +946,10 @@ type RisingWaveControllerManagerImpl interface {
 	// SyncComputeAdvancedStatefulSets creates or updates the StatefulSets for compute nodes.
 	SyncComputeAdvancedStatefulSets(ctx context.Context, logger logr.Logger, computeAdvancedStatefulSets []appsv1beta1.StatefulSet) (ctrl.Result, error)
 
+	// SyncComputePodDisruptionBudgets creates or updates the PodDisruptionBudgets for compute nodes of the groups with it
+	// enabled, and deletes the others.
+	SyncComputePodDisruptionBudgets(ctx context.Context, logger logr.Logger, computePodDisruptionBudgets []policyv1.PodDisruptionBudget) (ctrl.Result, error)
+
 	// WaitBeforeComputeStatefulSetsReady waits (aborts the workflow) before the compute StatefulSets are ready.
 	WaitBeforeComputeStatefulSetsReady(ctx context.Context, logger logr.Logger, computeStatefulSets []appsv1.StatefulSet) (ctrl.Result, error)
 
@@ -541,6 +965,10 @@ type RisingWaveControllerManagerImpl interface {
 	// SyncCompactorCloneSets creates or updates the Deployments for compactor nodes.
 	SyncCompactorCloneSets(ctx context.Context, logger logr.Logger, compactorCloneSets []appsv1alpha1.CloneSet) (ctrl.Result, error)
 
+	// SyncCompactorPodDisruptionBudgets creates or updates the PodDisruptionBudgets for compactor nodes of the groups with it
+	// enabled, and deletes the others.
+	SyncCompactorPodDisruptionBudgets(ctx context.Context, logger logr.Logger, compactorPodDisruptionBudgets []policyv1.PodDisruptionBudget) (ctrl.Result, error)
+
 	// WaitBeforeCompactorDeploymentsReady waits (aborts the workflow) before the compactor Deployments are ready.
 	WaitBeforeCompactorDeploymentsReady(ctx context.Context, logger logr.Logger, compactorDeployments []appsv1.Deployment) (ctrl.Result, error)
 
@@ -556,6 +984,10 @@ type RisingWaveControllerManagerImpl interface {
 	// SyncConnectorCloneSets creates or updates the Deployments for connector nodes.
 	SyncConnectorCloneSets(ctx context.Context, logger logr.Logger, connectorCloneSets []appsv1alpha1.CloneSet) (ctrl.Result, error)
 
+	// SyncConnectorPodDisruptionBudgets creates or updates the PodDisruptionBudgets for connector nodes of the groups with it
+	// enabled, and deletes the others.
+	SyncConnectorPodDisruptionBudgets(ctx context.Context, logger logr.Logger, connectorPodDisruptionBudgets []policyv1.PodDisruptionBudget) (ctrl.Result, error)
+
 	// WaitBeforeConnectorDeploymentsReady waits (aborts the workflow) before the connector Deployments are ready.
 	WaitBeforeConnectorDeploymentsReady(ctx context.Context, logger logr.Logger, connectorDeployments []appsv1.Deployment) (ctrl.Result, error)
 
@@ -565,68 +997,365 @@ type RisingWaveControllerManagerImpl interface {
 	// SyncConfigConfigMap creates or updates the configmap for RisingWave configs.
 	SyncConfigConfigMap(ctx context.Context, logger logr.Logger, configConfigMap *corev1.ConfigMap) (ctrl.Result, error)
 
+	// CheckRolloutProgressDeadline marks the rollout as failed if the workloads haven't been rolled out before the deadlines of their node groups.
+	CheckRolloutProgressDeadline(ctx context.Context, logger logr.Logger) (ctrl.Result, error)
+
+	// SyncRevisionConfigMap records the pod templates of the workloads as the last known-good revision.
+	SyncRevisionConfigMap(ctx context.Context, logger logr.Logger, revisionConfigMap *corev1.ConfigMap) (ctrl.Result, error)
+
+	// RollbackWorkloads reverts the pod templates of the workloads to the last known-good revision once per failed generation.
+	RollbackWorkloads(ctx context.Context, logger logr.Logger, revisionConfigMap *corev1.ConfigMap) (ctrl.Result, error)
+
 	// SyncServiceMonitor creates or updates the service monitor for RisingWave.
 	SyncServiceMonitor(ctx context.Context, logger logr.Logger, serviceMonitor *monitoringv1.ServiceMonitor) (ctrl.Result, error)
 
-	// CollectRunningStatisticsAndSyncStatus collects running statistics and sync them into the status.
-	CollectRunningStatisticsAndSyncStatus(ctx context.Context, logger logr.Logger, frontendService *corev1.Service, metaService *corev1.Service, computeService *corev1.Service, compactorService *corev1.Service, connectorService *corev1.Service, metaStatefulSets []appsv1.StatefulSet, frontendDeployments []appsv1.Deployment, computeStatefulSets []appsv1.StatefulSet, compactorDeployments []appsv1.Deployment, connectorDeployments []appsv1.Deployment, configConfigMap *corev1.ConfigMap) (ctrl.Result, error)
+	// CollectReferencedObjectsHash collects the hash of the ConfigMaps and Secrets referenced by all node groups.
+	CollectReferencedObjectsHash(ctx context.Context, logger logr.Logger) (ctrl.Result, error)
+
+	// CollectRunningStatisticsAndSyncStatus collects running statistics and sync them into the status.
+	CollectRunningStatisticsAndSyncStatus(ctx context.Context, logger logr.Logger, frontendService *corev1.Service, metaService *corev1.Service, computeService *corev1.Service, compactorService *corev1.Service, connectorService *corev1.Service, metaStatefulSets []appsv1.StatefulSet, frontendDeployments []appsv1.Deployment, computeStatefulSets []appsv1.StatefulSet, compactorDeployments []appsv1.Deployment, connectorDeployments []appsv1.Deployment, configConfigMap *corev1.ConfigMap) (ctrl.Result, error)
+
+	CollectOpenKruiseRunningStatisticsAndSyncStatus(ctx context.Context, logger logr.Logger, frontendService *corev1.Service, metaService *corev1.Service, computeService *corev1.Service, compactorService *corev1.Service, connectorService *corev1.Service, metaAdvancedStatefulSets []appsv1beta1.StatefulSet, frontendCloneSets []appsv1alpha1.CloneSet, computeAdvancedStatefulSets []appsv1beta1.StatefulSet, compactorCloneSets []appsv1alpha1.CloneSet, connectorCloneSets []appsv1alpha1.CloneSet, configConfigMap *corev1.ConfigMap) (ctrl.Result, error)
+
+	// CollectPodFailuresAndSyncCondition inspects the pods of all node groups and syncs the condition Failed
+	// with the failures found, e.g., CrashLoopBackOff and OOMKilled.
+	CollectPodFailuresAndSyncCondition(ctx context.Context, logger logr.Logger, componentPods []corev1.Pod) (ctrl.Result, error)
+
+	// CollectEndpointsAndSyncStatus collects the meta leader and the endpoints to connect to, and syncs them
+	// into the status.
+	CollectEndpointsAndSyncStatus(ctx context.Context, logger logr.Logger, frontendService *corev1.Service, metaService *corev1.Service, metaLeaderService *corev1.Service, componentPods []corev1.Pod) (ctrl.Result, error)
+}
+
+// Pre-defined actions in RisingWaveControllerManager.
+const (
+	RisingWaveAction_SyncEtcdService                                 = "SyncEtcdService"
+	RisingWaveAction_SyncEtcdStatefulSet                             = "SyncEtcdStatefulSet"
+	RisingWaveAction_WaitBeforeEtcdStatefulSetReady                  = "WaitBeforeEtcdStatefulSetReady"
+	RisingWaveAction_SyncMinIOService                                = "SyncMinIOService"
+	RisingWaveAction_SyncMinIOCredentialsSecret                      = "SyncMinIOCredentialsSecret"
+	RisingWaveAction_SyncMinIOStatefulSet                            = "SyncMinIOStatefulSet"
+	RisingWaveAction_WaitBeforeMinIOStatefulSetReady                 = "WaitBeforeMinIOStatefulSetReady"
+	RisingWaveAction_SyncMinIOBucketJob                              = "SyncMinIOBucketJob"
+	RisingWaveAction_WaitBeforeMinIOBucketJobCompleted               = "WaitBeforeMinIOBucketJobCompleted"
+	RisingWaveAction_SyncMetaRestoreJob                              = "SyncMetaRestoreJob"
+	RisingWaveAction_WaitBeforeMetaRestoreJobCompleted               = "WaitBeforeMetaRestoreJobCompleted"
+	RisingWaveAction_SyncMetaService                                 = "SyncMetaService"
+	RisingWaveAction_SyncMetaStatefulSets                            = "SyncMetaStatefulSets"
+	RisingWaveAction_SyncMetaAdvancedStatefulSets                    = "SyncMetaAdvancedStatefulSets"
+	RisingWaveAction_SyncMetaLeaderService                           = "SyncMetaLeaderService"
+	RisingWaveAction_SyncMetaDashboardIngress                        = "SyncMetaDashboardIngress"
+	RisingWaveAction_SyncMetaPodDisruptionBudgets                    = "SyncMetaPodDisruptionBudgets"
+	RisingWaveAction_WaitBeforeMetaServiceIsAvailable                = "WaitBeforeMetaServiceIsAvailable"
+	RisingWaveAction_WaitBeforeMetaStatefulSetsReady                 = "WaitBeforeMetaStatefulSetsReady"
+	RisingWaveAction_WaitBeforeMetaAdvancedStatefulSetsReady         = "WaitBeforeMetaAdvancedStatefulSetsReady"
+	RisingWaveAction_SyncFrontendService                             = "SyncFrontendService"
+	RisingWaveAction_SyncFrontendDeployments                         = "SyncFrontendDeployments"
+	RisingWaveAction_SyncFrontendCloneSets                           = "SyncFrontendCloneSets"
+	RisingWaveAction_SyncFrontendPodDisruptionBudgets                = "SyncFrontendPodDisruptionBudgets"
+	RisingWaveAction_WaitBeforeFrontendDeploymentsReady              = "WaitBeforeFrontendDeploymentsReady"
+	RisingWaveAction_WaitBeforeFrontendCloneSetsReady                = "WaitBeforeFrontendCloneSetsReady"
+	RisingWaveAction_SyncComputeService                              = "SyncComputeService"
+	RisingWaveAction_SyncComputeStatefulSets                         = "SyncComputeStatefulSets"
+	RisingWaveAction_SyncComputeAdvancedStatefulSets                 = "SyncComputeAdvancedStatefulSets"
+	RisingWaveAction_SyncComputePodDisruptionBudgets                 = "SyncComputePodDisruptionBudgets"
+	RisingWaveAction_WaitBeforeComputeStatefulSetsReady              = "WaitBeforeComputeStatefulSetsReady"
+	RisingWaveAction_WaitBeforeComputeAdvancedStatefulSetsReady      = "WaitBeforeComputeAdvancedStatefulSetsReady"
+	RisingWaveAction_SyncCompactorService                            = "SyncCompactorService"
+	RisingWaveAction_SyncCompactorDeployments                        = "SyncCompactorDeployments"
+	RisingWaveAction_SyncCompactorCloneSets                          = "SyncCompactorCloneSets"
+	RisingWaveAction_SyncCompactorPodDisruptionBudgets               = "SyncCompactorPodDisruptionBudgets"
+	RisingWaveAction_WaitBeforeCompactorDeploymentsReady             = "WaitBeforeCompactorDeploymentsReady"
+	RisingWaveAction_WaitBeforeCompactorCloneSetsReady               = "WaitBeforeCompactorCloneSetsReady"
+	RisingWaveAction_SyncConnectorService                            = "SyncConnectorService"
+	RisingWaveAction_SyncConnectorDeployments                        = "SyncConnectorDeployments"
+	RisingWaveAction_SyncConnectorCloneSets                          = "SyncConnectorCloneSets"
+	RisingWaveAction_SyncConnectorPodDisruptionBudgets               = "SyncConnectorPodDisruptionBudgets"
+	RisingWaveAction_WaitBeforeConnectorDeploymentsReady             = "WaitBeforeConnectorDeploymentsReady"
+	RisingWaveAction_WaitBeforeConnectorCloneSetsReady               = "WaitBeforeConnectorCloneSetsReady"
+	RisingWaveAction_SyncConfigConfigMap                             = "SyncConfigConfigMap"
+	RisingWaveAction_CheckRolloutProgressDeadline                    = "CheckRolloutProgressDeadline"
+	RisingWaveAction_SyncRevisionConfigMap                           = "SyncRevisionConfigMap"
+	RisingWaveAction_RollbackWorkloads                               = "RollbackWorkloads"
+	RisingWaveAction_SyncServiceMonitor                              = "SyncServiceMonitor"
+	RisingWaveAction_CollectReferencedObjectsHash                    = "CollectReferencedObjectsHash"
+	RisingWaveAction_CollectRunningStatisticsAndSyncStatus           = "CollectRunningStatisticsAndSyncStatus"
+	RisingWaveAction_CollectOpenKruiseRunningStatisticsAndSyncStatus = "CollectOpenKruiseRunningStatisticsAndSyncStatus"
+	RisingWaveAction_CollectPodFailuresAndSyncCondition              = "CollectPodFailuresAndSyncCondition"
+	RisingWaveAction_CollectEndpointsAndSyncStatus                   = "CollectEndpointsAndSyncStatus"
+)
+
+// RisingWaveControllerManager encapsulates the states and actions used by RisingWaveController.
+type RisingWaveControllerManager struct {
+	hook   ctrlkit.ActionHook
+	state  RisingWaveControllerManagerState
+	impl   RisingWaveControllerManagerImpl
+	logger logr.Logger
+}
+
+// NewAction returns a new action controlled by the manager.
+func (m *RisingWaveControllerManager) NewAction(description string, f func(context.Context, logr.Logger) (ctrl.Result, error)) ctrlkit.Action {
+	return ctrlkit.NewAction(description, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", description)
+
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, description, result, err) }()
+			m.hook.PreRun(ctx, logger, description, nil)
+		}
+
+		return f(ctx, logger)
+	})
+}
+
+// SyncEtcdService generates the action of "SyncEtcdService".
+func (m *RisingWaveControllerManager) SyncEtcdService() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncEtcdService, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncEtcdService)
+
+		// Get states.
+		etcdService, err := m.state.GetEtcdService(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncEtcdService, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncEtcdService, map[string]runtime.Object{
+				"etcdService": etcdService,
+			})
+		}
+
+		return m.impl.SyncEtcdService(ctx, logger, etcdService)
+	})
+}
+
+// SyncEtcdStatefulSet generates the action of "SyncEtcdStatefulSet".
+func (m *RisingWaveControllerManager) SyncEtcdStatefulSet() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncEtcdStatefulSet, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncEtcdStatefulSet)
+
+		// Get states.
+		etcdStatefulSet, err := m.state.GetEtcdStatefulSet(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncEtcdStatefulSet, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncEtcdStatefulSet, map[string]runtime.Object{
+				"etcdStatefulSet": etcdStatefulSet,
+			})
+		}
+
+		return m.impl.SyncEtcdStatefulSet(ctx, logger, etcdStatefulSet)
+	})
+}
+
+// WaitBeforeEtcdStatefulSetReady generates the action of "WaitBeforeEtcdStatefulSetReady".
+func (m *RisingWaveControllerManager) WaitBeforeEtcdStatefulSetReady() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_WaitBeforeEtcdStatefulSetReady, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_WaitBeforeEtcdStatefulSetReady)
+
+		// Get states.
+		etcdStatefulSet, err := m.state.GetEtcdStatefulSet(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_WaitBeforeEtcdStatefulSetReady, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_WaitBeforeEtcdStatefulSetReady, map[string]runtime.Object{
+				"etcdStatefulSet": etcdStatefulSet,
+			})
+		}
+
+		return m.impl.WaitBeforeEtcdStatefulSetReady(ctx, logger, etcdStatefulSet)
+	})
+}
+
+// SyncMinIOService generates the action of "SyncMinIOService".
+func (m *RisingWaveControllerManager) SyncMinIOService() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncMinIOService, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncMinIOService)
+
+		// Get states.
+		minioService, err := m.state.GetMinioService(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncMinIOService, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncMinIOService, map[string]runtime.Object{
+				"minioService": minioService,
+			})
+		}
+
+		return m.impl.SyncMinIOService(ctx, logger, minioService)
+	})
+}
+
+// SyncMinIOCredentialsSecret generates the action of "SyncMinIOCredentialsSecret".
+func (m *RisingWaveControllerManager) SyncMinIOCredentialsSecret() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncMinIOCredentialsSecret, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncMinIOCredentialsSecret)
+
+		// Get states.
+		minioCredentialsSecret, err := m.state.GetMinioCredentialsSecret(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncMinIOCredentialsSecret, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncMinIOCredentialsSecret, map[string]runtime.Object{
+				"minioCredentialsSecret": minioCredentialsSecret,
+			})
+		}
+
+		return m.impl.SyncMinIOCredentialsSecret(ctx, logger, minioCredentialsSecret)
+	})
+}
+
+// SyncMinIOStatefulSet generates the action of "SyncMinIOStatefulSet".
+func (m *RisingWaveControllerManager) SyncMinIOStatefulSet() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncMinIOStatefulSet, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncMinIOStatefulSet)
+
+		// Get states.
+		minioStatefulSet, err := m.state.GetMinioStatefulSet(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncMinIOStatefulSet, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncMinIOStatefulSet, map[string]runtime.Object{
+				"minioStatefulSet": minioStatefulSet,
+			})
+		}
+
+		return m.impl.SyncMinIOStatefulSet(ctx, logger, minioStatefulSet)
+	})
+}
+
+// WaitBeforeMinIOStatefulSetReady generates the action of "WaitBeforeMinIOStatefulSetReady".
+func (m *RisingWaveControllerManager) WaitBeforeMinIOStatefulSetReady() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_WaitBeforeMinIOStatefulSetReady, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_WaitBeforeMinIOStatefulSetReady)
+
+		// Get states.
+		minioStatefulSet, err := m.state.GetMinioStatefulSet(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_WaitBeforeMinIOStatefulSetReady, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_WaitBeforeMinIOStatefulSetReady, map[string]runtime.Object{
+				"minioStatefulSet": minioStatefulSet,
+			})
+		}
+
+		return m.impl.WaitBeforeMinIOStatefulSetReady(ctx, logger, minioStatefulSet)
+	})
+}
+
+// SyncMinIOBucketJob generates the action of "SyncMinIOBucketJob".
+func (m *RisingWaveControllerManager) SyncMinIOBucketJob() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncMinIOBucketJob, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncMinIOBucketJob)
+
+		// Get states.
+		minioBucketJob, err := m.state.GetMinioBucketJob(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncMinIOBucketJob, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncMinIOBucketJob, map[string]runtime.Object{
+				"minioBucketJob": minioBucketJob,
+			})
+		}
+
+		return m.impl.SyncMinIOBucketJob(ctx, logger, minioBucketJob)
+	})
+}
+
+// WaitBeforeMinIOBucketJobCompleted generates the action of "WaitBeforeMinIOBucketJobCompleted".
+func (m *RisingWaveControllerManager) WaitBeforeMinIOBucketJobCompleted() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_WaitBeforeMinIOBucketJobCompleted, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_WaitBeforeMinIOBucketJobCompleted)
+
+		// Get states.
+		minioBucketJob, err := m.state.GetMinioBucketJob(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_WaitBeforeMinIOBucketJobCompleted, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_WaitBeforeMinIOBucketJobCompleted, map[string]runtime.Object{
+				"minioBucketJob": minioBucketJob,
+			})
+		}
 
-	CollectOpenKruiseRunningStatisticsAndSyncStatus(ctx context.Context, logger logr.Logger, frontendService *corev1.Service, metaService *corev1.Service, computeService *corev1.Service, compactorService *corev1.Service, connectorService *corev1.Service, metaAdvancedStatefulSets []appsv1beta1.StatefulSet, frontendCloneSets []appsv1alpha1.CloneSet, computeAdvancedStatefulSets []appsv1beta1.StatefulSet, compactorCloneSets []appsv1alpha1.CloneSet, connectorCloneSets []appsv1alpha1.CloneSet, configConfigMap *corev1.ConfigMap) (ctrl.Result, error)
+		return m.impl.WaitBeforeMinIOBucketJobCompleted(ctx, logger, minioBucketJob)
+	})
 }
 
-// Pre-defined actions in RisingWaveControllerManager.
-const (
-	RisingWaveAction_SyncMetaService                                 = "SyncMetaService"
-	RisingWaveAction_SyncMetaStatefulSets                            = "SyncMetaStatefulSets"
-	RisingWaveAction_SyncMetaAdvancedStatefulSets                    = "SyncMetaAdvancedStatefulSets"
-	RisingWaveAction_WaitBeforeMetaServiceIsAvailable                = "WaitBeforeMetaServiceIsAvailable"
-	RisingWaveAction_WaitBeforeMetaStatefulSetsReady                 = "WaitBeforeMetaStatefulSetsReady"
-	RisingWaveAction_WaitBeforeMetaAdvancedStatefulSetsReady         = "WaitBeforeMetaAdvancedStatefulSetsReady"
-	RisingWaveAction_SyncFrontendService                             = "SyncFrontendService"
-	RisingWaveAction_SyncFrontendDeployments                         = "SyncFrontendDeployments"
-	RisingWaveAction_SyncFrontendCloneSets                           = "SyncFrontendCloneSets"
-	RisingWaveAction_WaitBeforeFrontendDeploymentsReady              = "WaitBeforeFrontendDeploymentsReady"
-	RisingWaveAction_WaitBeforeFrontendCloneSetsReady                = "WaitBeforeFrontendCloneSetsReady"
-	RisingWaveAction_SyncComputeService                              = "SyncComputeService"
-	RisingWaveAction_SyncComputeStatefulSets                         = "SyncComputeStatefulSets"
-	RisingWaveAction_SyncComputeAdvancedStatefulSets                 = "SyncComputeAdvancedStatefulSets"
-	RisingWaveAction_WaitBeforeComputeStatefulSetsReady              = "WaitBeforeComputeStatefulSetsReady"
-	RisingWaveAction_WaitBeforeComputeAdvancedStatefulSetsReady      = "WaitBeforeComputeAdvancedStatefulSetsReady"
-	RisingWaveAction_SyncCompactorService                            = "SyncCompactorService"
-	RisingWaveAction_SyncCompactorDeployments                        = "SyncCompactorDeployments"
-	RisingWaveAction_SyncCompactorCloneSets                          = "SyncCompactorCloneSets"
-	RisingWaveAction_WaitBeforeCompactorDeploymentsReady             = "WaitBeforeCompactorDeploymentsReady"
-	RisingWaveAction_WaitBeforeCompactorCloneSetsReady               = "WaitBeforeCompactorCloneSetsReady"
-	RisingWaveAction_SyncConnectorService                            = "SyncConnectorService"
-	RisingWaveAction_SyncConnectorDeployments                        = "SyncConnectorDeployments"
-	RisingWaveAction_SyncConnectorCloneSets                          = "SyncConnectorCloneSets"
-	RisingWaveAction_WaitBeforeConnectorDeploymentsReady             = "WaitBeforeConnectorDeploymentsReady"
-	RisingWaveAction_WaitBeforeConnectorCloneSetsReady               = "WaitBeforeConnectorCloneSetsReady"
-	RisingWaveAction_SyncConfigConfigMap                             = "SyncConfigConfigMap"
-	RisingWaveAction_SyncServiceMonitor                              = "SyncServiceMonitor"
-	RisingWaveAction_CollectRunningStatisticsAndSyncStatus           = "CollectRunningStatisticsAndSyncStatus"
-	RisingWaveAction_CollectOpenKruiseRunningStatisticsAndSyncStatus = "CollectOpenKruiseRunningStatisticsAndSyncStatus"
-)
+// SyncMetaRestoreJob generates the action of "SyncMetaRestoreJob".
+func (m *RisingWaveControllerManager) SyncMetaRestoreJob() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncMetaRestoreJob, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncMetaRestoreJob)
 
-// RisingWaveControllerManager encapsulates the states and actions used by RisingWaveController.
-type RisingWaveControllerManager struct {
-	hook   ctrlkit.ActionHook
-	state  RisingWaveControllerManagerState
-	impl   RisingWaveControllerManagerImpl
-	logger logr.Logger
+		// Get states.
+		metaRestoreJob, err := m.state.GetMetaRestoreJob(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncMetaRestoreJob, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncMetaRestoreJob, map[string]runtime.Object{
+				"metaRestoreJob": metaRestoreJob,
+			})
+		}
+
+		return m.impl.SyncMetaRestoreJob(ctx, logger, metaRestoreJob)
+	})
 }
 
-// NewAction returns a new action controlled by the manager.
-func (m *RisingWaveControllerManager) NewAction(description string, f func(context.Context, logr.Logger) (ctrl.Result, error)) ctrlkit.Action {
-	return ctrlkit.NewAction(description, func(ctx context.Context) (result ctrl.Result, err error) {
-		logger := m.logger.WithValues("action", description)
+// WaitBeforeMetaRestoreJobCompleted generates the action of "WaitBeforeMetaRestoreJobCompleted".
+func (m *RisingWaveControllerManager) WaitBeforeMetaRestoreJobCompleted() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_WaitBeforeMetaRestoreJobCompleted, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_WaitBeforeMetaRestoreJobCompleted)
+
+		// Get states.
+		metaRestoreJob, err := m.state.GetMetaRestoreJob(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
 
+		// Invoke action.
 		if m.hook != nil {
-			defer func() { m.hook.PostRun(ctx, logger, description, result, err) }()
-			m.hook.PreRun(ctx, logger, description, nil)
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_WaitBeforeMetaRestoreJobCompleted, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_WaitBeforeMetaRestoreJobCompleted, map[string]runtime.Object{
+				"metaRestoreJob": metaRestoreJob,
+			})
 		}
 
-		return f(ctx, logger)
+		return m.impl.WaitBeforeMetaRestoreJobCompleted(ctx, logger, metaRestoreJob)
 	})
 }
 
@@ -699,6 +1428,75 @@ func (m *RisingWaveControllerManager) SyncMetaAdvancedStatefulSets() ctrlkit.Act
 	})
 }
 
+// SyncMetaLeaderService generates the action of "SyncMetaLeaderService".
+func (m *RisingWaveControllerManager) SyncMetaLeaderService() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncMetaLeaderService, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncMetaLeaderService)
+
+		// Get states.
+		metaLeaderService, err := m.state.GetMetaLeaderService(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncMetaLeaderService, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncMetaLeaderService, map[string]runtime.Object{
+				"metaLeaderService": metaLeaderService,
+			})
+		}
+
+		return m.impl.SyncMetaLeaderService(ctx, logger, metaLeaderService)
+	})
+}
+
+// SyncMetaDashboardIngress generates the action of "SyncMetaDashboardIngress".
+func (m *RisingWaveControllerManager) SyncMetaDashboardIngress() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncMetaDashboardIngress, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncMetaDashboardIngress)
+
+		// Get states.
+		metaDashboardIngress, err := m.state.GetMetaDashboardIngress(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncMetaDashboardIngress, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncMetaDashboardIngress, map[string]runtime.Object{
+				"metaDashboardIngress": metaDashboardIngress,
+			})
+		}
+
+		return m.impl.SyncMetaDashboardIngress(ctx, logger, metaDashboardIngress)
+	})
+}
+
+// SyncMetaPodDisruptionBudgets generates the action of "SyncMetaPodDisruptionBudgets".
+func (m *RisingWaveControllerManager) SyncMetaPodDisruptionBudgets() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncMetaPodDisruptionBudgets, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncMetaPodDisruptionBudgets)
+
+		// Get states.
+		metaPodDisruptionBudgets, err := m.state.GetMetaPodDisruptionBudgets(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncMetaPodDisruptionBudgets, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncMetaPodDisruptionBudgets, map[string]runtime.Object{
+				"metaPodDisruptionBudgets": &policyv1.PodDisruptionBudgetList{Items: metaPodDisruptionBudgets},
+			})
+		}
+
+		return m.impl.SyncMetaPodDisruptionBudgets(ctx, logger, metaPodDisruptionBudgets)
+	})
+}
+
 // WaitBeforeMetaServiceIsAvailable generates the action of "WaitBeforeMetaServiceIsAvailable".
 func (m *RisingWaveControllerManager) WaitBeforeMetaServiceIsAvailable() ctrlkit.Action {
 	return ctrlkit.NewAction(RisingWaveAction_WaitBeforeMetaServiceIsAvailable, func(ctx context.Context) (result ctrl.Result, err error) {
@@ -839,6 +1637,29 @@ func (m *RisingWaveControllerManager) SyncFrontendCloneSets() ctrlkit.Action {
 	})
 }
 
+// SyncFrontendPodDisruptionBudgets generates the action of "SyncFrontendPodDisruptionBudgets".
+func (m *RisingWaveControllerManager) SyncFrontendPodDisruptionBudgets() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncFrontendPodDisruptionBudgets, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncFrontendPodDisruptionBudgets)
+
+		// Get states.
+		frontendPodDisruptionBudgets, err := m.state.GetFrontendPodDisruptionBudgets(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncFrontendPodDisruptionBudgets, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncFrontendPodDisruptionBudgets, map[string]runtime.Object{
+				"frontendPodDisruptionBudgets": &policyv1.PodDisruptionBudgetList{Items: frontendPodDisruptionBudgets},
+			})
+		}
+
+		return m.impl.SyncFrontendPodDisruptionBudgets(ctx, logger, frontendPodDisruptionBudgets)
+	})
+}
+
 // WaitBeforeFrontendDeploymentsReady generates the action of "WaitBeforeFrontendDeploymentsReady".
 func (m *RisingWaveControllerManager) WaitBeforeFrontendDeploymentsReady() ctrlkit.Action {
 	return ctrlkit.NewAction(RisingWaveAction_WaitBeforeFrontendDeploymentsReady, func(ctx context.Context) (result ctrl.Result, err error) {
@@ -954,6 +1775,29 @@ func (m *RisingWaveControllerManager) SyncComputeAdvancedStatefulSets() ctrlkit.
 	})
 }
 
+// SyncComputePodDisruptionBudgets generates the action of "SyncComputePodDisruptionBudgets".
+func (m *RisingWaveControllerManager) SyncComputePodDisruptionBudgets() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncComputePodDisruptionBudgets, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncComputePodDisruptionBudgets)
+
+		// Get states.
+		computePodDisruptionBudgets, err := m.state.GetComputePodDisruptionBudgets(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncComputePodDisruptionBudgets, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncComputePodDisruptionBudgets, map[string]runtime.Object{
+				"computePodDisruptionBudgets": &policyv1.PodDisruptionBudgetList{Items: computePodDisruptionBudgets},
+			})
+		}
+
+		return m.impl.SyncComputePodDisruptionBudgets(ctx, logger, computePodDisruptionBudgets)
+	})
+}
+
 // WaitBeforeComputeStatefulSetsReady generates the action of "WaitBeforeComputeStatefulSetsReady".
 func (m *RisingWaveControllerManager) WaitBeforeComputeStatefulSetsReady() ctrlkit.Action {
 	return ctrlkit.NewAction(RisingWaveAction_WaitBeforeComputeStatefulSetsReady, func(ctx context.Context) (result ctrl.Result, err error) {
@@ -1071,6 +1915,29 @@ func (m *RisingWaveControllerManager) SyncCompactorCloneSets() ctrlkit.Action {
 	})
 }
 
+// SyncCompactorPodDisruptionBudgets generates the action of "SyncCompactorPodDisruptionBudgets".
+func (m *RisingWaveControllerManager) SyncCompactorPodDisruptionBudgets() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncCompactorPodDisruptionBudgets, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncCompactorPodDisruptionBudgets)
+
+		// Get states.
+		compactorPodDisruptionBudgets, err := m.state.GetCompactorPodDisruptionBudgets(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncCompactorPodDisruptionBudgets, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncCompactorPodDisruptionBudgets, map[string]runtime.Object{
+				"compactorPodDisruptionBudgets": &policyv1.PodDisruptionBudgetList{Items: compactorPodDisruptionBudgets},
+			})
+		}
+
+		return m.impl.SyncCompactorPodDisruptionBudgets(ctx, logger, compactorPodDisruptionBudgets)
+	})
+}
+
 // WaitBeforeCompactorDeploymentsReady generates the action of "WaitBeforeCompactorDeploymentsReady".
 func (m *RisingWaveControllerManager) WaitBeforeCompactorDeploymentsReady() ctrlkit.Action {
 	return ctrlkit.NewAction(RisingWaveAction_WaitBeforeCompactorDeploymentsReady, func(ctx context.Context) (result ctrl.Result, err error) {
@@ -1186,6 +2053,29 @@ func (m *RisingWaveControllerManager) SyncConnectorCloneSets() ctrlkit.Action {
 	})
 }
 
+// SyncConnectorPodDisruptionBudgets generates the action of "SyncConnectorPodDisruptionBudgets".
+func (m *RisingWaveControllerManager) SyncConnectorPodDisruptionBudgets() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncConnectorPodDisruptionBudgets, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncConnectorPodDisruptionBudgets)
+
+		// Get states.
+		connectorPodDisruptionBudgets, err := m.state.GetConnectorPodDisruptionBudgets(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncConnectorPodDisruptionBudgets, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncConnectorPodDisruptionBudgets, map[string]runtime.Object{
+				"connectorPodDisruptionBudgets": &policyv1.PodDisruptionBudgetList{Items: connectorPodDisruptionBudgets},
+			})
+		}
+
+		return m.impl.SyncConnectorPodDisruptionBudgets(ctx, logger, connectorPodDisruptionBudgets)
+	})
+}
+
 // WaitBeforeConnectorDeploymentsReady generates the action of "WaitBeforeConnectorDeploymentsReady".
 func (m *RisingWaveControllerManager) WaitBeforeConnectorDeploymentsReady() ctrlkit.Action {
 	return ctrlkit.NewAction(RisingWaveAction_WaitBeforeConnectorDeploymentsReady, func(ctx context.Context) (result ctrl.Result, err error) {
@@ -1255,6 +2145,67 @@ func (m *RisingWaveControllerManager) SyncConfigConfigMap() ctrlkit.Action {
 	})
 }
 
+// CheckRolloutProgressDeadline generates the action of "CheckRolloutProgressDeadline".
+func (m *RisingWaveControllerManager) CheckRolloutProgressDeadline() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_CheckRolloutProgressDeadline, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_CheckRolloutProgressDeadline)
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_CheckRolloutProgressDeadline, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_CheckRolloutProgressDeadline, nil)
+		}
+
+		return m.impl.CheckRolloutProgressDeadline(ctx, logger)
+	})
+}
+
+// SyncRevisionConfigMap generates the action of "SyncRevisionConfigMap".
+func (m *RisingWaveControllerManager) SyncRevisionConfigMap() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_SyncRevisionConfigMap, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_SyncRevisionConfigMap)
+
+		// Get states.
+		revisionConfigMap, err := m.state.GetRevisionConfigMap(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_SyncRevisionConfigMap, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_SyncRevisionConfigMap, map[string]runtime.Object{
+				"revisionConfigMap": revisionConfigMap,
+			})
+		}
+
+		return m.impl.SyncRevisionConfigMap(ctx, logger, revisionConfigMap)
+	})
+}
+
+// RollbackWorkloads generates the action of "RollbackWorkloads".
+func (m *RisingWaveControllerManager) RollbackWorkloads() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_RollbackWorkloads, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_RollbackWorkloads)
+
+		// Get states.
+		revisionConfigMap, err := m.state.GetRevisionConfigMap(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_RollbackWorkloads, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_RollbackWorkloads, map[string]runtime.Object{
+				"revisionConfigMap": revisionConfigMap,
+			})
+		}
+
+		return m.impl.RollbackWorkloads(ctx, logger, revisionConfigMap)
+	})
+}
+
 // SyncServiceMonitor generates the action of "SyncServiceMonitor".
 func (m *RisingWaveControllerManager) SyncServiceMonitor() ctrlkit.Action {
 	return ctrlkit.NewAction(RisingWaveAction_SyncServiceMonitor, func(ctx context.Context) (result ctrl.Result, err error) {
@@ -1278,6 +2229,21 @@ func (m *RisingWaveControllerManager) SyncServiceMonitor() ctrlkit.Action {
 	})
 }
 
+// CollectReferencedObjectsHash generates the action of "CollectReferencedObjectsHash".
+func (m *RisingWaveControllerManager) CollectReferencedObjectsHash() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_CollectReferencedObjectsHash, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_CollectReferencedObjectsHash)
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_CollectReferencedObjectsHash, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_CollectReferencedObjectsHash, nil)
+		}
+
+		return m.impl.CollectReferencedObjectsHash(ctx, logger)
+	})
+}
+
 // CollectRunningStatisticsAndSyncStatus generates the action of "CollectRunningStatisticsAndSyncStatus".
 func (m *RisingWaveControllerManager) CollectRunningStatisticsAndSyncStatus() ctrlkit.Action {
 	return ctrlkit.NewAction(RisingWaveAction_CollectRunningStatisticsAndSyncStatus, func(ctx context.Context) (result ctrl.Result, err error) {
@@ -1448,6 +2414,70 @@ func (m *RisingWaveControllerManager) CollectOpenKruiseRunningStatisticsAndSyncS
 	})
 }
 
+// CollectPodFailuresAndSyncCondition generates the action of "CollectPodFailuresAndSyncCondition".
+func (m *RisingWaveControllerManager) CollectPodFailuresAndSyncCondition() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_CollectPodFailuresAndSyncCondition, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_CollectPodFailuresAndSyncCondition)
+
+		// Get states.
+		componentPods, err := m.state.GetComponentPods(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_CollectPodFailuresAndSyncCondition, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_CollectPodFailuresAndSyncCondition, map[string]runtime.Object{
+				"componentPods": &corev1.PodList{Items: componentPods},
+			})
+		}
+
+		return m.impl.CollectPodFailuresAndSyncCondition(ctx, logger, componentPods)
+	})
+}
+
+// CollectEndpointsAndSyncStatus generates the action of "CollectEndpointsAndSyncStatus".
+func (m *RisingWaveControllerManager) CollectEndpointsAndSyncStatus() ctrlkit.Action {
+	return ctrlkit.NewAction(RisingWaveAction_CollectEndpointsAndSyncStatus, func(ctx context.Context) (result ctrl.Result, err error) {
+		logger := m.logger.WithValues("action", RisingWaveAction_CollectEndpointsAndSyncStatus)
+
+		// Get states.
+		frontendService, err := m.state.GetFrontendService(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		metaService, err := m.state.GetMetaService(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		metaLeaderService, err := m.state.GetMetaLeaderService(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		componentPods, err := m.state.GetComponentPods(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfError(err)
+		}
+
+		// Invoke action.
+		if m.hook != nil {
+			defer func() { m.hook.PostRun(ctx, logger, RisingWaveAction_CollectEndpointsAndSyncStatus, result, err) }()
+			m.hook.PreRun(ctx, logger, RisingWaveAction_CollectEndpointsAndSyncStatus, map[string]runtime.Object{
+				"frontendService":   frontendService,
+				"metaService":       metaService,
+				"metaLeaderService": metaLeaderService,
+				"componentPods":     &corev1.PodList{Items: componentPods},
+			})
+		}
+
+		return m.impl.CollectEndpointsAndSyncStatus(ctx, logger, frontendService, metaService, metaLeaderService, componentPods)
+	})
+}
+
 type RisingWaveControllerManagerOption func(*RisingWaveControllerManager)
 
 func RisingWaveControllerManager_WithActionHook(hook ctrlkit.ActionHook) RisingWaveControllerManagerOption {
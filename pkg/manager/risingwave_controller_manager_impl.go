@@ -18,11 +18,13 @@ package manager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	kruiseappsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
@@ -30,9 +32,16 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/samber/lo"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
@@ -49,6 +58,10 @@ import (
 	"github.com/risingwavelabs/risingwave-operator/pkg/utils"
 )
 
+const (
+	minioPasswordLength = 32
+)
+
 type risingWaveControllerManagerImpl struct {
 	client             client.Client
 	risingwaveManager  *object.RisingWaveManager
@@ -122,6 +135,12 @@ func buildMetaStoreType(metaStore *risingwavev1alpha1.RisingWaveMetaStoreBackend
 		return risingwavev1alpha1.RisingWaveMetaStoreBackendTypeMemory
 	case metaStore.Etcd != nil:
 		return risingwavev1alpha1.RisingWaveMetaStoreBackendTypeEtcd
+	case metaStore.PostgreSQL != nil:
+		return risingwavev1alpha1.RisingWaveMetaStoreBackendTypePostgreSQL
+	case metaStore.MySQL != nil:
+		return risingwavev1alpha1.RisingWaveMetaStoreBackendTypeMySQL
+	case metaStore.SQLite != nil:
+		return risingwavev1alpha1.RisingWaveMetaStoreBackendTypeSQLite
 	default:
 		return risingwavev1alpha1.RisingWaveMetaStoreBackendTypeUnknown
 	}
@@ -135,6 +154,8 @@ func buildStateStoreType(stateStore *risingwavev1alpha1.RisingWaveStateStoreBack
 		return risingwavev1alpha1.RisingWaveStateStoreBackendTypeMinIO
 	case stateStore.S3 != nil:
 		return risingwavev1alpha1.RisingWaveStateStoreBackendTypeS3
+	case stateStore.S3Compatible != nil:
+		return risingwavev1alpha1.RisingWaveStateStoreBackendTypeS3Compatible
 	case stateStore.GCS != nil:
 		return risingwavev1alpha1.RisingWaveStateStoreBackendTypeGCS
 	case stateStore.AliyunOSS != nil:
@@ -394,6 +415,165 @@ func (mgr *risingWaveControllerManagerImpl) CollectRunningStatisticsAndSyncStatu
 	return ctrlkit.Continue()
 }
 
+// podFailureReasons are the reasons of the condition Failed set by CollectPodFailuresAndSyncCondition.
+var podFailureReasons = []string{
+	utils.PodFailureReasonCrashLoopBackOff,
+	utils.PodFailureReasonImagePullBackOff,
+	utils.PodFailureReasonOOMKilled,
+	utils.PodFailureReasonUnschedulable,
+}
+
+// CollectPodFailuresAndSyncCondition implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) CollectPodFailuresAndSyncCondition(ctx context.Context, logger logr.Logger, componentPods []corev1.Pod) (reconcile.Result, error) {
+	components := []string{
+		consts.ComponentMeta,
+		consts.ComponentCompute,
+		consts.ComponentCompactor,
+		consts.ComponentConnector,
+		consts.ComponentFrontend,
+	}
+
+	// Pods of the managed stores and the jobs are ignored.
+	pods := lo.Filter(componentPods, func(pod corev1.Pod, _ int) bool {
+		return lo.Contains(components, pod.Labels[consts.LabelRisingWaveComponent]) && !utils.IsDeleted(&pod)
+	})
+	sort.Slice(pods, func(i, j int) bool {
+		ci, cj := lo.IndexOf(components, pods[i].Labels[consts.LabelRisingWaveComponent]), lo.IndexOf(components, pods[j].Labels[consts.LabelRisingWaveComponent])
+		if ci != cj {
+			return ci < cj
+		}
+		if gi, gj := pods[i].Labels[consts.LabelRisingWaveGroup], pods[j].Labels[consts.LabelRisingWaveGroup]; gi != gj {
+			return gi < gj
+		}
+		return pods[i].Name < pods[j].Name
+	})
+
+	var firstReason string
+	var failures []string
+	for _, pod := range pods {
+		reason, message := utils.GetPodFailure(&pod)
+		if reason == "" {
+			continue
+		}
+		if firstReason == "" {
+			firstReason = reason
+		}
+		failures = append(failures, fmt.Sprintf("pod %s of %s/%s: %s, %s", pod.Name,
+			pod.Labels[consts.LabelRisingWaveComponent], pod.Labels[consts.LabelRisingWaveGroup], reason, message))
+	}
+
+	failedCondition := mgr.risingwaveManager.GetCondition(risingwavev1alpha1.RisingWaveConditionFailed)
+
+	// Only clear the condition set by this action.
+	if len(failures) == 0 {
+		if failedCondition != nil && lo.Contains(podFailureReasons, failedCondition.Reason) {
+			logger.Info("Pod failures are gone")
+			mgr.risingwaveManager.RemoveCondition(risingwavev1alpha1.RisingWaveConditionFailed)
+		}
+		return ctrlkit.Continue()
+	}
+
+	// Keep the failure of the rollout, which blocks the sync until the spec is changed.
+	if failedCondition != nil && failedCondition.Status == metav1.ConditionTrue && !lo.Contains(podFailureReasons, failedCondition.Reason) {
+		return ctrlkit.Continue()
+	}
+
+	message := strings.Join(failures, "; ")
+	mgr.risingwaveManager.UpdateCondition(risingwavev1alpha1.RisingWaveCondition{
+		Type:    risingwavev1alpha1.RisingWaveConditionFailed,
+		Status:  metav1.ConditionTrue,
+		Reason:  firstReason,
+		Message: message,
+	})
+
+	// Send the Unhealthy event only when the failures change.
+	if failedCondition == nil || failedCondition.Message != message {
+		logger.Info("Found pod failures", "failures", failures)
+		eventMessage := "Found pod failures: " + message
+		if mgr.eventMessageStore.IsMessageSet(consts.RisingWaveEventTypeUnhealthy.Name) {
+			eventMessage = mgr.eventMessageStore.MessageFor(consts.RisingWaveEventTypeUnhealthy.Name) + "; " + eventMessage
+		}
+		mgr.eventMessageStore.SetMessage(consts.RisingWaveEventTypeUnhealthy.Name, eventMessage)
+	}
+
+	return ctrlkit.Continue()
+}
+
+// findServicePort returns the port of the given name in the Service.
+func findServicePort(svc *corev1.Service, name string) (int32, bool) {
+	port, ok := lo.Find(svc.Spec.Ports, func(port corev1.ServicePort) bool {
+		return port.Name == name
+	})
+	return port.Port, ok
+}
+
+// CollectEndpointsAndSyncStatus implements the RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) CollectEndpointsAndSyncStatus(ctx context.Context, logger logr.Logger, frontendService *corev1.Service, metaService *corev1.Service, metaLeaderService *corev1.Service, componentPods []corev1.Pod) (reconcile.Result, error) {
+	risingwave := mgr.risingwaveManager.RisingWave()
+
+	// Keep the last observed leader if there's none at the moment, e.g., during the failover.
+	metaLeader := risingwave.Status.MetaLeader.DeepCopy()
+	leaderPod, found := lo.Find(componentPods, func(pod corev1.Pod) bool {
+		return pod.Labels[consts.LabelRisingWaveComponent] == consts.ComponentMeta &&
+			pod.Labels[consts.LabelRisingWaveMetaRole] == consts.MetaRoleLeader &&
+			!utils.IsDeleted(&pod) && utils.IsPodRunning(&pod) && pod.Status.PodIP != ""
+	})
+	if found {
+		svcPort, ok := utils.GetPortFromContainer(utils.GetContainerFromPod(&leaderPod, "meta"), consts.PortService)
+		if !ok {
+			svcPort = consts.MetaServicePort
+		}
+		address := fmt.Sprintf("%s:%d", leaderPod.Status.PodIP, svcPort)
+
+		switch {
+		case metaLeader == nil:
+			metaLeader = &risingwavev1alpha1.RisingWaveMetaLeaderStatus{}
+		case metaLeader.Pod != leaderPod.Name:
+			logger.Info("Meta leader changed", "from", metaLeader.Pod, "to", leaderPod.Name)
+			now := metav1.Now()
+			metaLeader.TransitionCount++
+			metaLeader.LastTransitionTime = &now
+		}
+		metaLeader.Pod = leaderPod.Name
+		metaLeader.Address = address
+	}
+
+	var frontendEndpoint *risingwavev1alpha1.RisingWaveFrontendEndpointStatus
+	if frontendService != nil {
+		port, ok := findServicePort(frontendService, consts.PortService)
+		if !ok {
+			port = consts.FrontendServicePort
+		}
+		frontendEndpoint = &risingwavev1alpha1.RisingWaveFrontendEndpointStatus{
+			Host:        fmt.Sprintf("%s.%s.svc", frontendService.Name, frontendService.Namespace),
+			Port:        port,
+			ServiceType: frontendService.Spec.Type,
+		}
+		if frontendService.Spec.Type == corev1.ServiceTypeLoadBalancer {
+			frontendEndpoint.LoadBalancerIngress = frontendService.Status.LoadBalancer.Ingress
+		}
+	}
+
+	// Prefer the meta leader Service, since the meta Service could route to the followers.
+	var metaDashboardURL string
+	if metaLeaderService != nil {
+		metaService = metaLeaderService
+	}
+	if metaService != nil {
+		if port, ok := findServicePort(metaService, consts.PortDashboard); ok {
+			metaDashboardURL = fmt.Sprintf("http://%s.%s.svc:%d", metaService.Name, metaService.Namespace, port)
+		}
+	}
+
+	mgr.risingwaveManager.UpdateStatus(func(status *risingwavev1alpha1.RisingWaveStatus) {
+		status.MetaLeader = metaLeader
+		status.FrontendEndpoint = frontendEndpoint
+		status.MetaDashboardURL = metaDashboardURL
+	})
+
+	return ctrlkit.Continue()
+}
+
 type ptrAsObject[T any] interface {
 	client.Object
 	*T
@@ -428,13 +608,22 @@ func syncComponentGroupWorkloads[T any, TP ptrAsObject[T]](
 			logger.Info("Duplicate group found, mark as to delete", "group", group, "workload", workloadObjPtr.GetName())
 			toDelete = append(toDelete, workloadObjPtr)
 		} else {
+			_, expectExists := expectedGroupSet[group]
 			if !mgr.isObjectSynced(workloadObjPtr) {
-				_, expectExists := expectedGroupSet[group]
 				if expectExists {
 					toSyncGroupObjects[group] = workloadObjPtr
 				} else {
 					toDelete = append(toDelete, workloadObjPtr)
 				}
+			} else if expectExists {
+				// Roll the group if the referenced ConfigMaps and Secrets are changed.
+				hashSynced, err := mgr.isReferencedObjectsHashSynced(ctx, component, workloadObjPtr)
+				if err != nil {
+					return ctrlkit.RequeueIfErrorAndWrap("unable to hash referenced objects", err)
+				}
+				if !hashSynced {
+					toSyncGroupObjects[group] = workloadObjPtr
+				}
 			}
 		}
 		observedGroupSet[group] = 1
@@ -446,6 +635,11 @@ func syncComponentGroupWorkloads[T any, TP ptrAsObject[T]](
 		}
 	}
 
+	// Leave the held groups as they are, e.g., the compute groups being drained before scaling in.
+	toSyncGroupObjects = lo.OmitBy(toSyncGroupObjects, func(group string, _ TP) bool {
+		return mgr.risingwaveManager.IsNodeGroupHeld(component, group)
+	})
+
 	// Delete the unexpected. Note it won't delete any workload object that is created with a newer generation,
 	// so it is safe to do the deletion.
 	for _, workloadObj := range toDelete {
@@ -456,18 +650,113 @@ func syncComponentGroupWorkloads[T any, TP ptrAsObject[T]](
 		}
 	}
 
+	newGroupObjects := make(map[string]TP, len(toSyncGroupObjects))
+	for group := range toSyncGroupObjects {
+		newGroupObjects[group] = factory(group)
+	}
+
+	// Hold the other groups until the canary group has soaked when upgrading the images with a canary. The images
+	// could be upgraded either through the global image or the images of the node groups.
+	var result reconcile.Result
+	if canaryGroup, ok := mgr.risingwaveManager.GetCanaryNodeGroup(component); ok && enabled &&
+		isAnyWorkloadImageUpgrading(toSyncGroupObjects, newGroupObjects) &&
+		lo.SomeBy(lo.Keys(toSyncGroupObjects), func(group string) bool { return group != canaryGroup }) {
+		canaryReady := false
+		if _, canaryToSync := toSyncGroupObjects[canaryGroup]; !canaryToSync {
+			canaryObj, found := lo.Find(objects, func(obj T) bool {
+				return TP(&obj).GetLabels()[consts.LabelRisingWaveGroup] == canaryGroup
+			})
+			if found {
+				canaryReady = mgr.isWorkloadSynced(ctx, component, TP(&canaryObj)) && isWorkloadRolledOut(TP(&canaryObj))
+			} else {
+				logger.Info("Canary workload not found", "group", canaryGroup)
+			}
+		}
+
+		if soakRemaining, soaked := mgr.syncCanary(logger, component, canaryGroup, canaryReady); !soaked {
+			toSyncGroupObjects = lo.PickByKeys(toSyncGroupObjects, []string{canaryGroup})
+			result.RequeueAfter = soakRemaining
+		}
+	}
+
 	// Sync the outdated.
 	if len(toSyncGroupObjects) > 0 {
 		for group, workloadObj := range toSyncGroupObjects {
-			if err := syncObject(mgr, ctx, workloadObj, func() TP {
-				return factory(group)
-			}, logger.WithValues("group", group)); err != nil {
+			newObj := newGroupObjects[group]
+			if err := mgr.injectReferencedObjectsHash(ctx, component, group, newObj); err != nil {
+				return ctrlkit.RequeueIfErrorAndWrap("unable to hash referenced objects", err)
+			}
+
+			var err error
+			if isObjectNil(workloadObj) {
+				err = syncObject(mgr, ctx, workloadObj, func() TP { return newObj }, logger.WithValues("group", group))
+			} else {
+				err = mgr.updateObject(ctx, workloadObj, newObj, logger.WithValues("group", group))
+			}
+			if err != nil {
 				return ctrlkit.RequeueIfErrorAndWrap("unable to sync object", err)
 			}
 		}
 	}
 
-	return ctrlkit.Continue()
+	return result, nil
+}
+
+// syncCanary records the status of the canary group and tells whether the canary group has been ready for the soak
+// time. If not, the remaining soak time is returned, or zero if the canary group isn't ready yet.
+func (mgr *risingWaveControllerManagerImpl) syncCanary(logger logr.Logger, component, group string, ready bool) (time.Duration, bool) {
+	var readyTime *metav1.Time
+	if canary := mgr.risingwaveManager.RisingWave().Status.Canary; ready && canary != nil &&
+		canary.Component == component && canary.Group == group && canary.ReadyTime != nil {
+		readyTime = canary.ReadyTime
+	} else if ready {
+		readyTime = lo.ToPtr(metav1.Now())
+	}
+	mgr.risingwaveManager.SetCanary(component, group, readyTime)
+
+	if readyTime == nil {
+		logger.Info("Canary group isn't ready, hold the others", "group", group)
+		mgr.risingwaveManager.UpdateCondition(risingwavev1alpha1.RisingWaveCondition{
+			Type:    risingwavev1alpha1.RisingWaveConditionCanaryInProgress,
+			Status:  metav1.ConditionTrue,
+			Reason:  "CanaryNotReady",
+			Message: fmt.Sprintf("Waiting for the canary group %s of %s to be ready", group, component),
+		})
+		return 0, false
+	}
+
+	if remaining := mgr.risingwaveManager.CanarySoakDuration() - time.Since(readyTime.Time); remaining > 0 {
+		logger.Info("Canary group is soaking, hold the others", "group", group, "remaining", remaining)
+		mgr.risingwaveManager.UpdateCondition(risingwavev1alpha1.RisingWaveCondition{
+			Type:    risingwavev1alpha1.RisingWaveConditionCanaryInProgress,
+			Status:  metav1.ConditionTrue,
+			Reason:  "CanarySoaking",
+			Message: fmt.Sprintf("Canary group %s of %s is soaking until %s", group, component, readyTime.Add(mgr.risingwaveManager.CanarySoakDuration()).Format(time.RFC3339)),
+		})
+		return remaining, false
+	}
+
+	logger.Info("Canary group has soaked, upgrade the others", "group", group)
+	mgr.risingwaveManager.RemoveCondition(risingwavev1alpha1.RisingWaveConditionCanaryInProgress)
+	mgr.risingwaveManager.SetCanarySoaked(component)
+	return 0, true
+}
+
+// isAnyWorkloadImageUpgrading tells whether the image of any existing workload is going to be changed.
+func isAnyWorkloadImageUpgrading[TP client.Object](objs, newObjs map[string]TP) bool {
+	return lo.SomeBy(lo.Keys(objs), func(group string) bool {
+		obj := objs[group]
+		return !isObjectNil(obj) && imageOfWorkload(obj) != imageOfWorkload(newObjs[group])
+	})
+}
+
+// imageOfWorkload returns the image of the RisingWave container, which is always the first one.
+func imageOfWorkload(obj client.Object) string {
+	containers := getPodTemplateOfWorkload(obj).Spec.Containers
+	if len(containers) == 0 {
+		return ""
+	}
+	return containers[0].Image
 }
 
 func getNameFromNodeGroup(g *risingwavev1alpha1.RisingWaveNodeGroup) string {
@@ -583,10 +872,115 @@ func (mgr *risingWaveControllerManagerImpl) SyncMetaAdvancedStatefulSets(ctx con
 	)
 }
 
-func waitComponentGroupWorkloadsReady[T any, TP ptrAsObject[T]](ctx context.Context, logger logr.Logger, component string,
-	groups map[string]int, objects []T, isReady func(*T) bool) (reconcile.Result, error) {
+// isPodDisruptionBudgetExpected tells whether the PodDisruptionBudget of the node group should exist. The explicit
+// one always exists. The default one is applied to the meta and frontend node groups unless opted in for the other
+// components, and is skipped when the node group has at most one replica or the RisingWave is stopped, since it'd
+// block the node drains, e.g., meta would have to keep its only Pod available.
+func isPodDisruptionBudgetExpected(component string, nodeGroup *risingwavev1alpha1.RisingWaveNodeGroup, stopped bool) bool {
+	pdb := nodeGroup.PodDisruptionBudget
+	if pdb != nil && (pdb.MinAvailable != nil || pdb.MaxUnavailable != nil) {
+		return true
+	}
+	if stopped || nodeGroup.Replicas <= 1 {
+		return false
+	}
+	return pdb != nil || component == consts.ComponentMeta || component == consts.ComponentFrontend
+}
+
+// syncComponentGroupPodDisruptionBudgets creates or updates the PodDisruptionBudgets of the groups expecting one,
+// and deletes the others.
+func syncComponentGroupPodDisruptionBudgets(
+	mgr *risingWaveControllerManagerImpl,
+	ctx context.Context,
+	logger logr.Logger,
+	component string,
+	pdbs []policyv1.PodDisruptionBudget,
+	factory func(group string) *policyv1.PodDisruptionBudget,
+) (reconcile.Result, error) {
+	logger = logger.WithValues("component", component)
+
+	expectedGroupSet := buildKeyMapFromList(
+		lo.Filter(mgr.risingwaveManager.GetNodeGroups(component), func(g risingwavev1alpha1.RisingWaveNodeGroup, _ int) bool {
+			return isPodDisruptionBudgetExpected(component, &g, mgr.risingwaveManager.IsStopped())
+		}),
+		getNameFromNodeGroup,
+	)
+
+	observedGroups := make(map[string]*policyv1.PodDisruptionBudget)
+	for i := range pdbs {
+		pdb := &pdbs[i]
+		group := pdb.GetLabels()[consts.LabelRisingWaveGroup]
+		_, expected := expectedGroupSet[group]
+		if _, duplicated := observedGroups[group]; duplicated || !expected {
+			if err := mgr.deleteObject(ctx, pdb, logger.WithValues("group", group)); err != nil {
+				return ctrlkit.RequeueIfErrorAndWrap("unable to delete pod disruption budget", err)
+			}
+			continue
+		}
+		observedGroups[group] = pdb
+	}
+
+	for group := range expectedGroupSet {
+		err := syncObject(mgr, ctx, observedGroups[group], func() *policyv1.PodDisruptionBudget {
+			return factory(group)
+		}, logger.WithValues("group", group))
+		if err != nil {
+			return ctrlkit.RequeueIfErrorAndWrap("unable to sync pod disruption budget", err)
+		}
+	}
+
+	return ctrlkit.Continue()
+}
+
+// SyncMetaPodDisruptionBudgets implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncMetaPodDisruptionBudgets(ctx context.Context, logger logr.Logger, metaPodDisruptionBudgets []policyv1.PodDisruptionBudget) (reconcile.Result, error) {
+	return syncComponentGroupPodDisruptionBudgets(mgr, ctx, logger,
+		consts.ComponentMeta,
+		metaPodDisruptionBudgets, mgr.objectFactory.NewMetaPodDisruptionBudget,
+	)
+}
+
+// SyncFrontendPodDisruptionBudgets implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncFrontendPodDisruptionBudgets(ctx context.Context, logger logr.Logger, frontendPodDisruptionBudgets []policyv1.PodDisruptionBudget) (reconcile.Result, error) {
+	return syncComponentGroupPodDisruptionBudgets(mgr, ctx, logger,
+		consts.ComponentFrontend,
+		frontendPodDisruptionBudgets, mgr.objectFactory.NewFrontendPodDisruptionBudget,
+	)
+}
+
+// SyncComputePodDisruptionBudgets implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncComputePodDisruptionBudgets(ctx context.Context, logger logr.Logger, computePodDisruptionBudgets []policyv1.PodDisruptionBudget) (reconcile.Result, error) {
+	return syncComponentGroupPodDisruptionBudgets(mgr, ctx, logger,
+		consts.ComponentCompute,
+		computePodDisruptionBudgets, mgr.objectFactory.NewComputePodDisruptionBudget,
+	)
+}
+
+// SyncCompactorPodDisruptionBudgets implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncCompactorPodDisruptionBudgets(ctx context.Context, logger logr.Logger, compactorPodDisruptionBudgets []policyv1.PodDisruptionBudget) (reconcile.Result, error) {
+	return syncComponentGroupPodDisruptionBudgets(mgr, ctx, logger,
+		consts.ComponentCompactor,
+		compactorPodDisruptionBudgets, mgr.objectFactory.NewCompactorPodDisruptionBudget,
+	)
+}
+
+// SyncConnectorPodDisruptionBudgets implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncConnectorPodDisruptionBudgets(ctx context.Context, logger logr.Logger, connectorPodDisruptionBudgets []policyv1.PodDisruptionBudget) (reconcile.Result, error) {
+	return syncComponentGroupPodDisruptionBudgets(mgr, ctx, logger,
+		consts.ComponentConnector,
+		connectorPodDisruptionBudgets, mgr.objectFactory.NewConnectorPodDisruptionBudget,
+	)
+}
+
+func waitComponentGroupWorkloadsReady[T any, TP ptrAsObject[T]](mgr *risingWaveControllerManagerImpl, ctx context.Context,
+	logger logr.Logger, component string, groups map[string]int, objects []T, isReady func(*T) bool) (reconcile.Result, error) {
 	logger = logger.WithValues("component", component)
 
+	// Never block when stopped, there are no Pods to wait for.
+	if mgr.risingwaveManager.IsStopped() {
+		return ctrlkit.Continue()
+	}
+
 	foundGroups := make(map[string]int)
 	for _, workloadObj := range objects {
 		group := TP(&workloadObj).GetLabels()[consts.LabelRisingWaveGroup]
@@ -619,100 +1013,110 @@ func (mgr *risingWaveControllerManagerImpl) buildExpectedGroupSet(component stri
 
 // WaitBeforeCompactorDeploymentsReady implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) WaitBeforeCompactorDeploymentsReady(ctx context.Context, logger logr.Logger, compactorDeployments []appsv1.Deployment) (reconcile.Result, error) {
-	return waitComponentGroupWorkloadsReady(ctx, logger, consts.ComponentCompactor,
+	return waitComponentGroupWorkloadsReady(mgr, ctx, logger, consts.ComponentCompactor,
 		lo.If(!mgr.risingwaveManager.IsOpenKruiseEnabled(), mgr.buildExpectedGroupSet(consts.ComponentCompactor)).Else(nil),
 		compactorDeployments,
-		func(t *appsv1.Deployment) bool { return mgr.isObjectSynced(t) && utils.IsDeploymentRolledOut(t) },
+		func(t *appsv1.Deployment) bool {
+			return mgr.isWorkloadSynced(ctx, consts.ComponentCompactor, t) && utils.IsDeploymentRolledOut(t)
+		},
 	)
 }
 
 // WaitBeforeCompactorCloneSetsReady implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) WaitBeforeCompactorCloneSetsReady(ctx context.Context, logger logr.Logger, compactorCloneSets []kruiseappsv1alpha1.CloneSet) (reconcile.Result, error) {
-	return waitComponentGroupWorkloadsReady(ctx, logger, consts.ComponentCompactor,
+	return waitComponentGroupWorkloadsReady(mgr, ctx, logger, consts.ComponentCompactor,
 		lo.If(mgr.risingwaveManager.IsOpenKruiseEnabled(), mgr.buildExpectedGroupSet(consts.ComponentCompactor)).Else(nil),
 		compactorCloneSets,
 		func(t *kruiseappsv1alpha1.CloneSet) bool {
-			return mgr.isObjectSynced(t) && utils.IsCloneSetRolledOut(t)
+			return mgr.isWorkloadSynced(ctx, consts.ComponentCompactor, t) && utils.IsCloneSetRolledOut(t)
 		},
 	)
 }
 
 // WaitBeforeConnectorDeploymentsReady implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) WaitBeforeConnectorDeploymentsReady(ctx context.Context, logger logr.Logger, connectorDeployments []appsv1.Deployment) (reconcile.Result, error) {
-	return waitComponentGroupWorkloadsReady(ctx, logger, consts.ComponentConnector,
+	return waitComponentGroupWorkloadsReady(mgr, ctx, logger, consts.ComponentConnector,
 		lo.If(!mgr.risingwaveManager.IsOpenKruiseEnabled(), mgr.buildExpectedGroupSet(consts.ComponentConnector)).Else(nil),
 		connectorDeployments,
-		func(t *appsv1.Deployment) bool { return mgr.isObjectSynced(t) && utils.IsDeploymentRolledOut(t) },
+		func(t *appsv1.Deployment) bool {
+			return mgr.isWorkloadSynced(ctx, consts.ComponentConnector, t) && utils.IsDeploymentRolledOut(t)
+		},
 	)
 }
 
 // WaitBeforeConnectorCloneSetsReady implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) WaitBeforeConnectorCloneSetsReady(ctx context.Context, logger logr.Logger, connectorCloneSets []kruiseappsv1alpha1.CloneSet) (reconcile.Result, error) {
-	return waitComponentGroupWorkloadsReady(ctx, logger, consts.ComponentConnector,
+	return waitComponentGroupWorkloadsReady(mgr, ctx, logger, consts.ComponentConnector,
 		lo.If(mgr.risingwaveManager.IsOpenKruiseEnabled(), mgr.buildExpectedGroupSet(consts.ComponentConnector)).Else(nil),
 		connectorCloneSets,
 		func(t *kruiseappsv1alpha1.CloneSet) bool {
-			return mgr.isObjectSynced(t) && utils.IsCloneSetRolledOut(t)
+			return mgr.isWorkloadSynced(ctx, consts.ComponentConnector, t) && utils.IsCloneSetRolledOut(t)
 		},
 	)
 }
 
 // WaitBeforeComputeStatefulSetsReady implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) WaitBeforeComputeStatefulSetsReady(ctx context.Context, logger logr.Logger, computeStatefulSets []appsv1.StatefulSet) (reconcile.Result, error) {
-	return waitComponentGroupWorkloadsReady(ctx, logger, consts.ComponentCompute,
+	return waitComponentGroupWorkloadsReady(mgr, ctx, logger, consts.ComponentCompute,
 		lo.If(!mgr.risingwaveManager.IsOpenKruiseEnabled(), mgr.buildExpectedGroupSet(consts.ComponentCompute)).Else(nil),
 		computeStatefulSets,
-		func(t *appsv1.StatefulSet) bool { return mgr.isObjectSynced(t) && utils.IsStatefulSetRolledOut(t) },
+		func(t *appsv1.StatefulSet) bool {
+			return mgr.isWorkloadSynced(ctx, consts.ComponentCompute, t) && utils.IsStatefulSetRolledOut(t)
+		},
 	)
 }
 
 // WaitBeforeComputeAdvancedStatefulSetsReady implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) WaitBeforeComputeAdvancedStatefulSetsReady(ctx context.Context, logger logr.Logger, computeStatefulSets []kruiseappsv1beta1.StatefulSet) (reconcile.Result, error) {
-	return waitComponentGroupWorkloadsReady(ctx, logger, consts.ComponentCompute,
+	return waitComponentGroupWorkloadsReady(mgr, ctx, logger, consts.ComponentCompute,
 		lo.If(mgr.risingwaveManager.IsOpenKruiseEnabled(), mgr.buildExpectedGroupSet(consts.ComponentCompute)).Else(nil),
 		computeStatefulSets,
 		func(t *kruiseappsv1beta1.StatefulSet) bool {
-			return mgr.isObjectSynced(t) && utils.IsAdvancedStatefulSetRolledOut(t)
+			return mgr.isWorkloadSynced(ctx, consts.ComponentCompute, t) && utils.IsAdvancedStatefulSetRolledOut(t)
 		},
 	)
 }
 
 // WaitBeforeFrontendDeploymentsReady implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) WaitBeforeFrontendDeploymentsReady(ctx context.Context, logger logr.Logger, frontendDeployments []appsv1.Deployment) (reconcile.Result, error) {
-	return waitComponentGroupWorkloadsReady(ctx, logger, consts.ComponentFrontend,
+	return waitComponentGroupWorkloadsReady(mgr, ctx, logger, consts.ComponentFrontend,
 		lo.If(!mgr.risingwaveManager.IsOpenKruiseEnabled(), mgr.buildExpectedGroupSet(consts.ComponentFrontend)).Else(nil),
 		frontendDeployments,
-		func(t *appsv1.Deployment) bool { return mgr.isObjectSynced(t) && utils.IsDeploymentRolledOut(t) },
+		func(t *appsv1.Deployment) bool {
+			return mgr.isWorkloadSynced(ctx, consts.ComponentFrontend, t) && utils.IsDeploymentRolledOut(t)
+		},
 	)
 }
 
 // WaitBeforeFrontendCloneSetsReady implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) WaitBeforeFrontendCloneSetsReady(ctx context.Context, logger logr.Logger, frontendCloneSets []kruiseappsv1alpha1.CloneSet) (reconcile.Result, error) {
-	return waitComponentGroupWorkloadsReady(ctx, logger, consts.ComponentFrontend,
+	return waitComponentGroupWorkloadsReady(mgr, ctx, logger, consts.ComponentFrontend,
 		lo.If(mgr.risingwaveManager.IsOpenKruiseEnabled(), mgr.buildExpectedGroupSet(consts.ComponentFrontend)).Else(nil),
 		frontendCloneSets,
 		func(t *kruiseappsv1alpha1.CloneSet) bool {
-			return mgr.isObjectSynced(t) && utils.IsCloneSetRolledOut(t)
+			return mgr.isWorkloadSynced(ctx, consts.ComponentFrontend, t) && utils.IsCloneSetRolledOut(t)
 		},
 	)
 }
 
 // WaitBeforeMetaStatefulSetsReady implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) WaitBeforeMetaStatefulSetsReady(ctx context.Context, logger logr.Logger, metaStatefulSets []appsv1.StatefulSet) (reconcile.Result, error) {
-	return waitComponentGroupWorkloadsReady(ctx, logger, consts.ComponentMeta,
+	return waitComponentGroupWorkloadsReady(mgr, ctx, logger, consts.ComponentMeta,
 		lo.If(!mgr.risingwaveManager.IsOpenKruiseEnabled(), mgr.buildExpectedGroupSet(consts.ComponentMeta)).Else(nil),
 		metaStatefulSets,
-		func(t *appsv1.StatefulSet) bool { return mgr.isObjectSynced(t) && utils.IsStatefulSetRolledOut(t) },
+		func(t *appsv1.StatefulSet) bool {
+			return mgr.isWorkloadSynced(ctx, consts.ComponentMeta, t) && utils.IsStatefulSetRolledOut(t)
+		},
 	)
 }
 
 // WaitBeforeMetaAdvancedStatefulSetsReady implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) WaitBeforeMetaAdvancedStatefulSetsReady(ctx context.Context, logger logr.Logger, metaAdvancedStatefulSets []kruiseappsv1beta1.StatefulSet) (reconcile.Result, error) {
-	return waitComponentGroupWorkloadsReady(ctx, logger, consts.ComponentMeta,
+	return waitComponentGroupWorkloadsReady(mgr, ctx, logger, consts.ComponentMeta,
 		lo.If(mgr.risingwaveManager.IsOpenKruiseEnabled(), mgr.buildExpectedGroupSet(consts.ComponentMeta)).Else(nil),
 		metaAdvancedStatefulSets,
 		func(t *kruiseappsv1beta1.StatefulSet) bool {
-			return mgr.isObjectSynced(t) && utils.IsAdvancedStatefulSetRolledOut(t)
+			return mgr.isWorkloadSynced(ctx, consts.ComponentMeta, t) && utils.IsAdvancedStatefulSetRolledOut(t)
 		},
 	)
 }
@@ -739,6 +1143,90 @@ func (mgr *risingWaveControllerManagerImpl) isObjectSynced(obj client.Object) bo
 	return observedGeneration >= currentGeneration
 }
 
+func getPodTemplateOfWorkload(obj client.Object) *corev1.PodTemplateSpec {
+	switch obj := obj.(type) {
+	case *appsv1.Deployment:
+		return &obj.Spec.Template
+	case *appsv1.StatefulSet:
+		return &obj.Spec.Template
+	case *kruiseappsv1alpha1.CloneSet:
+		return &obj.Spec.Template
+	case *kruiseappsv1beta1.StatefulSet:
+		return &obj.Spec.Template
+	default:
+		panic(fmt.Sprintf("unsupported workload type: %T", obj))
+	}
+}
+
+// hashReferencedObjects returns the hash of the contents of the given ConfigMaps and Secrets. The missing ones
+// are hashed as empty, since they could be optional.
+func (mgr *risingWaveControllerManagerImpl) hashReferencedObjects(ctx context.Context, configMaps, secrets []string) (string, error) {
+	namespace := mgr.risingwaveManager.RisingWave().Namespace
+
+	contents := make([]any, 0, len(configMaps)+len(secrets))
+	for _, name := range configMaps {
+		var cm corev1.ConfigMap
+		if err := mgr.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &cm); client.IgnoreNotFound(err) != nil {
+			return "", fmt.Errorf("unable to get configmap %s: %w", name, err)
+		}
+		contents = append(contents, []any{"ConfigMap", name, cm.Data, cm.BinaryData})
+	}
+	for _, name := range secrets {
+		var secret corev1.Secret
+		if err := mgr.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &secret); client.IgnoreNotFound(err) != nil {
+			return "", fmt.Errorf("unable to get secret %s: %w", name, err)
+		}
+		contents = append(contents, []any{"Secret", name, secret.Data})
+	}
+
+	return utils.HashObject(contents)
+}
+
+func (mgr *risingWaveControllerManagerImpl) referencedObjectsHashOfGroup(ctx context.Context, component, group string) (string, error) {
+	configMaps, secrets := mgr.objectFactory.ReferencedConfigMapsAndSecrets(component, group)
+	return mgr.hashReferencedObjects(ctx, configMaps, secrets)
+}
+
+func (mgr *risingWaveControllerManagerImpl) injectReferencedObjectsHash(ctx context.Context, component, group string, obj client.Object) error {
+	hash, err := mgr.referencedObjectsHashOfGroup(ctx, component, group)
+	if err != nil {
+		return err
+	}
+
+	template := getPodTemplateOfWorkload(obj)
+	if template.Annotations == nil {
+		template.Annotations = make(map[string]string)
+	}
+	template.Annotations[consts.AnnotationReferencedObjectsHash] = hash
+
+	return nil
+}
+
+// isReferencedObjectsHashSynced tells whether the hash of referenced objects in the Pod template of the workload
+// is the same as the current one. Workloads without the hash are created by old versions of the operator and are
+// considered to be synced, so that they won't be restarted unexpectedly.
+func (mgr *risingWaveControllerManagerImpl) isReferencedObjectsHashSynced(ctx context.Context, component string, obj client.Object) (bool, error) {
+	observedHash, ok := getPodTemplateOfWorkload(obj).Annotations[consts.AnnotationReferencedObjectsHash]
+	if !ok {
+		return true, nil
+	}
+
+	hash, err := mgr.referencedObjectsHashOfGroup(ctx, component, obj.GetLabels()[consts.LabelRisingWaveGroup])
+	if err != nil {
+		return false, err
+	}
+
+	return observedHash == hash, nil
+}
+
+func (mgr *risingWaveControllerManagerImpl) isWorkloadSynced(ctx context.Context, component string, obj client.Object) bool {
+	if !mgr.isObjectSynced(obj) {
+		return false
+	}
+	hashSynced, err := mgr.isReferencedObjectsHashSynced(ctx, component, obj)
+	return err == nil && hashSynced
+}
+
 func ensureTheSameObject(obj, newObj client.Object) client.Object {
 	// Ensure that they are the same object in Kubernetes.
 	if !isObjectNil(obj) && !isObjectNil(newObj) {
@@ -790,43 +1278,60 @@ func (mgr *risingWaveControllerManagerImpl) syncObject(ctx context.Context, obj
 		return mgr.client.Create(ctx, newObj)
 	}
 
-	gvk, err := apiutil.GVKForObject(obj, scheme)
-	if err != nil {
-		return err
-	}
-
 	// Found. Update/Sync if not synced.
 	if !mgr.isObjectSynced(obj) {
 		newObj, err := factory()
 		if err != nil {
 			return fmt.Errorf("unable to build new object: %w", err)
 		}
-		newObj = ensureTheSameObject(obj, newObj)
-		// Set the resource version for update.
-		newObj.SetResourceVersion(obj.GetResourceVersion())
-		logger.Info(fmt.Sprintf("Update the object of %s", gvk.Kind), "object", utils.GetNamespacedName(newObj),
-			"generation", mgr.risingwaveManager.RisingWave().Generation)
-		if err = mgr.client.Update(ctx, newObj); err == nil {
-			return nil
-		}
-		if !apierrors.IsInvalid(err) {
-			return err
-		}
-		if !mgr.forceUpdateEnabled ||
-			obj.GetLabels()[consts.LabelRisingWaveOperatorVersion] == newObj.GetLabels()[consts.LabelRisingWaveOperatorVersion] {
-			return err
-		}
-		if err := mgr.client.Delete(ctx, obj); err != nil {
-			return err
-		}
-		newObj.SetResourceVersion("")
-		if err := mgr.client.Create(ctx, newObj); err != nil {
-			return err
-		}
+		return mgr.updateObject(ctx, obj, newObj, logger)
 	}
 	return nil
 }
 
+func (mgr *risingWaveControllerManagerImpl) updateObject(ctx context.Context, obj, newObj client.Object, logger logr.Logger) error {
+	gvk, err := apiutil.GVKForObject(obj, mgr.client.Scheme())
+	if err != nil {
+		return err
+	}
+
+	newObj = ensureTheSameObject(obj, newObj)
+	// Set the resource version for update.
+	newObj.SetResourceVersion(obj.GetResourceVersion())
+	logger.Info(fmt.Sprintf("Update the object of %s", gvk.Kind), "object", utils.GetNamespacedName(newObj),
+		"generation", mgr.risingwaveManager.RisingWave().Generation)
+	if err = mgr.client.Update(ctx, newObj); err == nil {
+		return nil
+	}
+	if !apierrors.IsInvalid(err) {
+		return err
+	}
+	if !mgr.forceUpdateEnabled ||
+		obj.GetLabels()[consts.LabelRisingWaveOperatorVersion] == newObj.GetLabels()[consts.LabelRisingWaveOperatorVersion] {
+		return err
+	}
+	if err := mgr.client.Delete(ctx, obj); err != nil {
+		return err
+	}
+	newObj.SetResourceVersion("")
+	return mgr.client.Create(ctx, newObj)
+}
+
+// deleteObject deletes the object if it exists.
+func (mgr *risingWaveControllerManagerImpl) deleteObject(ctx context.Context, obj client.Object, logger logr.Logger) error {
+	if isObjectNil(obj) {
+		return nil
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, mgr.client.Scheme())
+	if err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("Delete the object of %s", gvk.Kind), "object", utils.GetNamespacedName(obj))
+	return client.IgnoreNotFound(mgr.client.Delete(ctx, obj))
+}
+
 // Helper function for compile time type assertion.
 func syncObject[T client.Object](mgr *risingWaveControllerManagerImpl, ctx context.Context, obj T, factory func() T, logger logr.Logger) error {
 	return mgr.syncObject(ctx, obj, func() (client.Object, error) {
@@ -864,6 +1369,151 @@ func (mgr *risingWaveControllerManagerImpl) SyncMetaService(ctx context.Context,
 	return ctrlkit.RequeueIfErrorAndWrap("unable to sync meta service", err)
 }
 
+// SyncMetaLeaderService implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncMetaLeaderService(ctx context.Context, logger logr.Logger, metaLeaderService *corev1.Service) (reconcile.Result, error) {
+	if mgr.risingwaveManager.RisingWave().Spec.MetaLeaderService == nil {
+		err := mgr.deleteObject(ctx, metaLeaderService, logger)
+		return ctrlkit.RequeueIfErrorAndWrap("unable to delete meta leader service", err)
+	}
+	err := syncObject(mgr, ctx, metaLeaderService, mgr.objectFactory.NewMetaLeaderService, logger)
+	return ctrlkit.RequeueIfErrorAndWrap("unable to sync meta leader service", err)
+}
+
+// SyncMetaDashboardIngress implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncMetaDashboardIngress(ctx context.Context, logger logr.Logger, metaDashboardIngress *networkingv1.Ingress) (reconcile.Result, error) {
+	metaLeaderService := mgr.risingwaveManager.RisingWave().Spec.MetaLeaderService
+	if metaLeaderService == nil || metaLeaderService.DashboardIngress == nil {
+		err := mgr.deleteObject(ctx, metaDashboardIngress, logger)
+		return ctrlkit.RequeueIfErrorAndWrap("unable to delete meta dashboard ingress", err)
+	}
+	err := syncObject(mgr, ctx, metaDashboardIngress, mgr.objectFactory.NewMetaDashboardIngress, logger)
+	return ctrlkit.RequeueIfErrorAndWrap("unable to sync meta dashboard ingress", err)
+}
+
+// SyncEtcdService implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncEtcdService(ctx context.Context, logger logr.Logger, etcdService *corev1.Service) (reconcile.Result, error) {
+	err := syncObject(mgr, ctx, etcdService, mgr.objectFactory.NewEtcdService, logger)
+	return ctrlkit.RequeueIfErrorAndWrap("unable to sync etcd service", err)
+}
+
+// SyncEtcdStatefulSet implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncEtcdStatefulSet(ctx context.Context, logger logr.Logger, etcdStatefulSet *appsv1.StatefulSet) (reconcile.Result, error) {
+	err := syncObject(mgr, ctx, etcdStatefulSet, mgr.objectFactory.NewEtcdStatefulSet, logger)
+	return ctrlkit.RequeueIfErrorAndWrap("unable to sync etcd statefulset", err)
+}
+
+// WaitBeforeEtcdStatefulSetReady implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) WaitBeforeEtcdStatefulSetReady(ctx context.Context, logger logr.Logger, etcdStatefulSet *appsv1.StatefulSet) (reconcile.Result, error) {
+	if mgr.isObjectSynced(etcdStatefulSet) && utils.IsStatefulSetRolledOut(etcdStatefulSet) {
+		return ctrlkit.Continue()
+	}
+	logger.Info("Etcd StatefulSet hasn't been ready")
+	return ctrlkit.Exit()
+}
+
+// SyncMinIOService implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncMinIOService(ctx context.Context, logger logr.Logger, minioService *corev1.Service) (reconcile.Result, error) {
+	err := syncObject(mgr, ctx, minioService, mgr.objectFactory.NewMinIOService, logger)
+	return ctrlkit.RequeueIfErrorAndWrap("unable to sync minio service", err)
+}
+
+// SyncMinIOCredentialsSecret implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncMinIOCredentialsSecret(ctx context.Context, logger logr.Logger, minioCredentialsSecret *corev1.Secret) (reconcile.Result, error) {
+	// The generated credentials must be kept once created, so never update the secret.
+	if minioCredentialsSecret != nil {
+		return ctrlkit.Continue()
+	}
+
+	err := syncObject(mgr, ctx, minioCredentialsSecret, func() *corev1.Secret {
+		return mgr.objectFactory.NewMinIOCredentialsSecret(rand.String(minioPasswordLength))
+	}, logger)
+	return ctrlkit.RequeueIfErrorAndWrap("unable to sync minio credentials secret", err)
+}
+
+// SyncMinIOStatefulSet implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncMinIOStatefulSet(ctx context.Context, logger logr.Logger, minioStatefulSet *appsv1.StatefulSet) (reconcile.Result, error) {
+	err := syncObject(mgr, ctx, minioStatefulSet, mgr.objectFactory.NewMinIOStatefulSet, logger)
+	return ctrlkit.RequeueIfErrorAndWrap("unable to sync minio statefulset", err)
+}
+
+// WaitBeforeMinIOStatefulSetReady implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) WaitBeforeMinIOStatefulSetReady(ctx context.Context, logger logr.Logger, minioStatefulSet *appsv1.StatefulSet) (reconcile.Result, error) {
+	if mgr.isObjectSynced(minioStatefulSet) && utils.IsStatefulSetRolledOut(minioStatefulSet) {
+		return ctrlkit.Continue()
+	}
+	logger.Info("MinIO StatefulSet hasn't been ready")
+	return ctrlkit.Exit()
+}
+
+// SyncMinIOBucketJob implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncMinIOBucketJob(ctx context.Context, logger logr.Logger, minioBucketJob *batchv1.Job) (reconcile.Result, error) {
+	// The pod template of a Job is immutable, and the bucket only needs to be created once.
+	if minioBucketJob != nil {
+		return ctrlkit.Continue()
+	}
+
+	err := syncObject(mgr, ctx, minioBucketJob, mgr.objectFactory.NewMinIOBucketJob, logger)
+	return ctrlkit.RequeueIfErrorAndWrap("unable to sync minio bucket job", err)
+}
+
+// WaitBeforeMinIOBucketJobCompleted implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) WaitBeforeMinIOBucketJobCompleted(ctx context.Context, logger logr.Logger, minioBucketJob *batchv1.Job) (reconcile.Result, error) {
+	if utils.IsJobCompleted(minioBucketJob) {
+		return ctrlkit.Continue()
+	}
+	if utils.IsJobFailed(minioBucketJob) {
+		logger.Info("MinIO bucket job has failed, delete it to retry", "job", utils.GetNamespacedName(minioBucketJob))
+	} else {
+		logger.Info("MinIO bucket hasn't been created")
+	}
+	return ctrlkit.Exit()
+}
+
+// SyncMetaRestoreJob implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncMetaRestoreJob(ctx context.Context, logger logr.Logger, metaRestoreJob *batchv1.Job) (reconcile.Result, error) {
+	// The pod template of a Job is immutable, and the meta store only needs to be restored once.
+	if metaRestoreJob != nil {
+		return ctrlkit.Continue()
+	}
+
+	err := syncObject(mgr, ctx, metaRestoreJob, mgr.objectFactory.NewMetaRestoreJob, logger)
+	return ctrlkit.RequeueIfErrorAndWrap("unable to sync meta restore job", err)
+}
+
+// WaitBeforeMetaRestoreJobCompleted implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) WaitBeforeMetaRestoreJobCompleted(ctx context.Context, logger logr.Logger, metaRestoreJob *batchv1.Job) (reconcile.Result, error) {
+	snapshotID := mgr.risingwaveManager.RisingWave().Spec.RestoreFrom.SnapshotID
+
+	if utils.IsJobCompleted(metaRestoreJob) {
+		mgr.risingwaveManager.UpdateCondition(risingwavev1alpha1.RisingWaveCondition{
+			Type:    risingwavev1alpha1.RisingWaveConditionRestored,
+			Status:  metav1.ConditionTrue,
+			Reason:  "Restored",
+			Message: fmt.Sprintf("Meta store restored from snapshot %d", snapshotID),
+		})
+		return ctrlkit.Continue()
+	}
+
+	if utils.IsJobFailed(metaRestoreJob) {
+		logger.Info("Meta restore job has failed, delete it to retry", "job", utils.GetNamespacedName(metaRestoreJob))
+		mgr.risingwaveManager.UpdateCondition(risingwavev1alpha1.RisingWaveCondition{
+			Type:    risingwavev1alpha1.RisingWaveConditionRestored,
+			Status:  metav1.ConditionFalse,
+			Reason:  "RestoreFailed",
+			Message: fmt.Sprintf("Job %s failed to restore the meta store from snapshot %d, delete it to retry", metaRestoreJob.Name, snapshotID),
+		})
+	} else {
+		logger.Info("Meta store hasn't been restored")
+		mgr.risingwaveManager.UpdateCondition(risingwavev1alpha1.RisingWaveCondition{
+			Type:    risingwavev1alpha1.RisingWaveConditionRestored,
+			Status:  metav1.ConditionFalse,
+			Reason:  "Restoring",
+			Message: fmt.Sprintf("Restoring the meta store from snapshot %d", snapshotID),
+		})
+	}
+	return ctrlkit.Exit()
+}
+
 // WaitBeforeMetaServiceIsAvailable implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) WaitBeforeMetaServiceIsAvailable(ctx context.Context, logger logr.Logger, metaService *corev1.Service) (reconcile.Result, error) {
 	if mgr.isObjectSynced(metaService) {
@@ -875,18 +1525,329 @@ func (mgr *risingWaveControllerManagerImpl) WaitBeforeMetaServiceIsAvailable(ctx
 
 // SyncConfigConfigMap implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) SyncConfigConfigMap(ctx context.Context, logger logr.Logger, configConfigMap *corev1.ConfigMap) (reconcile.Result, error) {
-	err := syncObject(mgr, ctx, configConfigMap, func() *corev1.ConfigMap {
-		return mgr.objectFactory.NewConfigConfigMap("")
-	}, logger)
+	configMaps := make(map[string]*corev1.ConfigMap)
+	for _, name := range mgr.objectFactory.ConfigMapsToMerge() {
+		var cm corev1.ConfigMap
+		err := mgr.client.Get(ctx, types.NamespacedName{Namespace: mgr.risingwaveManager.RisingWave().Namespace, Name: name}, &cm)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return ctrlkit.RequeueIfErrorAndWrap("unable to get configmap to merge", err)
+		}
+		configMaps[name] = &cm
+	}
+
+	newObj, err := mgr.objectFactory.NewConfigConfigMap(configMaps)
+	if err != nil {
+		return ctrlkit.RequeueIfErrorAndWrap("unable to render config configmap", err)
+	}
+
+	// The rendered configurations depend on the referenced ConfigMaps besides the spec, so compare the contents.
+	if configConfigMap != nil && !equality.Semantic.DeepEqual(configConfigMap.Data, newObj.Data) {
+		err = mgr.updateObject(ctx, configConfigMap, newObj, logger)
+	} else {
+		err = syncObject(mgr, ctx, configConfigMap, func() *corev1.ConfigMap { return newObj }, logger)
+	}
 	return ctrlkit.RequeueIfErrorAndWrap("unable to sync config configmap", err)
 }
 
+// CollectReferencedObjectsHash implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) CollectReferencedObjectsHash(ctx context.Context, logger logr.Logger) (reconcile.Result, error) {
+	groupHashes := make(map[string]string)
+	for _, component := range []string{
+		consts.ComponentMeta,
+		consts.ComponentCompute,
+		consts.ComponentCompactor,
+		consts.ComponentFrontend,
+		consts.ComponentConnector,
+	} {
+		for _, nodeGroup := range mgr.risingwaveManager.GetNodeGroups(component) {
+			hash, err := mgr.referencedObjectsHashOfGroup(ctx, component, nodeGroup.Name)
+			if err != nil {
+				return ctrlkit.RequeueIfErrorAndWrap("unable to hash referenced objects", err)
+			}
+			groupHashes[component+"/"+nodeGroup.Name] = hash
+		}
+	}
+
+	hash, err := utils.HashObject(groupHashes)
+	if err != nil {
+		return ctrlkit.RequeueIfErrorAndWrap("unable to hash referenced objects", err)
+	}
+	mgr.risingwaveManager.SetReferencedObjectsHash(hash)
+
+	return ctrlkit.Continue()
+}
+
 // SyncServiceMonitor implements RisingWaveControllerManagerImpl.
 func (mgr *risingWaveControllerManagerImpl) SyncServiceMonitor(ctx context.Context, logger logr.Logger, serviceMonitor *monitoringv1.ServiceMonitor) (reconcile.Result, error) {
 	err := syncObject(mgr, ctx, serviceMonitor, mgr.objectFactory.NewServiceMonitor, logger)
 	return ctrlkit.RequeueIfErrorAndWrap("unable to sync service monitor", err)
 }
 
+// componentWorkload is a workload object of a component.
+type componentWorkload struct {
+	component string
+	obj       client.Object
+}
+
+// listComponentWorkloads lists the workloads of all components owned by the RisingWave, either the built-in ones
+// or the OpenKruise ones depending on whether OpenKruise is enabled.
+func (mgr *risingWaveControllerManagerImpl) listComponentWorkloads(ctx context.Context) ([]componentWorkload, error) {
+	risingwave := mgr.risingwaveManager.RisingWave()
+	openKruiseEnabled := mgr.risingwaveManager.IsOpenKruiseEnabled()
+
+	var workloads []componentWorkload
+	for _, component := range []string{
+		consts.ComponentMeta,
+		consts.ComponentCompute,
+		consts.ComponentCompactor,
+		consts.ComponentConnector,
+		consts.ComponentFrontend,
+	} {
+		isStateful := component == consts.ComponentMeta || component == consts.ComponentCompute
+
+		var list client.ObjectList
+		switch {
+		case openKruiseEnabled && isStateful:
+			list = &kruiseappsv1beta1.StatefulSetList{}
+		case openKruiseEnabled:
+			list = &kruiseappsv1alpha1.CloneSetList{}
+		case isStateful:
+			list = &appsv1.StatefulSetList{}
+		default:
+			list = &appsv1.DeploymentList{}
+		}
+
+		if err := mgr.client.List(ctx, list, client.InNamespace(risingwave.Namespace), client.MatchingLabels{
+			consts.LabelRisingWaveName:      risingwave.Name,
+			consts.LabelRisingWaveComponent: component,
+		}); err != nil {
+			return nil, fmt.Errorf("unable to list workloads of %s: %w", component, err)
+		}
+
+		objs, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			obj := obj.(client.Object)
+			if ctrlkit.ValidateOwnership(obj, risingwave) {
+				workloads = append(workloads, componentWorkload{component: component, obj: obj})
+			}
+		}
+	}
+
+	return workloads, nil
+}
+
+func isWorkloadRolledOut(obj client.Object) bool {
+	switch obj := obj.(type) {
+	case *appsv1.Deployment:
+		return utils.IsDeploymentRolledOut(obj)
+	case *appsv1.StatefulSet:
+		return utils.IsStatefulSetRolledOut(obj)
+	case *kruiseappsv1alpha1.CloneSet:
+		return utils.IsCloneSetRolledOut(obj)
+	case *kruiseappsv1beta1.StatefulSet:
+		return utils.IsAdvancedStatefulSetRolledOut(obj)
+	default:
+		panic(fmt.Sprintf("unsupported workload type: %T", obj))
+	}
+}
+
+// revisionKeyOfWorkload returns the key of the workload's pod template in the revision ConfigMap.
+func revisionKeyOfWorkload(obj client.Object) string {
+	switch obj.(type) {
+	case *appsv1.Deployment:
+		return "Deployment." + obj.GetName()
+	case *appsv1.StatefulSet:
+		return "StatefulSet." + obj.GetName()
+	case *kruiseappsv1alpha1.CloneSet:
+		return "CloneSet." + obj.GetName()
+	case *kruiseappsv1beta1.StatefulSet:
+		return "AdvancedStatefulSet." + obj.GetName()
+	default:
+		panic(fmt.Sprintf("unsupported workload type: %T", obj))
+	}
+}
+
+// progressDeadlineOfWorkload returns the progress deadline of the workload's rollout. The one of the upgrade policy
+// overrides the one of the node group. False is returned when the node group has been removed from the spec.
+func (mgr *risingWaveControllerManagerImpl) progressDeadlineOfWorkload(w componentWorkload) (time.Duration, bool) {
+	nodeGroup := mgr.risingwaveManager.GetNodeGroup(w.component, w.obj.GetLabels()[consts.LabelRisingWaveGroup])
+	if nodeGroup == nil {
+		return 0, false
+	}
+
+	progressDeadlineSeconds := mgr.risingwaveManager.RisingWave().Spec.UpgradePolicy.ProgressDeadlineSeconds
+	if progressDeadlineSeconds == nil {
+		progressDeadlineSeconds = nodeGroup.ProgressDeadlineSeconds
+	}
+	return time.Duration(pointer.Int32Deref(progressDeadlineSeconds, 600)) * time.Second, true
+}
+
+// CheckRolloutProgressDeadline implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) CheckRolloutProgressDeadline(ctx context.Context, logger logr.Logger) (reconcile.Result, error) {
+	risingwave := mgr.risingwaveManager.RisingWave()
+
+	// Keep the failed state until the spec is changed.
+	if mgr.risingwaveManager.IsRolloutFailed() {
+		return ctrlkit.Continue()
+	}
+
+	// The rollout of the current generation starts in this reconciliation if it isn't recorded.
+	startTime := metav1.Now()
+	if rollout := risingwave.Status.Rollout; rollout != nil && rollout.Generation == risingwave.Generation {
+		startTime = rollout.StartTime
+	}
+	// The soak time of the canaries doesn't count.
+	elapsed := time.Since(startTime.Time) - mgr.risingwaveManager.CanarySoakTime()
+
+	workloads, err := mgr.listComponentWorkloads(ctx)
+	if err != nil {
+		return ctrlkit.RequeueIfErrorAndWrap("unable to list workloads", err)
+	}
+
+	var notRolledOut []string
+	var requeueAfter time.Duration
+	for _, w := range workloads {
+		if mgr.isWorkloadSynced(ctx, w.component, w.obj) && isWorkloadRolledOut(w.obj) {
+			continue
+		}
+
+		deadline, ok := mgr.progressDeadlineOfWorkload(w)
+		if !ok {
+			continue
+		}
+		if elapsed < deadline {
+			if requeueAfter == 0 || deadline-elapsed < requeueAfter {
+				requeueAfter = deadline - elapsed
+			}
+			continue
+		}
+		notRolledOut = append(notRolledOut, w.obj.GetName())
+	}
+	if len(notRolledOut) == 0 {
+		if requeueAfter > 0 {
+			return ctrlkit.RequeueAfter(requeueAfter)
+		}
+		return ctrlkit.Exit()
+	}
+
+	logger.Info("Rollout has exceeded the progress deadline", "generation", risingwave.Generation, "workloads", notRolledOut)
+	message := fmt.Sprintf("Generation %d isn't rolled out before the progress deadline, workloads not ready: %s",
+		risingwave.Generation, strings.Join(notRolledOut, ","))
+	mgr.risingwaveManager.UpdateCondition(risingwavev1alpha1.RisingWaveCondition{
+		Type:    risingwavev1alpha1.RisingWaveConditionFailed,
+		Status:  metav1.ConditionTrue,
+		Reason:  "ProgressDeadlineExceeded",
+		Message: message,
+	})
+	mgr.risingwaveManager.UpdateStatus(func(status *risingwavev1alpha1.RisingWaveStatus) {
+		status.Rollout = &risingwavev1alpha1.RisingWaveRolloutStatus{
+			Generation: risingwave.Generation,
+			StartTime:  startTime,
+			Failed:     true,
+		}
+	})
+	mgr.eventMessageStore.SetMessage(consts.RisingWaveEventTypeUpgradeFailed.Name, message)
+
+	return ctrlkit.Continue()
+}
+
+// SyncRevisionConfigMap implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) SyncRevisionConfigMap(ctx context.Context, logger logr.Logger, revisionConfigMap *corev1.ConfigMap) (reconcile.Result, error) {
+	workloads, err := mgr.listComponentWorkloads(ctx)
+	if err != nil {
+		return ctrlkit.RequeueIfErrorAndWrap("unable to list workloads", err)
+	}
+
+	podTemplates := make(map[string]string)
+	for _, w := range workloads {
+		podTemplate, err := json.Marshal(getPodTemplateOfWorkload(w.obj))
+		if err != nil {
+			return ctrlkit.RequeueIfErrorAndWrap("unable to marshal pod template", err)
+		}
+		podTemplates[revisionKeyOfWorkload(w.obj)] = string(podTemplate)
+	}
+
+	newRevisionConfigMap := mgr.objectFactory.NewRevisionConfigMap(podTemplates)
+	if revisionConfigMap == nil {
+		logger.Info("Create the revision ConfigMap", "configmap", utils.GetNamespacedName(newRevisionConfigMap))
+		err = mgr.client.Create(ctx, newRevisionConfigMap)
+	} else if !equality.Semantic.DeepEqual(revisionConfigMap.Data, newRevisionConfigMap.Data) {
+		err = mgr.updateObject(ctx, revisionConfigMap, newRevisionConfigMap, logger)
+	}
+	return ctrlkit.RequeueIfErrorAndWrap("unable to sync revision configmap", err)
+}
+
+// RollbackWorkloads implements RisingWaveControllerManagerImpl.
+func (mgr *risingWaveControllerManagerImpl) RollbackWorkloads(ctx context.Context, logger logr.Logger, revisionConfigMap *corev1.ConfigMap) (reconcile.Result, error) {
+	// Roll back at most once per failed generation.
+	if !mgr.risingwaveManager.IsRolloutFailed() || mgr.risingwaveManager.IsRolloutRolledBack() {
+		return ctrlkit.Continue()
+	}
+
+	var rolledBack []string
+	if revisionConfigMap == nil {
+		logger.Info("No known-good revision recorded, skip rolling back")
+	} else {
+		workloads, err := mgr.listComponentWorkloads(ctx)
+		if err != nil {
+			return ctrlkit.RequeueIfErrorAndWrap("unable to list workloads", err)
+		}
+
+		generation := strconv.FormatInt(mgr.risingwaveManager.RisingWave().Generation, 10)
+		for _, w := range workloads {
+			// Workloads of the new node groups don't have a known-good revision. Leave them as they are.
+			data, ok := revisionConfigMap.Data[revisionKeyOfWorkload(w.obj)]
+			if !ok {
+				continue
+			}
+
+			var podTemplate corev1.PodTemplateSpec
+			if err := json.Unmarshal([]byte(data), &podTemplate); err != nil {
+				logger.Error(err, "Failed to unmarshal the pod template, skip", "workload", w.obj.GetName())
+				continue
+			}
+			if equality.Semantic.DeepEqual(getPodTemplateOfWorkload(w.obj), &podTemplate) {
+				continue
+			}
+
+			// Label the reverted workload with the current generation so that it's considered as synced and
+			// kept as it is until the spec is changed.
+			newObj := w.obj.DeepCopyObject().(client.Object)
+			*getPodTemplateOfWorkload(newObj) = podTemplate
+			labels := newObj.GetLabels()
+			if labels == nil {
+				labels = make(map[string]string)
+			}
+			labels[consts.LabelRisingWaveGeneration] = generation
+			newObj.SetLabels(labels)
+
+			if err := mgr.updateObject(ctx, w.obj, newObj, logger); err != nil {
+				return ctrlkit.RequeueIfErrorAndWrap("unable to roll back workload "+w.obj.GetName(), err)
+			}
+			logger.Info("Workload rolled back to the last known-good revision", "workload", w.obj.GetName())
+			rolledBack = append(rolledBack, w.obj.GetName())
+		}
+	}
+
+	mgr.risingwaveManager.UpdateStatus(func(status *risingwavev1alpha1.RisingWaveStatus) {
+		if status.Rollout != nil {
+			status.Rollout.RolledBack = true
+		}
+	})
+	if len(rolledBack) > 0 {
+		mgr.eventMessageStore.SetMessage(consts.RisingWaveEventTypeRolledBack.Name,
+			fmt.Sprintf("Rolled back workloads to the last known-good revision: %s", strings.Join(rolledBack, ",")))
+	}
+
+	return ctrlkit.Continue()
+}
+
 func newRisingWaveControllerManagerImpl(client client.Client, risingwaveManager *object.RisingWaveManager, messageStore *event.MessageStore, forceUpdateEnabled bool, operatorVersion string) *risingWaveControllerManagerImpl {
 	return &risingWaveControllerManagerImpl{
 		client:             client,
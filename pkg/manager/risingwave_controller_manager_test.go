@@ -20,7 +20,9 @@ import (
 	"context"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	kruiseappsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
@@ -28,10 +30,16 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/samber/lo"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
 	"k8s.io/utils/strings/slices"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,8 +51,10 @@ import (
 
 	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
 	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+	"github.com/risingwavelabs/risingwave-operator/pkg/factory"
 	"github.com/risingwavelabs/risingwave-operator/pkg/object"
 	"github.com/risingwavelabs/risingwave-operator/pkg/testutils"
+	"github.com/risingwavelabs/risingwave-operator/pkg/utils"
 )
 
 func newRisingWaveControllerManagerImplForTest(risingwave *risingwavev1alpha1.RisingWave, objects ...client.Object) *risingWaveControllerManagerImpl {
@@ -396,6 +406,281 @@ func TestRisingWaveControllerManagerImpl_SyncMetaService(t *testing.T) {
 	)
 }
 
+func TestRisingWaveControllerManagerImpl_SyncMetaLeaderServiceAndDashboardIngress(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.MetaLeaderService = &risingwavev1alpha1.RisingWaveMetaLeaderService{
+		DashboardIngress: &risingwavev1alpha1.RisingWaveMetaDashboardIngress{},
+	}
+	svcKey := types.NamespacedName{Namespace: risingwave.Namespace, Name: risingwave.Name + "-meta-leader"}
+	ingressKey := types.NamespacedName{Namespace: risingwave.Namespace, Name: risingwave.Name + "-meta-dashboard"}
+
+	// Created when enabled.
+	managerImpl := newRisingWaveControllerManagerImplForTest(risingwave)
+	if _, err := managerImpl.SyncMetaLeaderService(context.Background(), logr.Discard(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := managerImpl.SyncMetaDashboardIngress(context.Background(), logr.Discard(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var svc corev1.Service
+	if err := managerImpl.client.Get(context.Background(), svcKey, &svc); err != nil {
+		t.Fatal(err)
+	}
+	if svc.Spec.Selector[consts.LabelRisingWaveMetaRole] != consts.MetaRoleLeader {
+		t.Fatalf("meta leader service doesn't select the leader: %v", svc.Spec.Selector)
+	}
+	var ingress networkingv1.Ingress
+	if err := managerImpl.client.Get(context.Background(), ingressKey, &ingress); err != nil {
+		t.Fatal(err)
+	}
+
+	// Deleted when disabled.
+	risingwave.Spec.MetaLeaderService = nil
+	managerImpl = newRisingWaveControllerManagerImplForTest(risingwave, &svc, &ingress)
+	if _, err := managerImpl.SyncMetaLeaderService(context.Background(), logr.Discard(), &svc); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := managerImpl.SyncMetaDashboardIngress(context.Background(), logr.Discard(), &ingress); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := managerImpl.client.Get(context.Background(), svcKey, &corev1.Service{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("meta leader service should be deleted, err: %v", err)
+	}
+	if err := managerImpl.client.Get(context.Background(), ingressKey, &networkingv1.Ingress{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("meta dashboard ingress should be deleted, err: %v", err)
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_SyncEtcdService(t *testing.T) {
+	fakeRisingwave := testutils.FakeRisingWave()
+
+	key := types.NamespacedName{Namespace: fakeRisingwave.Namespace, Name: fakeRisingwave.Name + "-etcd"}
+	testRisingWaveControllerManagerImplSyncSingleObject(t, key,
+		func(managerImpl *risingWaveControllerManagerImpl, ctx context.Context, logger logr.Logger, obj *corev1.Service) (ctrl.Result, error) {
+			return managerImpl.SyncEtcdService(ctx, logger, obj)
+		},
+	)
+}
+
+func TestRisingWaveControllerManagerImpl_WaitBeforeEtcdStatefulSetReady(t *testing.T) {
+	fakeRisingwave := testutils.FakeRisingWave()
+
+	newEtcdStatefulSet := func(generation int64, ready bool) *appsv1.StatefulSet {
+		sts := &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       fakeRisingwave.Name + "-etcd",
+				Namespace:  fakeRisingwave.Namespace,
+				Generation: 1,
+				Labels: map[string]string{
+					consts.LabelRisingWaveGeneration: strconv.FormatInt(generation, 10),
+				},
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: lo.ToPtr(int32(3)),
+			},
+			Status: appsv1.StatefulSetStatus{
+				ObservedGeneration: 1,
+				Replicas:           3,
+				UpdatedReplicas:    3,
+				AvailableReplicas:  3,
+				ReadyReplicas:      3,
+			},
+		}
+		if !ready {
+			sts.Status.ReadyReplicas = 1
+			sts.Status.AvailableReplicas = 1
+		}
+		return sts
+	}
+
+	testcases := map[string]struct {
+		etcdStatefulSet *appsv1.StatefulSet
+		exit            bool
+	}{
+		"not-found": {
+			etcdStatefulSet: nil,
+			exit:            true,
+		},
+		"not-synced": {
+			etcdStatefulSet: newEtcdStatefulSet(fakeRisingwave.Generation-1, true),
+			exit:            true,
+		},
+		"not-ready": {
+			etcdStatefulSet: newEtcdStatefulSet(fakeRisingwave.Generation, false),
+			exit:            true,
+		},
+		"ready": {
+			etcdStatefulSet: newEtcdStatefulSet(fakeRisingwave.Generation, true),
+			exit:            false,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			managerImpl := newRisingWaveControllerManagerImplForTest(fakeRisingwave)
+			_, err := managerImpl.WaitBeforeEtcdStatefulSetReady(context.Background(), logr.Discard(), tc.etcdStatefulSet)
+			if tc.exit != (err == ctrlkit.ErrExit) {
+				t.Fatalf("unexpected result, expect exit: %v, err: %v", tc.exit, err)
+			}
+		})
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_SyncMinIOService(t *testing.T) {
+	fakeRisingwave := testutils.FakeRisingWave()
+
+	key := types.NamespacedName{Namespace: fakeRisingwave.Namespace, Name: fakeRisingwave.Name + "-minio"}
+	testRisingWaveControllerManagerImplSyncSingleObject(t, key,
+		func(managerImpl *risingWaveControllerManagerImpl, ctx context.Context, logger logr.Logger, obj *corev1.Service) (ctrl.Result, error) {
+			return managerImpl.SyncMinIOService(ctx, logger, obj)
+		},
+	)
+}
+
+func TestRisingWaveControllerManagerImpl_SyncMinIOCredentialsSecret(t *testing.T) {
+	fakeRisingwave := testutils.FakeRisingWave()
+	key := types.NamespacedName{Namespace: fakeRisingwave.Namespace, Name: fakeRisingwave.Name + "-minio-credentials"}
+
+	// Create the secret with generated credentials.
+	managerImpl := newRisingWaveControllerManagerImplForTest(fakeRisingwave)
+	_, err := managerImpl.SyncMinIOCredentialsSecret(context.Background(), logr.Discard(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var secret corev1.Secret
+	if err := managerImpl.client.Get(context.Background(), key, &secret); err != nil {
+		t.Fatal(err)
+	}
+	if len(secret.Data[consts.SecretKeyMinIOUsername]) == 0 || len(secret.Data[consts.SecretKeyMinIOPassword]) < 8 {
+		t.Fatal("invalid credentials generated", secret.Data)
+	}
+
+	// The existing secret must not be changed.
+	_, err = managerImpl.SyncMinIOCredentialsSecret(context.Background(), logr.Discard(), &secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var current corev1.Secret
+	if err := managerImpl.client.Get(context.Background(), key, &current); err != nil {
+		t.Fatal(err)
+	}
+	if !equality.Semantic.DeepEqual(secret.Data, current.Data) {
+		t.Fatal("credentials changed")
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_WaitBeforeMinIOBucketJobCompleted(t *testing.T) {
+	fakeRisingwave := testutils.FakeRisingWave()
+
+	newBucketJob := func(conditions ...batchv1.JobCondition) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fakeRisingwave.Name + "-minio-bucket",
+				Namespace: fakeRisingwave.Namespace,
+			},
+			Status: batchv1.JobStatus{
+				Conditions: conditions,
+			},
+		}
+	}
+
+	testcases := map[string]struct {
+		bucketJob *batchv1.Job
+		exit      bool
+	}{
+		"not-found": {
+			bucketJob: nil,
+			exit:      true,
+		},
+		"running": {
+			bucketJob: newBucketJob(),
+			exit:      true,
+		},
+		"failed": {
+			bucketJob: newBucketJob(batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}),
+			exit:      true,
+		},
+		"completed": {
+			bucketJob: newBucketJob(batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}),
+			exit:      false,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			managerImpl := newRisingWaveControllerManagerImplForTest(fakeRisingwave)
+			_, err := managerImpl.WaitBeforeMinIOBucketJobCompleted(context.Background(), logr.Discard(), tc.bucketJob)
+			if tc.exit != (err == ctrlkit.ErrExit) {
+				t.Fatalf("unexpected result, expect exit: %v, err: %v", tc.exit, err)
+			}
+		})
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_WaitBeforeMetaRestoreJobCompleted(t *testing.T) {
+	fakeRisingwave := testutils.FakeRisingWave()
+	fakeRisingwave.Spec.RestoreFrom = &risingwavev1alpha1.RisingWaveRestoreFrom{SnapshotID: 1}
+
+	newRestoreJob := func(conditions ...batchv1.JobCondition) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fakeRisingwave.Name + "-meta-restore",
+				Namespace: fakeRisingwave.Namespace,
+			},
+			Status: batchv1.JobStatus{
+				Conditions: conditions,
+			},
+		}
+	}
+
+	testcases := map[string]struct {
+		restoreJob *batchv1.Job
+		exit       bool
+		reason     string
+	}{
+		"not-found": {
+			restoreJob: nil,
+			exit:       true,
+			reason:     "Restoring",
+		},
+		"running": {
+			restoreJob: newRestoreJob(),
+			exit:       true,
+			reason:     "Restoring",
+		},
+		"failed": {
+			restoreJob: newRestoreJob(batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}),
+			exit:       true,
+			reason:     "RestoreFailed",
+		},
+		"completed": {
+			restoreJob: newRestoreJob(batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}),
+			exit:       false,
+			reason:     "Restored",
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			managerImpl := newRisingWaveControllerManagerImplForTest(fakeRisingwave)
+			_, err := managerImpl.WaitBeforeMetaRestoreJobCompleted(context.Background(), logr.Discard(), tc.restoreJob)
+			if tc.exit != (err == ctrlkit.ErrExit) {
+				t.Fatalf("unexpected result, expect exit: %v, err: %v", tc.exit, err)
+			}
+
+			afterImage := object.NewRisingWaveReader(managerImpl.risingwaveManager.RisingWaveAfterImage())
+			condition := afterImage.GetCondition(risingwavev1alpha1.RisingWaveConditionRestored)
+			if condition == nil || condition.Reason != tc.reason {
+				t.Fatalf("unexpected condition, expect reason: %s, condition: %v", tc.reason, condition)
+			}
+		})
+	}
+}
+
 func TestRisingWaveControllerManagerImpl_SyncFrontendService(t *testing.T) {
 	fakeRisingwave := testutils.FakeRisingWave()
 
@@ -446,6 +731,55 @@ func TestRisingWaveControllerManagerImpl_SyncConfigConfigMap(t *testing.T) {
 	)
 }
 
+func TestRisingWaveControllerManagerImpl_SyncConfigConfigMap_RenderSettings(t *testing.T) {
+	fakeRisingwave := testutils.FakeRisingWave()
+	fakeRisingwave.Spec.Configuration.ConfigMap = &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{
+		Name: "risingwave-config",
+		Key:  "risingwave.toml",
+	}
+	fakeRisingwave.Spec.Configuration.Settings = &risingwavev1alpha1.RisingWaveConfigurationSettings{
+		Server: &risingwavev1alpha1.RisingWaveServerSettings{
+			HeartbeatIntervalMs: pointer.Int32(2000),
+		},
+	}
+	userConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: fakeRisingwave.Namespace,
+			Name:      "risingwave-config",
+		},
+		Data: map[string]string{
+			"risingwave.toml": "[server]\nheartbeat_interval_ms = 1000\n",
+		},
+	}
+
+	ctx, logger := context.Background(), logr.Discard()
+	managerImpl := newRisingWaveControllerManagerImplForTest(fakeRisingwave, userConfigMap)
+
+	var configConfigMap *corev1.ConfigMap
+	syncAndGet := func() string {
+		if _, err := managerImpl.SyncConfigConfigMap(ctx, logger, configConfigMap); err != nil {
+			t.Fatal(err)
+		}
+		configConfigMap = &corev1.ConfigMap{}
+		if err := managerImpl.client.Get(ctx, types.NamespacedName{Namespace: fakeRisingwave.Namespace, Name: fakeRisingwave.Name + "-default-config"}, configConfigMap); err != nil {
+			t.Fatal(err)
+		}
+		return configConfigMap.Data["risingwave.toml"]
+	}
+
+	if val := syncAndGet(); val != "[server]\nheartbeat_interval_ms = 2000\n" {
+		t.Fatalf("unexpected rendered configuration: %q", val)
+	}
+
+	userConfigMap.Data["risingwave.toml"] = "[server]\nmetrics_level = 'Debug'\n"
+	if err := managerImpl.client.Update(ctx, userConfigMap); err != nil {
+		t.Fatal(err)
+	}
+	if val := syncAndGet(); val != "[server]\nheartbeat_interval_ms = 2000\nmetrics_level = 'Debug'\n" {
+		t.Fatalf("configuration not re-rendered: %q", val)
+	}
+}
+
 type ptrAsObjectList[T any] interface {
 	*T
 	client.ObjectList
@@ -811,6 +1145,299 @@ func TestRisingWaveControllerManagerImpl_SyncComputeStatefulSets(t *testing.T) {
 	)
 }
 
+func TestRisingWaveControllerManagerImpl_SyncComputeStatefulSets_ReferencedObjectsChanged(t *testing.T) {
+	fakeRisingwave := testutils.FakeRisingWave()
+	fakeRisingwave.Spec.Configuration.ConfigMap = &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{
+		Name: "risingwave-config",
+		Key:  "risingwave.toml",
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: fakeRisingwave.Namespace,
+			Name:      "risingwave-config",
+		},
+		Data: map[string]string{
+			"risingwave.toml": "",
+		},
+	}
+
+	ctx, logger := context.Background(), logr.Discard()
+	managerImpl := newRisingWaveControllerManagerImplForTest(fakeRisingwave, configMap)
+
+	listComputeStatefulSets := func() []appsv1.StatefulSet {
+		var statefulSets appsv1.StatefulSetList
+		if err := managerImpl.client.List(ctx, &statefulSets, client.InNamespace(fakeRisingwave.Namespace), client.MatchingLabels{
+			consts.LabelRisingWaveComponent: consts.ComponentCompute,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		return statefulSets.Items
+	}
+	syncComputeStatefulSets := func() string {
+		if _, err := managerImpl.SyncComputeStatefulSets(ctx, logger, listComputeStatefulSets()); err != nil {
+			t.Fatal(err)
+		}
+		statefulSets := listComputeStatefulSets()
+		if len(statefulSets) != 1 {
+			t.Fatalf("unexpected number of statefulsets: %d", len(statefulSets))
+		}
+		return statefulSets[0].Spec.Template.Annotations[consts.AnnotationReferencedObjectsHash]
+	}
+
+	// Create.
+	hash := syncComputeStatefulSets()
+	if hash == "" {
+		t.Fatal("referenced objects hash not injected")
+	}
+	if !managerImpl.isWorkloadSynced(ctx, consts.ComponentCompute, &listComputeStatefulSets()[0]) {
+		t.Fatal("workload should be synced")
+	}
+
+	// Nothing changed.
+	if syncComputeStatefulSets() != hash {
+		t.Fatal("referenced objects hash changed unexpectedly")
+	}
+
+	// Change the referenced ConfigMap.
+	configMap.Data["risingwave.toml"] = "[server]"
+	if err := managerImpl.client.Update(ctx, configMap); err != nil {
+		t.Fatal(err)
+	}
+	if managerImpl.isWorkloadSynced(ctx, consts.ComponentCompute, &listComputeStatefulSets()[0]) {
+		t.Fatal("workload shouldn't be synced after referenced objects changed")
+	}
+	if syncComputeStatefulSets() == hash {
+		t.Fatal("referenced objects hash not updated")
+	}
+	if !managerImpl.isWorkloadSynced(ctx, consts.ComponentCompute, &listComputeStatefulSets()[0]) {
+		t.Fatal("workload should be synced")
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_SyncMetaPodDisruptionBudgets(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.Components.Meta.NodeGroups[0].Replicas = 3
+	risingwave.Spec.Components.Meta.NodeGroups[0].PodDisruptionBudget = &risingwavev1alpha1.RisingWaveNodeGroupPodDisruptionBudget{}
+
+	ctx, logger := context.Background(), logr.Discard()
+	listMetaPodDisruptionBudgets := func(managerImpl *risingWaveControllerManagerImpl) []policyv1.PodDisruptionBudget {
+		var pdbs policyv1.PodDisruptionBudgetList
+		if err := managerImpl.client.List(ctx, &pdbs, client.InNamespace(risingwave.Namespace), client.MatchingLabels{
+			consts.LabelRisingWaveComponent: consts.ComponentMeta,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		return pdbs.Items
+	}
+
+	// Created when enabled, and the stale one of the removed group is deleted.
+	stalePDB := factory.NewRisingWaveObjectFactory(risingwave, testutils.Scheme, "").NewMetaPodDisruptionBudget("")
+	stalePDB.Name += "-stale"
+	stalePDB.Labels[consts.LabelRisingWaveGroup] = "stale"
+	managerImpl := newRisingWaveControllerManagerImplForTest(risingwave, stalePDB)
+	if _, err := managerImpl.SyncMetaPodDisruptionBudgets(ctx, logger, listMetaPodDisruptionBudgets(managerImpl)); err != nil {
+		t.Fatal(err)
+	}
+
+	pdbs := listMetaPodDisruptionBudgets(managerImpl)
+	if len(pdbs) != 1 {
+		t.Fatalf("unexpected number of pod disruption budgets: %d", len(pdbs))
+	}
+	if pdbs[0].Name != risingwave.Name+"-meta" {
+		t.Fatalf("unexpected pod disruption budget: %s", pdbs[0].Name)
+	}
+	if pdbs[0].Spec.MinAvailable == nil || pdbs[0].Spec.MinAvailable.IntValue() != 2 {
+		t.Fatalf("meta pod disruption budget doesn't preserve the quorum: %v", pdbs[0].Spec.MinAvailable)
+	}
+
+	// Kept with the default when unset.
+	risingwave.Spec.Components.Meta.NodeGroups[0].PodDisruptionBudget = nil
+	managerImpl = newRisingWaveControllerManagerImplForTest(risingwave, &pdbs[0])
+	if _, err := managerImpl.SyncMetaPodDisruptionBudgets(ctx, logger, listMetaPodDisruptionBudgets(managerImpl)); err != nil {
+		t.Fatal(err)
+	}
+	if pdbs := listMetaPodDisruptionBudgets(managerImpl); len(pdbs) != 1 {
+		t.Fatalf("meta pod disruption budget should be kept, found: %d", len(pdbs))
+	}
+
+	// Deleted when scaled to a single replica.
+	risingwave.Spec.Components.Meta.NodeGroups[0].Replicas = 1
+	managerImpl = newRisingWaveControllerManagerImplForTest(risingwave, &pdbs[0])
+	if _, err := managerImpl.SyncMetaPodDisruptionBudgets(ctx, logger, listMetaPodDisruptionBudgets(managerImpl)); err != nil {
+		t.Fatal(err)
+	}
+	if pdbs := listMetaPodDisruptionBudgets(managerImpl); len(pdbs) != 0 {
+		t.Fatalf("meta pod disruption budgets should be deleted, found: %d", len(pdbs))
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_SyncPodDisruptionBudgets_Default(t *testing.T) {
+	testcases := map[string]struct {
+		component string
+		replicas  int32
+		stopped   bool
+		pdb       *risingwavev1alpha1.RisingWaveNodeGroupPodDisruptionBudget
+		expected  bool
+	}{
+		"meta-unset-multiple-replicas": {
+			component: consts.ComponentMeta,
+			replicas:  3,
+			expected:  true,
+		},
+		"meta-unset-single-replica": {
+			component: consts.ComponentMeta,
+			replicas:  1,
+			expected:  false,
+		},
+		"meta-unset-stopped": {
+			component: consts.ComponentMeta,
+			replicas:  3,
+			stopped:   true,
+			expected:  false,
+		},
+		"meta-default-multiple-replicas": {
+			component: consts.ComponentMeta,
+			replicas:  3,
+			pdb:       &risingwavev1alpha1.RisingWaveNodeGroupPodDisruptionBudget{},
+			expected:  true,
+		},
+		"meta-default-single-replica": {
+			component: consts.ComponentMeta,
+			replicas:  1,
+			pdb:       &risingwavev1alpha1.RisingWaveNodeGroupPodDisruptionBudget{},
+			expected:  false,
+		},
+		"meta-default-stopped": {
+			component: consts.ComponentMeta,
+			replicas:  3,
+			stopped:   true,
+			pdb:       &risingwavev1alpha1.RisingWaveNodeGroupPodDisruptionBudget{},
+			expected:  false,
+		},
+		"meta-explicit-single-replica": {
+			component: consts.ComponentMeta,
+			replicas:  1,
+			pdb: &risingwavev1alpha1.RisingWaveNodeGroupPodDisruptionBudget{
+				MaxUnavailable: lo.ToPtr(intstr.FromInt(1)),
+			},
+			expected: true,
+		},
+		"frontend-unset-multiple-replicas": {
+			component: consts.ComponentFrontend,
+			replicas:  2,
+			expected:  true,
+		},
+		"frontend-unset-single-replica": {
+			component: consts.ComponentFrontend,
+			replicas:  1,
+			expected:  false,
+		},
+		"compute-unset-multiple-replicas": {
+			component: consts.ComponentCompute,
+			replicas:  2,
+			expected:  false,
+		},
+		"compute-default-multiple-replicas": {
+			component: consts.ComponentCompute,
+			replicas:  2,
+			pdb:       &risingwavev1alpha1.RisingWaveNodeGroupPodDisruptionBudget{},
+			expected:  true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			risingwave := testutils.FakeRisingWave()
+			risingwave.Spec.Stopped = pointer.Bool(tc.stopped)
+			nodeGroups := map[string]*risingwavev1alpha1.RisingWaveNodeGroup{
+				consts.ComponentMeta:     &risingwave.Spec.Components.Meta.NodeGroups[0],
+				consts.ComponentFrontend: &risingwave.Spec.Components.Frontend.NodeGroups[0],
+				consts.ComponentCompute:  &risingwave.Spec.Components.Compute.NodeGroups[0],
+			}
+			nodeGroups[tc.component].Replicas = tc.replicas
+			nodeGroups[tc.component].PodDisruptionBudget = tc.pdb
+
+			// The PodDisruptionBudget created before is deleted if it's no longer expected.
+			objectFactory := factory.NewRisingWaveObjectFactory(risingwave, testutils.Scheme, "")
+			var pdb *policyv1.PodDisruptionBudget
+			var sync func(*risingWaveControllerManagerImpl, []policyv1.PodDisruptionBudget) (ctrl.Result, error)
+			switch tc.component {
+			case consts.ComponentMeta:
+				pdb = objectFactory.NewMetaPodDisruptionBudget("")
+				sync = func(managerImpl *risingWaveControllerManagerImpl, pdbs []policyv1.PodDisruptionBudget) (ctrl.Result, error) {
+					return managerImpl.SyncMetaPodDisruptionBudgets(context.Background(), logr.Discard(), pdbs)
+				}
+			case consts.ComponentFrontend:
+				pdb = objectFactory.NewFrontendPodDisruptionBudget("")
+				sync = func(managerImpl *risingWaveControllerManagerImpl, pdbs []policyv1.PodDisruptionBudget) (ctrl.Result, error) {
+					return managerImpl.SyncFrontendPodDisruptionBudgets(context.Background(), logr.Discard(), pdbs)
+				}
+			case consts.ComponentCompute:
+				pdb = objectFactory.NewComputePodDisruptionBudget("")
+				sync = func(managerImpl *risingWaveControllerManagerImpl, pdbs []policyv1.PodDisruptionBudget) (ctrl.Result, error) {
+					return managerImpl.SyncComputePodDisruptionBudgets(context.Background(), logr.Discard(), pdbs)
+				}
+			}
+			managerImpl := newRisingWaveControllerManagerImplForTest(risingwave, pdb)
+			if _, err := sync(managerImpl, []policyv1.PodDisruptionBudget{*pdb}); err != nil {
+				t.Fatal(err)
+			}
+
+			var pdbs policyv1.PodDisruptionBudgetList
+			if err := managerImpl.client.List(context.Background(), &pdbs, client.InNamespace(risingwave.Namespace)); err != nil {
+				t.Fatal(err)
+			}
+			if exists := len(pdbs.Items) > 0; exists != tc.expected {
+				t.Fatalf("pod disruption budget exists: %v, expected: %v", exists, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_CollectReferencedObjectsHash(t *testing.T) {
+	fakeRisingwave := testutils.FakeRisingWave()
+	fakeRisingwave.Spec.Configuration.Secret = &risingwavev1alpha1.RisingWaveNodeConfigurationSecretSource{
+		Name: "risingwave-config",
+		Key:  "risingwave.toml",
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: fakeRisingwave.Namespace,
+			Name:      "risingwave-config",
+		},
+		Data: map[string][]byte{
+			"risingwave.toml": []byte(""),
+		},
+	}
+
+	ctx, logger := context.Background(), logr.Discard()
+	managerImpl := newRisingWaveControllerManagerImplForTest(fakeRisingwave, secret)
+
+	collectHash := func() string {
+		if _, err := managerImpl.CollectReferencedObjectsHash(ctx, logger); err != nil {
+			t.Fatal(err)
+		}
+		managerImpl.risingwaveManager.SyncReferencedObjectsHash()
+		return managerImpl.risingwaveManager.RisingWaveAfterImage().Status.ReferencedObjectsHash
+	}
+
+	hash := collectHash()
+	if hash == "" {
+		t.Fatal("referenced objects hash not collected")
+	}
+	if collectHash() != hash {
+		t.Fatal("referenced objects hash changed unexpectedly")
+	}
+
+	secret.Data["risingwave.toml"] = []byte("[server]")
+	if err := managerImpl.client.Update(ctx, secret); err != nil {
+		t.Fatal(err)
+	}
+	if collectHash() == hash {
+		t.Fatal("referenced objects hash not changed")
+	}
+}
+
 func TestRisingWaveControllerManagerImpl_SyncComputeAdvancedStatefulSets(t *testing.T) {
 	fakeRisingwave := testutils.FakeRisingWaveOpenKruiseEnabled()
 
@@ -913,9 +1540,11 @@ func Test_WaitComponentGroupWorkloadsReady(t *testing.T) {
 		},
 	}
 
+	managerImpl := newRisingWaveControllerManagerImplForTest(testutils.FakeRisingWave())
+
 	for name, tc := range testcases {
 		t.Run(name, func(t *testing.T) {
-			r, err := waitComponentGroupWorkloadsReady(
+			r, err := waitComponentGroupWorkloadsReady(managerImpl,
 				context.Background(), logr.Discard(), "", tc.groups, tc.objects,
 				func(obj *appsv1.Deployment) bool {
 					return obj.Labels["ready"] == "1"
@@ -927,3 +1556,643 @@ func Test_WaitComponentGroupWorkloadsReady(t *testing.T) {
 		})
 	}
 }
+
+func Test_WaitComponentGroupWorkloadsReady_Stopped(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.Stopped = pointer.Bool(true)
+	managerImpl := newRisingWaveControllerManagerImplForTest(risingwave)
+
+	r, err := waitComponentGroupWorkloadsReady(managerImpl,
+		context.Background(), logr.Discard(), "", map[string]int{"": 1}, []appsv1.Deployment{
+			newGroupObjectFromGroup[appsv1.Deployment]("", "", "", map[string]string{}),
+		},
+		func(obj *appsv1.Deployment) bool {
+			return false
+		},
+	)
+	if ctrlkit.NeedsRequeue(r, err) {
+		t.Fatal("should not wait when stopped")
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_CheckRolloutProgressDeadline(t *testing.T) {
+	newRisingWave := func(startTime time.Time, mutates ...func(*risingwavev1alpha1.RisingWave)) *risingwavev1alpha1.RisingWave {
+		risingwave := testutils.FakeRisingWave()
+		risingwave.Spec.UpgradePolicy.ProgressDeadlineSeconds = pointer.Int32(600)
+		risingwave.Status.Rollout = &risingwavev1alpha1.RisingWaveRolloutStatus{
+			Generation: risingwave.Generation,
+			StartTime:  metav1.NewTime(startTime),
+		}
+		for _, mutate := range mutates {
+			mutate(risingwave)
+		}
+		return risingwave
+	}
+
+	testcases := map[string]struct {
+		risingwave *risingwavev1alpha1.RisingWave
+		rolledOut  bool
+		requeue    bool
+		exit       bool
+		failed     bool
+	}{
+		"within-deadline": {
+			risingwave: newRisingWave(time.Now()),
+			requeue:    true,
+		},
+		"deadline-exceeded": {
+			risingwave: newRisingWave(time.Now().Add(-time.Hour)),
+			failed:     true,
+		},
+		"deadline-exceeded-but-rolled-out": {
+			risingwave: newRisingWave(time.Now().Add(-time.Hour)),
+			rolledOut:  true,
+			exit:       true,
+		},
+		"default-node-group-deadline-exceeded": {
+			risingwave: newRisingWave(time.Now().Add(-time.Hour), func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.UpgradePolicy.ProgressDeadlineSeconds = nil
+			}),
+			failed: true,
+		},
+		"node-group-deadline-not-exceeded": {
+			risingwave: newRisingWave(time.Now().Add(-time.Hour), func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.UpgradePolicy.ProgressDeadlineSeconds = nil
+				r.Spec.Components.Compute.NodeGroups[0].ProgressDeadlineSeconds = pointer.Int32(7200)
+			}),
+			requeue: true,
+		},
+		"canary-soak-time-excluded": {
+			risingwave: newRisingWave(time.Now().Add(-15*time.Minute), func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.UpgradePolicy.Canary = &risingwavev1alpha1.RisingWaveCanaryUpgradePolicy{
+					SoakSeconds: pointer.Int32(300),
+				}
+				r.Status.Canary = &risingwavev1alpha1.RisingWaveCanaryStatus{
+					Component: consts.ComponentCompute,
+					Group:     "canary",
+					ReadyTime: lo.ToPtr(metav1.NewTime(time.Now().Add(-10 * time.Minute))),
+				}
+				r.Status.Rollout.SoakedCanaryComponents = []string{consts.ComponentMeta}
+			}),
+			requeue: true,
+		},
+		"upgrade-policy-overrides-node-group-deadline": {
+			risingwave: newRisingWave(time.Now().Add(-time.Hour), func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Components.Compute.NodeGroups[0].ProgressDeadlineSeconds = pointer.Int32(7200)
+			}),
+			failed: true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			computeStatefulSet := factory.NewRisingWaveObjectFactory(tc.risingwave, testutils.Scheme, "").NewComputeStatefulSet("")
+			computeStatefulSet.Generation = 1
+			if tc.rolledOut {
+				computeStatefulSet.Status = appsv1.StatefulSetStatus{
+					ObservedGeneration: 1,
+					Replicas:           1,
+					ReadyReplicas:      1,
+					UpdatedReplicas:    1,
+					AvailableReplicas:  1,
+				}
+			}
+
+			managerImpl := newRisingWaveControllerManagerImplForTest(tc.risingwave, computeStatefulSet)
+			result, err := managerImpl.CheckRolloutProgressDeadline(context.Background(), logr.Discard())
+			if tc.requeue != (result.RequeueAfter > 0) {
+				t.Fatalf("unexpected result, expect requeue: %v, result: %v", tc.requeue, result)
+			}
+			if tc.exit != (err == ctrlkit.ErrExit) {
+				t.Fatalf("unexpected error, expect exit: %v, err: %v", tc.exit, err)
+			}
+
+			afterImage := object.NewRisingWaveReader(managerImpl.risingwaveManager.RisingWaveAfterImage())
+			condition := afterImage.GetCondition(risingwavev1alpha1.RisingWaveConditionFailed)
+			if tc.failed != (condition != nil && condition.Status == metav1.ConditionTrue) {
+				t.Fatalf("unexpected condition, expect failed: %v, condition: %v", tc.failed, condition)
+			}
+			if tc.failed != afterImage.IsRolloutFailed() {
+				t.Fatalf("unexpected rollout status, expect failed: %v", tc.failed)
+			}
+		})
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_RollbackWorkloads(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Status.Rollout = &risingwavev1alpha1.RisingWaveRolloutStatus{
+		Generation: risingwave.Generation,
+		Failed:     true,
+	}
+
+	computeStatefulSet := factory.NewRisingWaveObjectFactory(risingwave, testutils.Scheme, "").NewComputeStatefulSet("")
+	knownGoodTemplate := computeStatefulSet.Spec.Template.DeepCopy()
+
+	// Record the known-good revision.
+	managerImpl := newRisingWaveControllerManagerImplForTest(risingwave, computeStatefulSet)
+	_, err := managerImpl.SyncRevisionConfigMap(context.Background(), logr.Discard(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var revisionConfigMap corev1.ConfigMap
+	if err := managerImpl.client.Get(context.Background(), types.NamespacedName{
+		Namespace: risingwave.Namespace,
+		Name:      risingwave.Name + "-revision",
+	}, &revisionConfigMap); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := revisionConfigMap.Data["StatefulSet."+computeStatefulSet.Name]; !ok {
+		t.Fatalf("pod template of compute not recorded: %v", lo.Keys(revisionConfigMap.Data))
+	}
+
+	// Upgrade the compute to a bad image.
+	var currentStatefulSet appsv1.StatefulSet
+	if err := managerImpl.client.Get(context.Background(), client.ObjectKeyFromObject(computeStatefulSet), &currentStatefulSet); err != nil {
+		t.Fatal(err)
+	}
+	currentStatefulSet.Spec.Template.Spec.Containers[0].Image = "ghcr.io/risingwavelabs/risingwave:bad"
+	if err := managerImpl.client.Update(context.Background(), &currentStatefulSet); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = managerImpl.RollbackWorkloads(context.Background(), logr.Discard(), &revisionConfigMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := managerImpl.client.Get(context.Background(), client.ObjectKeyFromObject(computeStatefulSet), &currentStatefulSet); err != nil {
+		t.Fatal(err)
+	}
+	if !equality.Semantic.DeepEqual(knownGoodTemplate, &currentStatefulSet.Spec.Template) {
+		t.Fatal("compute not rolled back to the known-good revision")
+	}
+	if !managerImpl.risingwaveManager.RisingWaveAfterImage().Status.Rollout.RolledBack {
+		t.Fatal("rollback not recorded")
+	}
+	if !managerImpl.eventMessageStore.IsMessageSet(consts.RisingWaveEventTypeRolledBack.Name) {
+		t.Fatal("rollback event not set")
+	}
+	if !managerImpl.isObjectSynced(&currentStatefulSet) {
+		t.Fatal("rolled back workload not considered as synced")
+	}
+
+	// Reconcile again with the recorded status. The workloads must not be rolled back again.
+	currentStatefulSet.Spec.Template.Spec.Containers[0].Image = "ghcr.io/risingwavelabs/risingwave:manual-fix"
+	if err := managerImpl.client.Update(context.Background(), &currentStatefulSet); err != nil {
+		t.Fatal(err)
+	}
+	risingwaveManager := object.NewRisingWaveManager(managerImpl.client, managerImpl.risingwaveManager.RisingWaveAfterImage(), false)
+	managerImpl = newRisingWaveControllerManagerImpl(managerImpl.client, risingwaveManager, event.NewMessageStore(), false, "")
+	_, err = managerImpl.RollbackWorkloads(context.Background(), logr.Discard(), &revisionConfigMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := managerImpl.client.Get(context.Background(), client.ObjectKeyFromObject(computeStatefulSet), &currentStatefulSet); err != nil {
+		t.Fatal(err)
+	}
+	if currentStatefulSet.Spec.Template.Spec.Containers[0].Image != "ghcr.io/risingwavelabs/risingwave:manual-fix" {
+		t.Fatal("workload rolled back more than once in the same generation")
+	}
+	if managerImpl.eventMessageStore.IsMessageSet(consts.RisingWaveEventTypeRolledBack.Name) {
+		t.Fatal("rollback event set more than once in the same generation")
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_CollectPodFailuresAndSyncCondition(t *testing.T) {
+	fakeRisingwave := testutils.FakeRisingWave()
+
+	newPod := func(name, component string, status corev1.PodStatus) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: fakeRisingwave.Namespace,
+				Labels: map[string]string{
+					consts.LabelRisingWaveName:      fakeRisingwave.Name,
+					consts.LabelRisingWaveComponent: component,
+					consts.LabelRisingWaveGroup:     "",
+				},
+			},
+			Status: status,
+		}
+	}
+	crashLoopBackOff := corev1.PodStatus{
+		ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "compute", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+		},
+	}
+	unschedulable := corev1.PodStatus{
+		Conditions: []corev1.PodCondition{
+			{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable},
+		},
+	}
+
+	testcases := map[string]struct {
+		conditions []risingwavev1alpha1.RisingWaveCondition
+		pods       []corev1.Pod
+		reason     string
+		event      bool
+	}{
+		"healthy": {
+			pods: []corev1.Pod{newPod("fake-compute-0", consts.ComponentCompute, corev1.PodStatus{})},
+		},
+		"crash-loop-back-off": {
+			pods: []corev1.Pod{
+				newPod("fake-frontend-0", consts.ComponentFrontend, unschedulable),
+				newPod("fake-compute-0", consts.ComponentCompute, crashLoopBackOff),
+			},
+			reason: utils.PodFailureReasonCrashLoopBackOff,
+			event:  true,
+		},
+		"recovered": {
+			conditions: []risingwavev1alpha1.RisingWaveCondition{
+				{Type: risingwavev1alpha1.RisingWaveConditionFailed, Status: metav1.ConditionTrue, Reason: utils.PodFailureReasonOOMKilled},
+			},
+			pods: []corev1.Pod{newPod("fake-compute-0", consts.ComponentCompute, corev1.PodStatus{})},
+		},
+		"rollout-failure-kept": {
+			conditions: []risingwavev1alpha1.RisingWaveCondition{
+				{Type: risingwavev1alpha1.RisingWaveConditionFailed, Status: metav1.ConditionTrue, Reason: "ProgressDeadlineExceeded"},
+			},
+			pods:   []corev1.Pod{newPod("fake-compute-0", consts.ComponentCompute, crashLoopBackOff)},
+			reason: "ProgressDeadlineExceeded",
+		},
+		"managed-store-ignored": {
+			pods: []corev1.Pod{newPod("fake-etcd-0", "etcd", crashLoopBackOff)},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			risingwave := fakeRisingwave.DeepCopy()
+			risingwave.Status.Conditions = tc.conditions
+
+			managerImpl := newRisingWaveControllerManagerImplForTest(risingwave)
+			_, err := managerImpl.CollectPodFailuresAndSyncCondition(context.Background(), logr.Discard(), tc.pods)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			afterImage := object.NewRisingWaveReader(managerImpl.risingwaveManager.RisingWaveAfterImage())
+			condition := afterImage.GetCondition(risingwavev1alpha1.RisingWaveConditionFailed)
+			if tc.reason == "" {
+				if condition != nil {
+					t.Fatalf("unexpected condition: %v", condition)
+				}
+			} else if condition == nil || condition.Reason != tc.reason {
+				t.Fatalf("unexpected condition, expect reason: %s, condition: %v", tc.reason, condition)
+			}
+
+			if tc.event != managerImpl.eventMessageStore.IsMessageSet(consts.RisingWaveEventTypeUnhealthy.Name) {
+				t.Fatalf("unexpected event, expect: %v", tc.event)
+			}
+			if tc.event {
+				message := managerImpl.eventMessageStore.MessageFor(consts.RisingWaveEventTypeUnhealthy.Name)
+				if !strings.Contains(message, "fake-compute-0") || !strings.Contains(message, "fake-frontend-0") {
+					t.Fatalf("pod names not in the event message: %s", message)
+				}
+			}
+		})
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_SyncComputeStatefulSetsWithCanary(t *testing.T) {
+	newRisingWave := func(canary *risingwavev1alpha1.RisingWaveCanaryStatus) *risingwavev1alpha1.RisingWave {
+		risingwave := testutils.FakeRisingWave()
+		risingwave.Spec.Image = "ghcr.io/risingwavelabs/risingwave:v1.1.0"
+		canaryGroup := risingwave.Spec.Components.Compute.NodeGroups[0].DeepCopy()
+		canaryGroup.Name = "canary"
+		risingwave.Spec.Components.Compute.NodeGroups = append(risingwave.Spec.Components.Compute.NodeGroups, *canaryGroup)
+		risingwave.Spec.UpgradePolicy.Canary = &risingwavev1alpha1.RisingWaveCanaryUpgradePolicy{
+			Compute:     pointer.String("canary"),
+			SoakSeconds: pointer.Int32(300),
+		}
+		risingwave.Status.RolledOutVersion = "v1.0.0"
+		risingwave.Status.Rollout = &risingwavev1alpha1.RisingWaveRolloutStatus{
+			Generation: risingwave.Generation,
+			StartTime:  metav1.Now(),
+		}
+		risingwave.Status.Canary = canary
+		return risingwave
+	}
+
+	// Only the images of the node groups are changed.
+	newRisingWaveWithGroupImages := func() *risingwavev1alpha1.RisingWave {
+		risingwave := newRisingWave(nil)
+		risingwave.Spec.Image = "ghcr.io/risingwavelabs/risingwave:v1.0.0"
+		risingwave.Status.RolledOutVersion = "v1.0.0"
+		for i := range risingwave.Spec.Components.Compute.NodeGroups {
+			risingwave.Spec.Components.Compute.NodeGroups[i].Template.Spec.Image = "ghcr.io/risingwavelabs/risingwave:v1.0.1"
+		}
+		return risingwave
+	}
+
+	// Workloads of the previous generation with the previous image, and the canary one of the current generation
+	// if it's upgraded.
+	newStatefulSets := func(risingwave *risingwavev1alpha1.RisingWave, canaryUpgraded, canaryMissing bool) []client.Object {
+		previous := risingwave.DeepCopy()
+		previous.Spec.Image = "ghcr.io/risingwavelabs/risingwave:v1.0.0"
+		for i := range previous.Spec.Components.Compute.NodeGroups {
+			previous.Spec.Components.Compute.NodeGroups[i].Template.Spec.Image = ""
+		}
+
+		var objs []client.Object
+		for _, group := range []string{"", "canary"} {
+			var sts *appsv1.StatefulSet
+			switch {
+			case group == "canary" && canaryMissing:
+				continue
+			case group == "canary" && canaryUpgraded:
+				sts = factory.NewRisingWaveObjectFactory(risingwave, testutils.Scheme, "").NewComputeStatefulSet(group)
+			default:
+				sts = factory.NewRisingWaveObjectFactory(previous, testutils.Scheme, "").NewComputeStatefulSet(group)
+				sts.Labels[consts.LabelRisingWaveGeneration] = "0"
+			}
+			sts.Status = appsv1.StatefulSetStatus{
+				Replicas:          1,
+				UpdatedReplicas:   1,
+				AvailableReplicas: 1,
+			}
+			objs = append(objs, sts)
+		}
+		return objs
+	}
+
+	testcases := map[string]struct {
+		risingwave     *risingwavev1alpha1.RisingWave
+		canaryUpgraded bool
+		canaryMissing  bool
+		reason         string
+		requeue        bool
+		othersUpgraded bool
+	}{
+		"canary-not-upgraded": {
+			risingwave: newRisingWave(nil),
+			reason:     "CanaryNotReady",
+		},
+		"canary-not-found": {
+			risingwave:    newRisingWave(nil),
+			canaryMissing: true,
+			reason:        "CanaryNotReady",
+		},
+		"canary-ready": {
+			risingwave:     newRisingWave(nil),
+			canaryUpgraded: true,
+			reason:         "CanarySoaking",
+			requeue:        true,
+		},
+		"canary-soaked": {
+			risingwave: newRisingWave(&risingwavev1alpha1.RisingWaveCanaryStatus{
+				Component: consts.ComponentCompute,
+				Group:     "canary",
+				ReadyTime: lo.ToPtr(metav1.NewTime(time.Now().Add(-time.Hour))),
+			}),
+			canaryUpgraded: true,
+			othersUpgraded: true,
+		},
+		"group-images-canary-not-upgraded": {
+			risingwave: newRisingWaveWithGroupImages(),
+			reason:     "CanaryNotReady",
+		},
+		"group-images-canary-ready": {
+			risingwave:     newRisingWaveWithGroupImages(),
+			canaryUpgraded: true,
+			reason:         "CanarySoaking",
+			requeue:        true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			statefulSets := newStatefulSets(tc.risingwave, tc.canaryUpgraded, tc.canaryMissing)
+			managerImpl := newRisingWaveControllerManagerImplForTest(tc.risingwave, statefulSets...)
+
+			result, err := managerImpl.SyncComputeStatefulSets(context.Background(), logr.Discard(),
+				lo.Map(statefulSets, func(obj client.Object, _ int) appsv1.StatefulSet { return *obj.(*appsv1.StatefulSet) }))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.requeue != (result.RequeueAfter > 0) {
+				t.Fatalf("unexpected result, expect requeue: %v, result: %v", tc.requeue, result)
+			}
+
+			for _, group := range []string{"", "canary"} {
+				var sts appsv1.StatefulSet
+				if err := managerImpl.client.Get(context.Background(), types.NamespacedName{
+					Namespace: tc.risingwave.Namespace,
+					Name:      factory.NewRisingWaveObjectFactory(tc.risingwave, testutils.Scheme, "").NewComputeStatefulSet(group).Name,
+				}, &sts); err != nil {
+					t.Fatal(err)
+				}
+				upgraded := managerImpl.isObjectSynced(&sts)
+				expectUpgraded := group == "canary" || tc.othersUpgraded
+				if upgraded != expectUpgraded {
+					t.Fatalf("unexpected upgrade of group %q, expect: %v", group, expectUpgraded)
+				}
+			}
+
+			afterImage := object.NewRisingWaveReader(managerImpl.risingwaveManager.RisingWaveAfterImage())
+			condition := afterImage.GetCondition(risingwavev1alpha1.RisingWaveConditionCanaryInProgress)
+			if tc.reason == "" {
+				if condition != nil {
+					t.Fatalf("unexpected condition: %v", condition)
+				}
+			} else if condition == nil || condition.Reason != tc.reason {
+				t.Fatalf("unexpected condition, expect reason: %s, condition: %v", tc.reason, condition)
+			}
+			soaked := lo.Contains(afterImage.RisingWave().Status.Rollout.SoakedCanaryComponents, consts.ComponentCompute)
+			if soaked != tc.othersUpgraded {
+				t.Fatalf("unexpected soaked canary components, expect compute soaked: %v", tc.othersUpgraded)
+			}
+		})
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_SyncComputeStatefulSetsWithCanaryNoImageChange(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	canaryGroup := risingwave.Spec.Components.Compute.NodeGroups[0].DeepCopy()
+	canaryGroup.Name = "canary"
+	canaryGroup.Replicas = 2
+	risingwave.Spec.Components.Compute.NodeGroups = append(risingwave.Spec.Components.Compute.NodeGroups, *canaryGroup)
+	risingwave.Spec.UpgradePolicy.Canary = &risingwavev1alpha1.RisingWaveCanaryUpgradePolicy{
+		Compute: pointer.String("canary"),
+	}
+	risingwave.Status.RolledOutVersion = "v0.0.1"
+
+	// The images of the workloads stay unchanged, e.g., only the replicas are changed.
+	var statefulSets []client.Object
+	for _, group := range []string{"", "canary"} {
+		sts := factory.NewRisingWaveObjectFactory(risingwave, testutils.Scheme, "").NewComputeStatefulSet(group)
+		sts.Labels[consts.LabelRisingWaveGeneration] = "0"
+		statefulSets = append(statefulSets, sts)
+	}
+
+	managerImpl := newRisingWaveControllerManagerImplForTest(risingwave, statefulSets...)
+	_, err := managerImpl.SyncComputeStatefulSets(context.Background(), logr.Discard(),
+		lo.Map(statefulSets, func(obj client.Object, _ int) appsv1.StatefulSet { return *obj.(*appsv1.StatefulSet) }))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, obj := range statefulSets {
+		var sts appsv1.StatefulSet
+		if err := managerImpl.client.Get(context.Background(), client.ObjectKeyFromObject(obj), &sts); err != nil {
+			t.Fatal(err)
+		}
+		if !managerImpl.isObjectSynced(&sts) {
+			t.Fatalf("group %q held without an image change", sts.Labels[consts.LabelRisingWaveGroup])
+		}
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_SyncComputeStatefulSetsWithHeldGroup(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	otherGroup := risingwave.Spec.Components.Compute.NodeGroups[0].DeepCopy()
+	otherGroup.Name = "other"
+	risingwave.Spec.Components.Compute.NodeGroups = append(risingwave.Spec.Components.Compute.NodeGroups, *otherGroup)
+
+	var statefulSets []client.Object
+	for _, group := range []string{"", "other"} {
+		sts := factory.NewRisingWaveObjectFactory(risingwave, testutils.Scheme, "").NewComputeStatefulSet(group)
+		sts.Labels[consts.LabelRisingWaveGeneration] = "0"
+		statefulSets = append(statefulSets, sts)
+	}
+
+	// The default group is being drained before scaling in.
+	managerImpl := newRisingWaveControllerManagerImplForTest(risingwave, statefulSets...)
+	managerImpl.risingwaveManager.HoldNodeGroup(consts.ComponentCompute, "")
+	_, err := managerImpl.SyncComputeStatefulSets(context.Background(), logr.Discard(),
+		lo.Map(statefulSets, func(obj client.Object, _ int) appsv1.StatefulSet { return *obj.(*appsv1.StatefulSet) }))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, obj := range statefulSets {
+		var sts appsv1.StatefulSet
+		if err := managerImpl.client.Get(context.Background(), client.ObjectKeyFromObject(obj), &sts); err != nil {
+			t.Fatal(err)
+		}
+		group := sts.Labels[consts.LabelRisingWaveGroup]
+		if synced := managerImpl.isObjectSynced(&sts); synced != (group != "") {
+			t.Fatalf("unexpected sync state of group %q: %v", group, synced)
+		}
+	}
+}
+
+func TestRisingWaveControllerManagerImpl_CollectEndpointsAndSyncStatus(t *testing.T) {
+	fakeRisingwave := testutils.FakeRisingWave()
+
+	newMetaPod := func(name, role, ip string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: fakeRisingwave.Namespace,
+				Labels: map[string]string{
+					consts.LabelRisingWaveName:      fakeRisingwave.Name,
+					consts.LabelRisingWaveComponent: consts.ComponentMeta,
+					consts.LabelRisingWaveMetaRole:  role,
+				},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning, PodIP: ip},
+		}
+	}
+	frontendService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-frontend", Namespace: fakeRisingwave.Namespace},
+		Spec: corev1.ServiceSpec{
+			Type:  corev1.ServiceTypeLoadBalancer,
+			Ports: []corev1.ServicePort{{Name: consts.PortService, Port: 4567}},
+		},
+		Status: corev1.ServiceStatus{
+			LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}}},
+		},
+	}
+	metaService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-meta", Namespace: fakeRisingwave.Namespace},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: consts.PortDashboard, Port: 5691}},
+		},
+	}
+
+	testcases := map[string]struct {
+		leader             *risingwavev1alpha1.RisingWaveMetaLeaderStatus
+		pods               []corev1.Pod
+		expectedPod        string
+		expectedAddress    string
+		expectedTransition int32
+	}{
+		"first-leader": {
+			pods: []corev1.Pod{
+				newMetaPod("fake-meta-0", consts.MetaRoleFollower, "10.0.0.1"),
+				newMetaPod("fake-meta-1", consts.MetaRoleLeader, "10.0.0.2"),
+			},
+			expectedPod:     "fake-meta-1",
+			expectedAddress: "10.0.0.2:5690",
+		},
+		"leader-unchanged": {
+			leader: &risingwavev1alpha1.RisingWaveMetaLeaderStatus{Pod: "fake-meta-1", Address: "10.0.0.2:5690", TransitionCount: 1},
+			pods: []corev1.Pod{
+				newMetaPod("fake-meta-1", consts.MetaRoleLeader, "10.0.0.2"),
+			},
+			expectedPod:        "fake-meta-1",
+			expectedAddress:    "10.0.0.2:5690",
+			expectedTransition: 1,
+		},
+		"leader-changed": {
+			leader: &risingwavev1alpha1.RisingWaveMetaLeaderStatus{Pod: "fake-meta-1", Address: "10.0.0.2:5690", TransitionCount: 1},
+			pods: []corev1.Pod{
+				newMetaPod("fake-meta-0", consts.MetaRoleLeader, "10.0.0.1"),
+				newMetaPod("fake-meta-1", consts.MetaRoleFollower, "10.0.0.2"),
+			},
+			expectedPod:        "fake-meta-0",
+			expectedAddress:    "10.0.0.1:5690",
+			expectedTransition: 2,
+		},
+		"leader-not-found": {
+			leader: &risingwavev1alpha1.RisingWaveMetaLeaderStatus{Pod: "fake-meta-1", Address: "10.0.0.2:5690", TransitionCount: 1},
+			pods: []corev1.Pod{
+				newMetaPod("fake-meta-0", consts.MetaRoleUnknown, "10.0.0.1"),
+			},
+			expectedPod:        "fake-meta-1",
+			expectedAddress:    "10.0.0.2:5690",
+			expectedTransition: 1,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			risingwave := fakeRisingwave.DeepCopy()
+			risingwave.Status.MetaLeader = tc.leader
+
+			managerImpl := newRisingWaveControllerManagerImplForTest(risingwave)
+			_, err := managerImpl.CollectEndpointsAndSyncStatus(context.Background(), logr.Discard(), frontendService, metaService, nil, tc.pods)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			status := managerImpl.risingwaveManager.RisingWaveAfterImage().Status
+			if status.MetaLeader == nil || status.MetaLeader.Pod != tc.expectedPod ||
+				status.MetaLeader.Address != tc.expectedAddress || status.MetaLeader.TransitionCount != tc.expectedTransition {
+				t.Fatalf("unexpected meta leader: %v", status.MetaLeader)
+			}
+			if tc.leader != nil && tc.leader.Pod != tc.expectedPod && status.MetaLeader.LastTransitionTime == nil {
+				t.Fatal("last transition time not set")
+			}
+
+			expectedFrontendEndpoint := &risingwavev1alpha1.RisingWaveFrontendEndpointStatus{
+				Host:                "fake-frontend." + fakeRisingwave.Namespace + ".svc",
+				Port:                4567,
+				ServiceType:         corev1.ServiceTypeLoadBalancer,
+				LoadBalancerIngress: []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}},
+			}
+			if !equality.Semantic.DeepEqual(status.FrontendEndpoint, expectedFrontendEndpoint) {
+				t.Fatalf("unexpected frontend endpoint: %v", status.FrontendEndpoint)
+			}
+
+			if status.MetaDashboardURL != "http://fake-meta."+fakeRisingwave.Namespace+".svc:5691" {
+				t.Fatalf("unexpected meta dashboard url: %s", status.MetaDashboardURL)
+			}
+		})
+	}
+}
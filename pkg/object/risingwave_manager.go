@@ -19,14 +19,17 @@ package object
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/samber/lo"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
 	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+	"github.com/risingwavelabs/risingwave-operator/pkg/utils"
 )
 
 // RisingWaveReader is a reader for RisingWave object.
@@ -65,6 +68,175 @@ func (r *RisingWaveReader) DoesConditionExistAndEqual(conditionType risingwavev1
 	return cond != nil && (cond.Status == metav1.ConditionTrue) == value
 }
 
+// IsMetaStoreEtcdManaged tells whether the meta store is an etcd managed by the operator.
+func (r *RisingWaveReader) IsMetaStoreEtcdManaged() bool {
+	etcd := r.risingwave.Spec.MetaStore.Etcd
+	return etcd != nil && etcd.Managed != nil
+}
+
+// IsStateStoreMinIOManaged tells whether the state store is a MinIO managed by the operator.
+func (r *RisingWaveReader) IsStateStoreMinIOManaged() bool {
+	minio := r.risingwave.Spec.StateStore.MinIO
+	return minio != nil && minio.Managed != nil
+}
+
+// StoresHash returns the hash of the meta store and state store specs.
+func (r *RisingWaveReader) StoresHash() string {
+	return lo.Must(utils.HashObject([]any{r.risingwave.Spec.MetaStore, r.risingwave.Spec.StateStore}))
+}
+
+// IsStoreCredentialsRotating tells whether the stores in the spec differ from the ones rolled out. The locations
+// of the stores are immutable, so it only happens when the credentials are changed.
+func (r *RisingWaveReader) IsStoreCredentialsRotating() bool {
+	storesHash := r.risingwave.Status.StoresHash
+	return storesHash != "" && storesHash != r.StoresHash()
+}
+
+// IsStopped tells whether the RisingWave is requested to be stopped.
+func (r *RisingWaveReader) IsStopped() bool {
+	return pointer.BoolDeref(r.risingwave.Spec.Stopped, false)
+}
+
+// IsResuming tells whether the RisingWave is resuming from the stopped state, i.e., it's no longer requested
+// to be stopped but the components haven't been brought back yet.
+func (r *RisingWaveReader) IsResuming() bool {
+	return !r.IsStopped() && r.DoesConditionExistAndEqual(risingwavev1alpha1.RisingWaveConditionStopped, true)
+}
+
+// IsRestoringMetaStore tells whether the RisingWave is requested to be bootstrapped from a meta snapshot and the
+// meta store hasn't been restored yet.
+func (r *RisingWaveReader) IsRestoringMetaStore() bool {
+	return r.risingwave.Spec.RestoreFrom != nil &&
+		!r.DoesConditionExistAndEqual(risingwavev1alpha1.RisingWaveConditionRestored, true)
+}
+
+// TargetVersion returns the version of the global image in the spec.
+func (r *RisingWaveReader) TargetVersion() string {
+	return utils.GetVersionFromImage(r.risingwave.Spec.Image)
+}
+
+// hasObservedWorkloads tells whether any workload of the components has been observed in the status.
+func (r *RisingWaveReader) hasObservedWorkloads() bool {
+	componentReplicas := &r.risingwave.Status.ComponentReplicas
+	for _, status := range []*risingwavev1alpha1.ComponentReplicasStatus{
+		&componentReplicas.Meta,
+		&componentReplicas.Frontend,
+		&componentReplicas.Compute,
+		&componentReplicas.Compactor,
+		&componentReplicas.Connector,
+	} {
+		if lo.SomeBy(status.Groups, func(g risingwavev1alpha1.ComponentGroupReplicasStatus) bool { return g.Exists }) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsVersionUpgrading tells whether the version of the global image differs from the one rolled out. If no version
+// has been rolled out yet but there are workloads, e.g., the image is changed before the first rollout completes,
+// it's also considered upgrading so that the components are upgraded in order.
+func (r *RisingWaveReader) IsVersionUpgrading() bool {
+	rolledOutVersion := r.risingwave.Status.RolledOutVersion
+	if rolledOutVersion == "" {
+		return r.hasObservedWorkloads()
+	}
+	return rolledOutVersion != r.TargetVersion()
+}
+
+// IsRolloutFailed tells whether the rollout of the current generation has failed.
+func (r *RisingWaveReader) IsRolloutFailed() bool {
+	rollout := r.risingwave.Status.Rollout
+	return rollout != nil && rollout.Generation == r.risingwave.Generation && rollout.Failed
+}
+
+// IsRolloutRolledBack tells whether the workloads have been rolled back since the rollout of the current generation
+// failed.
+func (r *RisingWaveReader) IsRolloutRolledBack() bool {
+	return r.IsRolloutFailed() && r.risingwave.Status.Rollout.RolledBack
+}
+
+// IsAutoRollbackEnabled tells whether to revert the workloads to the last known-good revision when the upgrade fails.
+func (r *RisingWaveReader) IsAutoRollbackEnabled() bool {
+	return pointer.BoolDeref(r.risingwave.Spec.UpgradePolicy.AutoRollback, false)
+}
+
+// IsGracefulComputeScaleInEnabled tells whether to drain the compute workers through meta before scaling in.
+func (r *RisingWaveReader) IsGracefulComputeScaleInEnabled() bool {
+	return pointer.BoolDeref(r.risingwave.Spec.EnableGracefulComputeScaleIn, false)
+}
+
+// GetComputeScaleIn returns the status of the graceful scale-in of the given compute node group. Nil is returned
+// if there's none.
+func (r *RisingWaveReader) GetComputeScaleIn(group string) *risingwavev1alpha1.RisingWaveComputeScaleInStatus {
+	for i := range r.risingwave.Status.ComputeScaleIns {
+		if r.risingwave.Status.ComputeScaleIns[i].Group == group {
+			return &r.risingwave.Status.ComputeScaleIns[i]
+		}
+	}
+	return nil
+}
+
+// GetCanaryNodeGroup returns the name of the canary node group of the given component. False is returned when there's
+// no canary for the component, or the canary is the only node group. The canary only takes effect when the images of
+// the workloads are upgrading, which is up to the caller to tell.
+func (r *RisingWaveReader) GetCanaryNodeGroup(component string) (string, bool) {
+	canary := r.risingwave.Spec.UpgradePolicy.Canary
+	if canary == nil {
+		return "", false
+	}
+
+	var group *string
+	switch component {
+	case consts.ComponentMeta:
+		group = canary.Meta
+	case consts.ComponentFrontend:
+		group = canary.Frontend
+	case consts.ComponentCompute:
+		group = canary.Compute
+	case consts.ComponentCompactor:
+		group = canary.Compactor
+	case consts.ComponentConnector:
+		group = canary.Connector
+	default:
+		panic("unknown component: " + component)
+	}
+	if group == nil {
+		return "", false
+	}
+
+	nodeGroups := r.GetNodeGroups(component)
+	if len(nodeGroups) < 2 || !lo.ContainsBy(nodeGroups, func(g risingwavev1alpha1.RisingWaveNodeGroup) bool { return g.Name == *group }) {
+		return "", false
+	}
+	return *group, true
+}
+
+// CanarySoakDuration returns the time that the canary node groups must stay ready before the others are upgraded.
+func (r *RisingWaveReader) CanarySoakDuration() time.Duration {
+	canary := r.risingwave.Spec.UpgradePolicy.Canary
+	if canary == nil {
+		return 0
+	}
+	return time.Duration(pointer.Int32Deref(canary.SoakSeconds, 300)) * time.Second
+}
+
+// CanarySoakTime returns the time spent on soaking the canary node groups in the rollout of the current generation,
+// which doesn't count in the progress deadline.
+func (r *RisingWaveReader) CanarySoakTime() time.Duration {
+	rollout := r.risingwave.Status.Rollout
+	if rollout == nil || rollout.Generation != r.risingwave.Generation {
+		return 0
+	}
+
+	soakDuration := r.CanarySoakDuration()
+	soakTime := time.Duration(len(rollout.SoakedCanaryComponents)) * soakDuration
+	if canary := r.risingwave.Status.Canary; canary != nil && canary.ReadyTime != nil &&
+		!lo.Contains(rollout.SoakedCanaryComponents, canary.Component) {
+		soakTime += lo.Min([]time.Duration{time.Since(canary.ReadyTime.Time), soakDuration})
+	}
+	return soakTime
+}
+
 // GetNodeGroups gets the node groups of the given component. It panics when the component is unknown.
 func (r *RisingWaveReader) GetNodeGroups(component string) []risingwavev1alpha1.RisingWaveNodeGroup {
 	switch component {
@@ -105,6 +277,10 @@ type RisingWaveManager struct {
 	mutableRisingWave *risingwavev1alpha1.RisingWave // Mutable copy of original.
 
 	openkruiseAvailable bool // Availability and administrative switch of openkruise
+
+	referencedObjectsHash string // Hash of the referenced ConfigMaps and Secrets observed currently.
+
+	heldNodeGroups map[string]map[string]struct{} // Node groups whose workloads mustn't be synced, by component.
 }
 
 // RisingWaveAfterImage returns a copy of the mutable RisingWave.
@@ -123,6 +299,166 @@ func (mgr *RisingWaveManager) SyncObservedGeneration() {
 	mgr.mutableRisingWave.Status.ObservedGeneration = mgr.mutableRisingWave.Generation
 }
 
+// SyncStoresHash updates the stores hash in the status to the one of current spec.
+func (mgr *RisingWaveManager) SyncStoresHash() {
+	storesHash := mgr.StoresHash()
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	mgr.mutableRisingWave.Status.StoresHash = storesHash
+}
+
+// SyncVersion updates the rolled out version in the status to the one of the current spec, and clears the upgrade
+// and the canary status.
+func (mgr *RisingWaveManager) SyncVersion() {
+	version := mgr.TargetVersion()
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	mgr.mutableRisingWave.Status.RolledOutVersion = version
+	mgr.mutableRisingWave.Status.Upgrade = nil
+	mgr.mutableRisingWave.Status.Canary = nil
+}
+
+// SetCanary records the status of the canary node group of the component being upgraded.
+func (mgr *RisingWaveManager) SetCanary(component, group string, readyTime *metav1.Time) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	mgr.mutableRisingWave.Status.Canary = &risingwavev1alpha1.RisingWaveCanaryStatus{
+		Component: component,
+		Group:     group,
+		ReadyTime: readyTime,
+	}
+}
+
+// SetCanarySoaked records that the canary node group of the component has soaked in the current rollout.
+func (mgr *RisingWaveManager) SetCanarySoaked(component string) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	rollout := mgr.mutableRisingWave.Status.Rollout
+	if rollout != nil && !lo.Contains(rollout.SoakedCanaryComponents, component) {
+		rollout.SoakedCanaryComponents = append(rollout.SoakedCanaryComponents, component)
+	}
+}
+
+// SetUpgradePhase records the current phase of the version upgrade and the components pending in the status.
+func (mgr *RisingWaveManager) SetUpgradePhase(phase risingwavev1alpha1.RisingWaveUpgradePhase, pendingComponents ...string) {
+	targetVersion := mgr.TargetVersion()
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	mgr.mutableRisingWave.Status.Upgrade = &risingwavev1alpha1.RisingWaveUpgradeStatus{
+		TargetVersion:     targetVersion,
+		Phase:             phase,
+		PendingComponents: pendingComponents,
+	}
+}
+
+// SyncRollout starts recording the rollout of the current generation if it isn't being recorded.
+func (mgr *RisingWaveManager) SyncRollout() {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	rollout := mgr.mutableRisingWave.Status.Rollout
+	if rollout == nil || rollout.Generation != mgr.mutableRisingWave.Generation {
+		mgr.mutableRisingWave.Status.Rollout = &risingwavev1alpha1.RisingWaveRolloutStatus{
+			Generation: mgr.mutableRisingWave.Generation,
+			StartTime:  metav1.Now(),
+		}
+	}
+}
+
+// ClearRollout clears the rollout status when the rollout completes.
+func (mgr *RisingWaveManager) ClearRollout() {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	mgr.mutableRisingWave.Status.Rollout = nil
+}
+
+// SetComputeScaleIn records the status of the graceful scale-in of a compute node group. The previous one of
+// the same group is replaced.
+func (mgr *RisingWaveManager) SetComputeScaleIn(scaleIn risingwavev1alpha1.RisingWaveComputeScaleInStatus) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	scaleIns := mgr.mutableRisingWave.Status.ComputeScaleIns
+	for i := range scaleIns {
+		if scaleIns[i].Group == scaleIn.Group {
+			scaleIns[i] = scaleIn
+			return
+		}
+	}
+	mgr.mutableRisingWave.Status.ComputeScaleIns = append(scaleIns, scaleIn)
+}
+
+// RemoveComputeScaleIn removes the status of the graceful scale-in of the given compute node group.
+func (mgr *RisingWaveManager) RemoveComputeScaleIn(group string) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	mgr.mutableRisingWave.Status.ComputeScaleIns = lo.Filter(mgr.mutableRisingWave.Status.ComputeScaleIns,
+		func(scaleIn risingwavev1alpha1.RisingWaveComputeScaleInStatus, _ int) bool {
+			return scaleIn.Group != group
+		})
+}
+
+// SetReferencedObjectsHash sets the hash of the ConfigMaps and Secrets referenced currently.
+func (mgr *RisingWaveManager) SetReferencedObjectsHash(referencedObjectsHash string) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	mgr.referencedObjectsHash = referencedObjectsHash
+}
+
+// IsReferencedObjectsOutdated tells whether the referenced ConfigMaps and Secrets have been changed since
+// the last rollout.
+func (mgr *RisingWaveManager) IsReferencedObjectsOutdated() bool {
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+
+	rolledOutHash := mgr.risingwave.Status.ReferencedObjectsHash
+	return rolledOutHash != "" && mgr.referencedObjectsHash != "" && rolledOutHash != mgr.referencedObjectsHash
+}
+
+// SyncReferencedObjectsHash updates the referenced objects hash in the status to the one observed currently.
+func (mgr *RisingWaveManager) SyncReferencedObjectsHash() {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	if mgr.referencedObjectsHash != "" {
+		mgr.mutableRisingWave.Status.ReferencedObjectsHash = mgr.referencedObjectsHash
+	}
+}
+
+// HoldNodeGroup holds the workload of the node group from being synced in the current reconciliation.
+func (mgr *RisingWaveManager) HoldNodeGroup(component, group string) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	if mgr.heldNodeGroups == nil {
+		mgr.heldNodeGroups = make(map[string]map[string]struct{})
+	}
+	if mgr.heldNodeGroups[component] == nil {
+		mgr.heldNodeGroups[component] = make(map[string]struct{})
+	}
+	mgr.heldNodeGroups[component][group] = struct{}{}
+}
+
+// IsNodeGroupHeld tells whether the workload of the node group is held from being synced.
+func (mgr *RisingWaveManager) IsNodeGroupHeld(component, group string) bool {
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+
+	_, held := mgr.heldNodeGroups[component][group]
+	return held
+}
+
 // RemoveCondition removes the condition if the condition type matches.
 func (mgr *RisingWaveManager) RemoveCondition(conditionType risingwavev1alpha1.RisingWaveConditionType) {
 	mgr.mu.Lock()
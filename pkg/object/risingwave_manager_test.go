@@ -19,13 +19,17 @@ package object
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/samber/lo"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
+	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
 	"github.com/risingwavelabs/risingwave-operator/pkg/testutils"
 )
 
@@ -188,3 +192,232 @@ func Test_RisingWaveManager_UpdateMemoryAndGet(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func Test_RisingWaveManager_StoresHash(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+		S3: &risingwavev1alpha1.RisingWaveStateStoreBackendS3{
+			Bucket: "hummock",
+			RisingWaveS3Credentials: risingwavev1alpha1.RisingWaveS3Credentials{
+				SecretName: "s3-credentials",
+			},
+		},
+	}
+	mgr := NewRisingWaveManager(nil, risingwave, false)
+
+	// Never rolled out before.
+	if mgr.IsStoreCredentialsRotating() {
+		t.Fatal("stores shouldn't be rotating before the hash is recorded")
+	}
+
+	mgr.SyncStoresHash()
+	if mgr.RisingWaveAfterImage().Status.StoresHash != mgr.StoresHash() {
+		t.Fatal("stores hash not synced")
+	}
+
+	// Rolled out with the same stores.
+	risingwave.Status.StoresHash = mgr.StoresHash()
+	if NewRisingWaveReader(risingwave).IsStoreCredentialsRotating() {
+		t.Fatal("stores shouldn't be rotating when hash is the same")
+	}
+
+	// Credentials changed.
+	risingwave.Spec.StateStore.S3.SecretName = "s3-credentials-rotated"
+	if !NewRisingWaveReader(risingwave).IsStoreCredentialsRotating() {
+		t.Fatal("stores should be rotating when credentials are changed")
+	}
+}
+
+func Test_RisingWaveManager_ReferencedObjectsHash(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	mgr := NewRisingWaveManager(nil, risingwave, false)
+
+	// Not collected yet.
+	mgr.SyncReferencedObjectsHash()
+	if mgr.RisingWaveAfterImage().Status.ReferencedObjectsHash != "" {
+		t.Fatal("hash shouldn't be synced before collected")
+	}
+
+	// Never rolled out before.
+	mgr.SetReferencedObjectsHash("a")
+	if mgr.IsReferencedObjectsOutdated() {
+		t.Fatal("referenced objects shouldn't be outdated before the hash is recorded")
+	}
+	mgr.SyncReferencedObjectsHash()
+	if mgr.RisingWaveAfterImage().Status.ReferencedObjectsHash != "a" {
+		t.Fatal("hash not synced")
+	}
+
+	// Rolled out.
+	risingwave.Status.ReferencedObjectsHash = "a"
+	mgr = NewRisingWaveManager(nil, risingwave, false)
+	mgr.SetReferencedObjectsHash("a")
+	if mgr.IsReferencedObjectsOutdated() {
+		t.Fatal("referenced objects shouldn't be outdated when hash is the same")
+	}
+
+	// Changed.
+	mgr.SetReferencedObjectsHash("b")
+	if !mgr.IsReferencedObjectsOutdated() {
+		t.Fatal("referenced objects should be outdated when hash is changed")
+	}
+}
+
+func Test_RisingWaveManager_Version(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.Image = "ghcr.io/risingwavelabs/risingwave:v1.1.0"
+	mgr := NewRisingWaveManager(nil, risingwave, false)
+
+	// Never rolled out before.
+	if mgr.IsVersionUpgrading() {
+		t.Fatal("version shouldn't be upgrading before it's recorded")
+	}
+
+	// Never rolled out before, but the workloads have been created.
+	risingwave.Status.ComponentReplicas.Meta.Groups = []risingwavev1alpha1.ComponentGroupReplicasStatus{
+		{Name: "", Target: 1, Exists: true},
+	}
+	mgr = NewRisingWaveManager(nil, risingwave, false)
+	if !mgr.IsVersionUpgrading() {
+		t.Fatal("version should be upgrading when the workloads exist before the first rollout completes")
+	}
+
+	// Upgrading from v1.0.0.
+	risingwave.Status.RolledOutVersion = "v1.0.0"
+	mgr = NewRisingWaveManager(nil, risingwave, false)
+	if !mgr.IsVersionUpgrading() {
+		t.Fatal("version should be upgrading when it's changed")
+	}
+
+	mgr.SetUpgradePhase(risingwavev1alpha1.RisingWaveUpgradePhaseCompute, "frontend")
+	upgrade := mgr.RisingWaveAfterImage().Status.Upgrade
+	if upgrade == nil || upgrade.TargetVersion != "v1.1.0" || upgrade.Phase != risingwavev1alpha1.RisingWaveUpgradePhaseCompute {
+		t.Fatalf("upgrade status not set: %v", upgrade)
+	}
+
+	mgr.SyncVersion()
+	if status := mgr.RisingWaveAfterImage().Status; status.RolledOutVersion != "v1.1.0" || status.Upgrade != nil {
+		t.Fatal("version not synced")
+	}
+}
+
+func Test_RisingWaveManager_Rollout(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	mgr := NewRisingWaveManager(nil, risingwave, false)
+
+	mgr.SyncRollout()
+	rollout := mgr.RisingWaveAfterImage().Status.Rollout
+	if rollout == nil || rollout.Generation != risingwave.Generation || rollout.StartTime.IsZero() {
+		t.Fatalf("rollout not started: %v", rollout)
+	}
+
+	// Failed in the current generation.
+	risingwave.Status.Rollout = &risingwavev1alpha1.RisingWaveRolloutStatus{Generation: risingwave.Generation, Failed: true}
+	mgr = NewRisingWaveManager(nil, risingwave, false)
+	if !mgr.IsRolloutFailed() {
+		t.Fatal("rollout should be failed")
+	}
+
+	// Failed in the previous generation.
+	risingwave.Generation++
+	mgr = NewRisingWaveManager(nil, risingwave, false)
+	if mgr.IsRolloutFailed() {
+		t.Fatal("rollout of the previous generation shouldn't fail the current one")
+	}
+	mgr.SyncRollout()
+	if rollout := mgr.RisingWaveAfterImage().Status.Rollout; rollout.Generation != risingwave.Generation || rollout.Failed {
+		t.Fatalf("rollout of the current generation not started: %v", rollout)
+	}
+
+	mgr.ClearRollout()
+	if mgr.RisingWaveAfterImage().Status.Rollout != nil {
+		t.Fatal("rollout not cleared")
+	}
+}
+
+func Test_RisingWaveManager_ComputeScaleIns(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Status.ComputeScaleIns = []risingwavev1alpha1.RisingWaveComputeScaleInStatus{
+		{Group: "a", Replicas: 1, Phase: risingwavev1alpha1.RisingWaveComputeScaleInPhaseDraining},
+	}
+	mgr := NewRisingWaveManager(nil, risingwave, false)
+
+	if scaleIn := mgr.GetComputeScaleIn("a"); scaleIn == nil || scaleIn.Replicas != 1 {
+		t.Fatalf("scale-in of group a not found: %v", scaleIn)
+	}
+	if mgr.GetComputeScaleIn("b") != nil {
+		t.Fatal("scale-in of group b shouldn't exist")
+	}
+
+	mgr.SetComputeScaleIn(risingwavev1alpha1.RisingWaveComputeScaleInStatus{
+		Group: "a", Replicas: 1, Phase: risingwavev1alpha1.RisingWaveComputeScaleInPhaseDrained,
+	})
+	mgr.SetComputeScaleIn(risingwavev1alpha1.RisingWaveComputeScaleInStatus{
+		Group: "b", Replicas: 2, Phase: risingwavev1alpha1.RisingWaveComputeScaleInPhaseDraining,
+	})
+	scaleIns := mgr.RisingWaveAfterImage().Status.ComputeScaleIns
+	if len(scaleIns) != 2 || scaleIns[0].Phase != risingwavev1alpha1.RisingWaveComputeScaleInPhaseDrained || scaleIns[1].Group != "b" {
+		t.Fatalf("scale-ins not set: %v", scaleIns)
+	}
+
+	mgr.RemoveComputeScaleIn("a")
+	scaleIns = mgr.RisingWaveAfterImage().Status.ComputeScaleIns
+	if len(scaleIns) != 1 || scaleIns[0].Group != "b" {
+		t.Fatalf("scale-in of group a not removed: %v", scaleIns)
+	}
+}
+
+func Test_RisingWaveReader_GetCanaryNodeGroup(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.Image = "ghcr.io/risingwavelabs/risingwave:v1.1.0"
+	risingwave.Spec.Components.Compute.NodeGroups = append(risingwave.Spec.Components.Compute.NodeGroups,
+		risingwavev1alpha1.RisingWaveNodeGroup{Name: "canary", Replicas: 1})
+	risingwave.Spec.UpgradePolicy.Canary = &risingwavev1alpha1.RisingWaveCanaryUpgradePolicy{
+		Meta:    pointer.String(""),
+		Compute: pointer.String("canary"),
+	}
+
+	reader := NewRisingWaveReader(risingwave)
+	if group, ok := reader.GetCanaryNodeGroup(consts.ComponentCompute); !ok || group != "canary" {
+		t.Fatalf("unexpected canary group of compute: %s", group)
+	}
+	// The canary is the only node group.
+	if _, ok := reader.GetCanaryNodeGroup(consts.ComponentMeta); ok {
+		t.Fatal("canary shouldn't be enabled for the only node group")
+	}
+	if _, ok := reader.GetCanaryNodeGroup(consts.ComponentFrontend); ok {
+		t.Fatal("canary shouldn't be enabled without a canary group")
+	}
+	if reader.CanarySoakDuration() != 300*time.Second {
+		t.Fatalf("unexpected soak duration: %s", reader.CanarySoakDuration())
+	}
+}
+
+func Test_RisingWaveReader_CanarySoakTime(t *testing.T) {
+	risingwave := testutils.FakeRisingWave()
+	risingwave.Spec.UpgradePolicy.Canary = &risingwavev1alpha1.RisingWaveCanaryUpgradePolicy{
+		SoakSeconds: pointer.Int32(300),
+	}
+	if soakTime := NewRisingWaveReader(risingwave).CanarySoakTime(); soakTime != 0 {
+		t.Fatalf("unexpected soak time without a rollout: %s", soakTime)
+	}
+
+	risingwave.Status.Rollout = &risingwavev1alpha1.RisingWaveRolloutStatus{
+		Generation:             risingwave.Generation,
+		StartTime:              metav1.Now(),
+		SoakedCanaryComponents: []string{consts.ComponentMeta, consts.ComponentCompute},
+	}
+	if soakTime := NewRisingWaveReader(risingwave).CanarySoakTime(); soakTime != 600*time.Second {
+		t.Fatalf("unexpected soak time of the soaked canaries: %s", soakTime)
+	}
+
+	// The soaking one counts no more than the soak duration.
+	risingwave.Status.Canary = &risingwavev1alpha1.RisingWaveCanaryStatus{
+		Component: consts.ComponentFrontend,
+		Group:     "canary",
+		ReadyTime: lo.ToPtr(metav1.NewTime(time.Now().Add(-time.Hour))),
+	}
+	if soakTime := NewRisingWaveReader(risingwave).CanarySoakTime(); soakTime != 900*time.Second {
+		t.Fatalf("unexpected soak time with a soaking canary: %s", soakTime)
+	}
+}
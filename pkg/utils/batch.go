@@ -0,0 +1,44 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func isJobConditionTrue(job *batchv1.Job, condType batchv1.JobConditionType) bool {
+	if job == nil {
+		return false
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == condType {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// IsJobCompleted returns true when the Job has completed successfully.
+func IsJobCompleted(job *batchv1.Job) bool {
+	return isJobConditionTrue(job, batchv1.JobComplete)
+}
+
+// IsJobFailed returns true when the Job has failed and won't be retried any more.
+func IsJobFailed(job *batchv1.Job) bool {
+	return isJobConditionTrue(job, batchv1.JobFailed)
+}
@@ -21,6 +21,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -53,6 +54,16 @@ func GetVersionFromImage(image string) string {
 	return image[lastRepoIdx+lastTagIdx+2:]
 }
 
+// IsVersionAtLeast tells whether the version is a semantic version no earlier than the minimum one. False is returned
+// when the version isn't a semantic one, e.g., latest and the nightly builds.
+func IsVersionAtLeast(version, minVersion string) bool {
+	v, err := utilversion.ParseSemantic(version)
+	if err != nil {
+		return false
+	}
+	return v.AtLeast(utilversion.MustParseSemantic(minVersion))
+}
+
 // GetContainerFromPod gets a pointer to the container with the same name. Nil is returned when
 // the container isn't found.
 func GetContainerFromPod(pod *corev1.Pod, name string) *corev1.Container {
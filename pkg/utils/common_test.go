@@ -73,3 +73,46 @@ func Test_CommonGetVersionFromImage(t *testing.T) {
 		})
 	}
 }
+
+func Test_CommonIsVersionAtLeast(t *testing.T) {
+	testcases := map[string]struct {
+		version string
+		atLeast bool
+	}{
+		"equal": {
+			version: "v2.0.0",
+			atLeast: true,
+		},
+		"later": {
+			version: "v2.1.3",
+			atLeast: true,
+		},
+		"without-v": {
+			version: "2.0.1",
+			atLeast: true,
+		},
+		"earlier": {
+			version: "v1.10.0",
+			atLeast: false,
+		},
+		"pre-release": {
+			version: "v2.0.0-rc.1",
+			atLeast: false,
+		},
+		"latest": {
+			version: "latest",
+			atLeast: false,
+		},
+		"nightly": {
+			version: "nightly-20240101",
+			atLeast: false,
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			if IsVersionAtLeast(tc.version, "v2.0.0") != tc.atLeast {
+				t.Fatalf("unexpected result of %s, expect at least v2.0.0: %v", tc.version, tc.atLeast)
+			}
+		})
+	}
+}
@@ -15,3 +15,105 @@
  */
 
 package utils
+
+import (
+	"sort"
+
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// GetReferencedConfigMapsAndSecrets returns the sorted names of the ConfigMaps and Secrets referenced by the
+// volumes and the env vars of all containers in the Pod spec.
+func GetReferencedConfigMapsAndSecrets(podSpec *corev1.PodSpec) (configMaps []string, secrets []string) {
+	configMapSet, secretSet := make(map[string]struct{}), make(map[string]struct{})
+
+	for _, volume := range podSpec.Volumes {
+		if volume.ConfigMap != nil {
+			configMapSet[volume.ConfigMap.Name] = struct{}{}
+		}
+		if volume.Secret != nil {
+			secretSet[volume.Secret.SecretName] = struct{}{}
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					configMapSet[source.ConfigMap.Name] = struct{}{}
+				}
+				if source.Secret != nil {
+					secretSet[source.Secret.Name] = struct{}{}
+				}
+			}
+		}
+	}
+
+	containers := append(append([]corev1.Container{}, podSpec.InitContainers...), podSpec.Containers...)
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				configMapSet[envFrom.ConfigMapRef.Name] = struct{}{}
+			}
+			if envFrom.SecretRef != nil {
+				secretSet[envFrom.SecretRef.Name] = struct{}{}
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				configMapSet[env.ValueFrom.ConfigMapKeyRef.Name] = struct{}{}
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				secretSet[env.ValueFrom.SecretKeyRef.Name] = struct{}{}
+			}
+		}
+	}
+
+	configMaps, secrets = lo.Keys(configMapSet), lo.Keys(secretSet)
+	sort.Strings(configMaps)
+	sort.Strings(secrets)
+
+	return configMaps, secrets
+}
+
+// Reasons of the pod failures.
+const (
+	PodFailureReasonCrashLoopBackOff = "CrashLoopBackOff"
+	PodFailureReasonImagePullBackOff = "ImagePullBackOff"
+	PodFailureReasonOOMKilled        = "OOMKilled"
+	PodFailureReasonUnschedulable    = "Unschedulable"
+)
+
+// GetPodFailure returns the reason and the message of the failure of the Pod, if it's unschedulable or any of
+// the containers is crash looping, failing to pull the image or killed because of OOM. A crash loop caused by
+// OOM is reported as OOMKilled. Empty strings are returned when no failure is found.
+func GetPodFailure(pod *corev1.Pod) (reason string, message string) {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable {
+			return PodFailureReasonUnschedulable, cond.Message
+		}
+	}
+
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if terminated := status.State.Terminated; terminated != nil && terminated.Reason == PodFailureReasonOOMKilled {
+			return PodFailureReasonOOMKilled, "container " + status.Name + " was killed because of OOM"
+		}
+
+		waiting := status.State.Waiting
+		if waiting == nil {
+			continue
+		}
+		switch waiting.Reason {
+		case PodFailureReasonCrashLoopBackOff:
+			if lastTerminated := status.LastTerminationState.Terminated; lastTerminated != nil && lastTerminated.Reason == PodFailureReasonOOMKilled {
+				return PodFailureReasonOOMKilled, "container " + status.Name + " was killed because of OOM and is restarting"
+			}
+			return PodFailureReasonCrashLoopBackOff, "container " + status.Name + " is crash looping: " + waiting.Message
+		case PodFailureReasonImagePullBackOff, "ErrImagePull", "InvalidImageName":
+			return PodFailureReasonImagePullBackOff, "container " + status.Name + " is unable to pull the image: " + waiting.Message
+		}
+	}
+
+	return "", ""
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_GetReferencedConfigMapsAndSecrets(t *testing.T) {
+	podSpec := &corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{
+				Name: "config",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "config"},
+					},
+				},
+			},
+			{
+				Name: "tls",
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{
+							{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "tls"}}},
+							{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "ca"}}},
+						},
+					},
+				},
+			},
+			{
+				Name: "empty",
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			},
+		},
+		InitContainers: []corev1.Container{
+			{
+				EnvFrom: []corev1.EnvFromSource{
+					{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "init"}}},
+				},
+			},
+		},
+		Containers: []corev1.Container{
+			{
+				Env: []corev1.EnvVar{
+					{Name: "A", Value: "a"},
+					{Name: "B", ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "b"},
+					}},
+					{Name: "C", ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "c"},
+					}},
+					{Name: "D", ValueFrom: &corev1.EnvVarSource{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "env"}, Key: "d"},
+					}},
+				},
+			},
+		},
+	}
+
+	configMaps, secrets := GetReferencedConfigMapsAndSecrets(podSpec)
+	if !reflect.DeepEqual(configMaps, []string{"ca", "config", "env"}) {
+		t.Fatalf("unexpected config maps: %v", configMaps)
+	}
+	if !reflect.DeepEqual(secrets, []string{"credentials", "init", "tls"}) {
+		t.Fatalf("unexpected secrets: %v", secrets)
+	}
+}
+
+func Test_GetPodFailure(t *testing.T) {
+	testcases := map[string]struct {
+		status corev1.PodStatus
+		reason string
+	}{
+		"running": {
+			status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "compute", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				},
+			},
+			reason: "",
+		},
+		"unschedulable": {
+			status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{
+					{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable, Message: "0/3 nodes are available"},
+				},
+			},
+			reason: PodFailureReasonUnschedulable,
+		},
+		"crash-loop-back-off": {
+			status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "compute", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+				},
+			},
+			reason: PodFailureReasonCrashLoopBackOff,
+		},
+		"crash-loop-back-off-oom-killed": {
+			status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:                 "compute",
+						State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+						LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}},
+					},
+				},
+			},
+			reason: PodFailureReasonOOMKilled,
+		},
+		"oom-killed": {
+			status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "compute", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}}},
+				},
+			},
+			reason: PodFailureReasonOOMKilled,
+		},
+		"err-image-pull": {
+			status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "compute", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"}}},
+				},
+			},
+			reason: PodFailureReasonImagePullBackOff,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			reason, _ := GetPodFailure(&corev1.Pod{Status: tc.status})
+			if reason != tc.reason {
+				t.Fatalf("unexpected reason, expect: %s, got: %s", tc.reason, reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+)
+
+func Test_HashObject(t *testing.T) {
+	type obj struct {
+		A string `json:"a"`
+		B int    `json:"b"`
+	}
+
+	h1, err := HashObject(obj{A: "a", B: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2, _ := HashObject(obj{A: "a", B: 1})
+	h3, _ := HashObject(obj{A: "a", B: 2})
+
+	if h1 != h2 {
+		t.Fatal("hashes of equal objects should be the same")
+	}
+	if h1 == h3 {
+		t.Fatal("hashes of different objects should be different")
+	}
+
+	if _, err := HashObject(func() {}); err == nil {
+		t.Fatal("expect an error when the object can't be marshaled")
+	}
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"github.com/pelletier/go-toml/v2"
+)
+
+// ParseTOML parses the TOML document into a map of tables and values.
+func ParseTOML(doc string) (map[string]any, error) {
+	r := make(map[string]any)
+	if err := toml.Unmarshal([]byte(doc), &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func mergeTOMLTables(dst, src map[string]any) {
+	for k, v := range src {
+		srcTable, srcIsTable := v.(map[string]any)
+		dstTable, dstIsTable := dst[k].(map[string]any)
+		if srcIsTable && dstIsTable {
+			mergeTOMLTables(dstTable, srcTable)
+		} else {
+			dst[k] = v
+		}
+	}
+}
+
+// MergeTOML deep merges the TOML documents in order and returns the merged one. Tables are merged recursively
+// and the other values in the latter documents take precedence. Keys in the result are sorted.
+func MergeTOML(docs ...string) (string, error) {
+	merged := make(map[string]any)
+	for _, doc := range docs {
+		r, err := ParseTOML(doc)
+		if err != nil {
+			return "", err
+		}
+		mergeTOMLTables(merged, r)
+	}
+
+	data, err := toml.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+)
+
+func Test_MergeTOML(t *testing.T) {
+	testcases := map[string]struct {
+		docs     []string
+		expected string
+		err      bool
+	}{
+		"empty": {
+			docs:     nil,
+			expected: "",
+		},
+		"override-values": {
+			docs: []string{
+				"[server]\nheartbeat_interval_ms = 1000\nmetrics_level = 'Info'\n",
+				"[server]\nheartbeat_interval_ms = 2000\n",
+			},
+			expected: "[server]\nheartbeat_interval_ms = 2000\nmetrics_level = 'Info'\n",
+		},
+		"merge-nested-tables": {
+			docs: []string{
+				"[storage.data_file_cache]\ndir = '/data'\n[streaming]\nin_flight_barrier_nums = 10\n",
+				"[storage]\nblock_cache_capacity_mb = 512\n[storage.data_file_cache]\ncapacity_mb = 1024\n",
+			},
+			expected: "[storage]\nblock_cache_capacity_mb = 512\n\n[storage.data_file_cache]\ncapacity_mb = 1024\ndir = '/data'\n\n[streaming]\nin_flight_barrier_nums = 10\n",
+		},
+		"syntax-error": {
+			docs: []string{"[server"},
+			err:  true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			r, err := MergeTOML(tc.docs...)
+			if tc.err {
+				if err == nil {
+					t.Fatal("expect an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r != tc.expected {
+				t.Fatalf("unexpected result, expect %q, but got %q", tc.expected, r)
+			}
+		})
+	}
+}
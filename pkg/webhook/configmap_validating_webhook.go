@@ -0,0 +1,134 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+	"github.com/risingwavelabs/risingwave-operator/pkg/factory/envs"
+	"github.com/risingwavelabs/risingwave-operator/pkg/metrics"
+	"github.com/risingwavelabs/risingwave-operator/pkg/utils"
+)
+
+// Known top-level sections of the risingwave.toml.
+var risingWaveConfigSections = []string{"batch", "meta", "server", "storage", "streaming", "system"}
+
+// Keys in the risingwave.toml that override the values derived from the env vars managed by the operator.
+var risingWaveConfigKeysConflictingWithEnvs = map[string]string{
+	"storage.block_cache_capacity_mb":            envs.RWTotalMemoryBytes,
+	"storage.meta_cache_capacity_mb":             envs.RWTotalMemoryBytes,
+	"storage.shared_buffer_capacity_mb":          envs.RWTotalMemoryBytes,
+	"storage.compactor_memory_limit_mb":          envs.RWTotalMemoryBytes,
+	"streaming.actor_runtime_worker_threads_num": envs.RWParallelism,
+}
+
+func hasTOMLKey(config map[string]any, table, key string) bool {
+	t, ok := config[table].(map[string]any)
+	if !ok {
+		return false
+	}
+	_, ok = t[key]
+	return ok
+}
+
+// validateRisingWaveConfig parses the content of the risingwave.toml. It returns errors on syntax errors and unknown
+// top-level sections, and warnings on keys that conflict with the env vars managed by the operator.
+func validateRisingWaveConfig(path *field.Path, key, content string) (admission.Warnings, field.ErrorList) {
+	config, err := utils.ParseTOML(content)
+	if err != nil {
+		return nil, field.ErrorList{field.Invalid(path, key, fmt.Sprintf("invalid toml: %s", err))}
+	}
+
+	fieldErrs := field.ErrorList{}
+	sections := lo.Keys(config)
+	sort.Strings(sections)
+	for _, section := range sections {
+		if !slices.Contains(risingWaveConfigSections, section) {
+			fieldErrs = append(fieldErrs, field.NotSupported(path.Key(section), section, risingWaveConfigSections))
+		}
+	}
+
+	var warnings admission.Warnings
+	conflictKeys := lo.Keys(risingWaveConfigKeysConflictingWithEnvs)
+	sort.Strings(conflictKeys)
+	for _, k := range conflictKeys {
+		table, name, _ := strings.Cut(k, ".")
+		if hasTOMLKey(config, table, name) {
+			warnings = append(warnings, fmt.Sprintf("%s: %s overrides the value derived from %s, which is managed by the operator",
+				path, k, risingWaveConfigKeysConflictingWithEnvs[k]))
+		}
+	}
+
+	return warnings, fieldErrs
+}
+
+// ConfigMapValidatingWebhook is the validating webhook for the ConfigMaps labeled as RisingWave configurations.
+type ConfigMapValidatingWebhook struct{}
+
+func (w *ConfigMapValidatingWebhook) validateObject(obj *corev1.ConfigMap) (admission.Warnings, error) {
+	key, ok := obj.Labels[consts.LabelRisingWaveConfig]
+	if !ok || isBypassed(obj) {
+		return nil, nil
+	}
+
+	path := field.NewPath("data").Key(key)
+	content, ok := obj.Data[key]
+	if !ok {
+		return nil, apierrors.NewInvalid(obj.GroupVersionKind().GroupKind(), obj.Name, field.ErrorList{
+			field.Required(path, fmt.Sprintf("must exist as labeled by %s", consts.LabelRisingWaveConfig)),
+		})
+	}
+
+	warnings, fieldErrs := validateRisingWaveConfig(path, key, content)
+	if len(fieldErrs) > 0 {
+		return warnings, apierrors.NewInvalid(obj.GroupVersionKind().GroupKind(), obj.Name, fieldErrs)
+	}
+	return warnings, nil
+}
+
+// ValidateCreate implements the webhook.CustomValidator.
+func (w *ConfigMapValidatingWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	return w.validateObject(obj.(*corev1.ConfigMap))
+}
+
+// ValidateUpdate implements the webhook.CustomValidator.
+func (w *ConfigMapValidatingWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	return w.validateObject(newObj.(*corev1.ConfigMap))
+}
+
+// ValidateDelete implements the webhook.CustomValidator.
+func (w *ConfigMapValidatingWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	return nil, nil
+}
+
+// NewConfigMapValidatingWebhook returns a new validator for the ConfigMaps labeled as RisingWave configurations.
+func NewConfigMapValidatingWebhook() webhook.CustomValidator {
+	return metrics.NewValidatingWebhookMetricsRecorder(&ConfigMapValidatingWebhook{})
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright 2023 RisingWave Labs
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
+)
+
+func Test_ConfigMapValidatingWebhook(t *testing.T) {
+	testcases := map[string]struct {
+		labels      map[string]string
+		annotations map[string]string
+		data        map[string]string
+		pass        bool
+		warnings    int
+	}{
+		"not-labeled": {
+			data: map[string]string{"risingwave.toml": "[server"},
+			pass: true,
+		},
+		"valid": {
+			labels: map[string]string{consts.LabelRisingWaveConfig: "risingwave.toml"},
+			data:   map[string]string{"risingwave.toml": "[server]\nheartbeat_interval_ms = 1000\n"},
+			pass:   true,
+		},
+		"key-not-found": {
+			labels: map[string]string{consts.LabelRisingWaveConfig: "config.toml"},
+			data:   map[string]string{"risingwave.toml": ""},
+			pass:   false,
+		},
+		"syntax-error": {
+			labels: map[string]string{consts.LabelRisingWaveConfig: "risingwave.toml"},
+			data:   map[string]string{"risingwave.toml": "[server"},
+			pass:   false,
+		},
+		"unknown-section": {
+			labels: map[string]string{consts.LabelRisingWaveConfig: "risingwave.toml"},
+			data:   map[string]string{"risingwave.toml": "[servers]\n"},
+			pass:   false,
+		},
+		"conflicting-key": {
+			labels:   map[string]string{consts.LabelRisingWaveConfig: "risingwave.toml"},
+			data:     map[string]string{"risingwave.toml": "[storage]\nshared_buffer_capacity_mb = 1024\n"},
+			pass:     true,
+			warnings: 1,
+		},
+		"bypassed": {
+			labels:      map[string]string{consts.LabelRisingWaveConfig: "risingwave.toml"},
+			annotations: map[string]string{consts.AnnotationBypassValidatingWebhook: "true"},
+			data:        map[string]string{"risingwave.toml": "[server"},
+			pass:        true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Name:        "config",
+					Labels:      tc.labels,
+					Annotations: tc.annotations,
+				},
+				Data: tc.data,
+			}
+
+			webhook := NewConfigMapValidatingWebhook()
+			warnings, err := webhook.ValidateCreate(context.Background(), cm)
+			if tc.pass != (err == nil) {
+				t.Fatal(tc.pass, err)
+			}
+			assert.Len(t, warnings, tc.warnings)
+
+			_, err = webhook.ValidateUpdate(context.Background(), &corev1.ConfigMap{}, cm)
+			if tc.pass != (err == nil) {
+				t.Fatal(tc.pass, err)
+			}
+
+			_, err = webhook.ValidateDelete(context.Background(), cm)
+			assert.Nil(t, err)
+		})
+	}
+}
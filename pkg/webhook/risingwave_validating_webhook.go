@@ -29,12 +29,14 @@ import (
 	"github.com/risingwavelabs/risingwave-operator/pkg/factory/envs"
 
 	"github.com/distribution/distribution/reference"
+	"github.com/pelletier/go-toml/v2"
 	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
@@ -42,11 +44,13 @@ import (
 
 	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
 	"github.com/risingwavelabs/risingwave-operator/pkg/metrics"
+	"github.com/risingwavelabs/risingwave-operator/pkg/object"
 	"github.com/risingwavelabs/risingwave-operator/pkg/scaleview"
 )
 
 // RisingWaveValidatingWebhook is the validating webhook for RisingWaves.
 type RisingWaveValidatingWebhook struct {
+	client              client.Reader
 	openKruiseAvailable bool
 }
 
@@ -64,7 +68,7 @@ var systemEnv = map[string]bool{
 	envs.JavaOpts:               true,
 }
 
-func (v *RisingWaveValidatingWebhook) isBypassed(obj client.Object) bool {
+func isBypassed(obj client.Object) bool {
 	val, ok := obj.GetAnnotations()[consts.AnnotationBypassValidatingWebhook]
 	if !ok {
 		return false
@@ -121,6 +125,16 @@ func (v *RisingWaveValidatingWebhook) validateNodeGroup(path *field.Path, nodeGr
 		}
 	}
 
+	// Validate the PodDisruptionBudget.
+	if pdb := nodeGroup.PodDisruptionBudget; pdb != nil && pdb.MinAvailable != nil && pdb.MaxUnavailable != nil {
+		fieldErrs = append(fieldErrs, field.Forbidden(path.Child("podDisruptionBudget", "maxUnavailable"), "must be nil when minAvailable is set"))
+	}
+
+	// Validate the configuration.
+	if nodeGroup.Configuration != nil {
+		fieldErrs = append(fieldErrs, v.validateConfiguration(path.Child("configuration"), nodeGroup.Configuration)...)
+	}
+
 	// Validate labels of the RisingWave's Pods
 	for label := range nodeGroup.Template.ObjectMeta.Labels {
 		if strings.HasPrefix(label, "risingwave/") {
@@ -160,23 +174,191 @@ func ptrValueNotZero[T comparable](ptr *T) bool {
 	return ptr != nil && *ptr != zero
 }
 
-func (v *RisingWaveValidatingWebhook) validateMetaStoreAndStateStore(path *field.Path, metaStore *risingwavev1alpha1.RisingWaveMetaStoreBackend, stateStore *risingwavev1alpha1.RisingWaveStateStoreBackend) field.ErrorList {
+func ptrValueNotEmpty[T any](ptr *T) bool {
+	var zero T
+	return ptr != nil && !equality.Semantic.DeepEqual(*ptr, zero)
+}
+
+func validateSQLMetaStore(path *field.Path, host, database string, credentials *risingwavev1alpha1.RisingWaveDBCredentials) field.ErrorList {
 	fieldErrs := field.ErrorList{}
 
-	isMetaMemory, isMetaEtcd := ptrValueNotZero(metaStore.Memory), ptrValueNotZero(metaStore.Etcd)
-	if isMetaMemory {
-		if isMetaEtcd {
-			fieldErrs = append(fieldErrs, field.Forbidden(path.Child("metaStore", "etcd"), "must not specified when type is memory"))
+	if host == "" {
+		fieldErrs = append(fieldErrs, field.Required(path.Child("host"), "host is required"))
+	}
+	if database == "" {
+		fieldErrs = append(fieldErrs, field.Required(path.Child("database"), "database is required"))
+	}
+	if credentials.SecretName == "" {
+		fieldErrs = append(fieldErrs, field.Required(path.Child("credentials", "secretName"), "secretName is required"))
+	}
+
+	return fieldErrs
+}
+
+func validateSecretKeySelector(path *field.Path, selector *corev1.SecretKeySelector) field.ErrorList {
+	fieldErrs := field.ErrorList{}
+
+	if selector.Name == "" {
+		fieldErrs = append(fieldErrs, field.Required(path.Child("name"), "name is required"))
+	}
+	if selector.Key == "" {
+		fieldErrs = append(fieldErrs, field.Required(path.Child("key"), "key is required"))
+	}
+
+	return fieldErrs
+}
+
+func validateEtcdTLS(path *field.Path, tls *risingwavev1alpha1.RisingWaveEtcdTLS) field.ErrorList {
+	fieldErrs := field.ErrorList{}
+
+	if tls.CA != nil {
+		fieldErrs = append(fieldErrs, validateSecretKeySelector(path.Child("ca"), tls.CA)...)
+	}
+
+	// Client certificate and key must be provided in pairs.
+	if (tls.ClientCert == nil) != (tls.ClientKey == nil) {
+		fieldErrs = append(fieldErrs, field.Invalid(path, tls, "clientCert and clientKey must be specified together"))
+	}
+	if tls.ClientCert != nil {
+		fieldErrs = append(fieldErrs, validateSecretKeySelector(path.Child("clientCert"), tls.ClientCert)...)
+	}
+	if tls.ClientKey != nil {
+		fieldErrs = append(fieldErrs, validateSecretKeySelector(path.Child("clientKey"), tls.ClientKey)...)
+	}
+
+	return fieldErrs
+}
+
+func validateEtcd(path *field.Path, etcd *risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd) field.ErrorList {
+	fieldErrs := field.ErrorList{}
+
+	if etcd.Managed != nil {
+		// The managed etcd is wired in automatically without authentication and TLS.
+		if etcd.Endpoint != "" {
+			fieldErrs = append(fieldErrs, field.Forbidden(path.Child("endpoint"), "must not be specified when etcd is managed"))
 		}
-	} else {
-		if !isMetaEtcd {
-			fieldErrs = append(fieldErrs, field.Invalid(path.Child("metaStore"), metaStore, "either memory or etcd must be specified"))
+		if etcd.RisingWaveEtcdCredentials != nil || etcd.Secret != "" {
+			fieldErrs = append(fieldErrs, field.Forbidden(path.Child("credentials"), "must not be specified when etcd is managed"))
+		}
+		if etcd.TLS != nil {
+			fieldErrs = append(fieldErrs, field.Forbidden(path.Child("tls"), "must not be specified when etcd is managed"))
+		}
+		if etcd.Managed.Image != "" && !isImageValid(etcd.Managed.Image) {
+			fieldErrs = append(fieldErrs, field.Invalid(path.Child("managed", "image"), etcd.Managed.Image, "invalid image reference"))
+		}
+		if etcd.Managed.StorageSize.Sign() <= 0 {
+			fieldErrs = append(fieldErrs, field.Invalid(path.Child("managed", "storageSize"), etcd.Managed.StorageSize.String(), "must be positive"))
+		}
+		return fieldErrs
+	}
+
+	if etcd.Endpoint == "" {
+		fieldErrs = append(fieldErrs, field.Required(path.Child("endpoint"), "endpoint is required"))
+	}
+	if etcd.TLS != nil {
+		fieldErrs = append(fieldErrs, validateEtcdTLS(path.Child("tls"), etcd.TLS)...)
+	}
+
+	return fieldErrs
+}
+
+func validateMinIO(path *field.Path, minio *risingwavev1alpha1.RisingWaveStateStoreBackendMinIO) field.ErrorList {
+	fieldErrs := field.ErrorList{}
+
+	if minio.Managed != nil {
+		// The credentials of the managed MinIO are generated by the operator.
+		if minio.Endpoint != "" {
+			fieldErrs = append(fieldErrs, field.Forbidden(path.Child("endpoint"), "must not be specified when minio is managed"))
+		}
+		if minio.SecretName != "" {
+			fieldErrs = append(fieldErrs, field.Forbidden(path.Child("credentials", "secretName"), "must not be specified when minio is managed"))
+		}
+		if minio.Managed.Image != "" && !isImageValid(minio.Managed.Image) {
+			fieldErrs = append(fieldErrs, field.Invalid(path.Child("managed", "image"), minio.Managed.Image, "invalid image reference"))
 		}
+		if minio.Managed.ClientImage != "" && !isImageValid(minio.Managed.ClientImage) {
+			fieldErrs = append(fieldErrs, field.Invalid(path.Child("managed", "clientImage"), minio.Managed.ClientImage, "invalid image reference"))
+		}
+		// Distributed MinIO requires at least 4 drives for erasure coding.
+		if minio.Managed.Replicas > 1 && minio.Managed.Replicas < 4 {
+			fieldErrs = append(fieldErrs, field.Invalid(path.Child("managed", "replicas"), minio.Managed.Replicas, "must be either 1 or no less than 4"))
+		}
+		if minio.Managed.StorageSize.Sign() <= 0 {
+			fieldErrs = append(fieldErrs, field.Invalid(path.Child("managed", "storageSize"), minio.Managed.StorageSize.String(), "must be positive"))
+		}
+		return fieldErrs
+	}
+
+	if minio.Endpoint == "" {
+		fieldErrs = append(fieldErrs, field.Required(path.Child("endpoint"), "endpoint is required"))
+	}
+	if minio.SecretName == "" {
+		fieldErrs = append(fieldErrs, field.Required(path.Child("credentials", "secretName"), "secretName is required"))
+	}
+
+	return fieldErrs
+}
+
+func validateS3Compatible(path *field.Path, s3Compatible *risingwavev1alpha1.RisingWaveStateStoreBackendS3Compatible) field.ErrorList {
+	fieldErrs := field.ErrorList{}
+
+	if pointer.BoolDeref(s3Compatible.UseServiceAccount, false) {
+		fieldErrs = append(fieldErrs, field.Forbidden(path.Child("credentials", "useServiceAccount"), "service account is only supported by AWS S3"))
+	}
+	if s3Compatible.SecretName == "" {
+		fieldErrs = append(fieldErrs, field.Required(path.Child("credentials", "secretName"), "secretName is required"))
+	}
+
+	// Only the providers of public clouds have the well-known endpoints.
+	hasWellKnownEndpoint := s3Compatible.Provider == risingwavev1alpha1.RisingWaveS3CompatibleProviderHuaweiOBS ||
+		s3Compatible.Provider == risingwavev1alpha1.RisingWaveS3CompatibleProviderTencentCOS
+	if !hasWellKnownEndpoint && s3Compatible.Endpoint == "" {
+		fieldErrs = append(fieldErrs, field.Required(path.Child("endpoint"), "endpoint is required"))
+	}
+
+	if s3Compatible.CA != nil {
+		fieldErrs = append(fieldErrs, validateSecretKeySelector(path.Child("ca"), s3Compatible.CA)...)
+	}
+
+	return fieldErrs
+}
+
+func (v *RisingWaveValidatingWebhook) validateMetaStoreAndStateStore(path *field.Path, metaStore *risingwavev1alpha1.RisingWaveMetaStoreBackend, stateStore *risingwavev1alpha1.RisingWaveStateStoreBackend) field.ErrorList {
+	fieldErrs := field.ErrorList{}
+
+	isMetaMemory, isMetaEtcd := ptrValueNotZero(metaStore.Memory), ptrValueNotZero(metaStore.Etcd)
+	isMetaPostgreSQL, isMetaMySQL := ptrValueNotEmpty(metaStore.PostgreSQL), ptrValueNotEmpty(metaStore.MySQL)
+	isMetaSQLite := ptrValueNotZero(metaStore.SQLite)
+
+	validMetaStoreTypeCount := lo.CountBy([]bool{isMetaMemory, isMetaEtcd, isMetaPostgreSQL, isMetaMySQL, isMetaSQLite}, func(x bool) bool { return x })
+	if validMetaStoreTypeCount == 0 {
+		fieldErrs = append(fieldErrs, field.Invalid(path.Child("metaStore"), metaStore, "one of memory, etcd, postgresql, mysql and sqlite must be specified"))
+	} else if validMetaStoreTypeCount > 1 {
+		fieldErrs = append(fieldErrs, field.Invalid(path.Child("metaStore"), metaStore, "multiple meta store types"))
+	}
+
+	if isMetaEtcd {
+		fieldErrs = append(fieldErrs, validateEtcd(path.Child("metaStore", "etcd"), metaStore.Etcd)...)
+	}
+
+	if isMetaPostgreSQL {
+		postgresql := metaStore.PostgreSQL
+		fieldErrs = append(fieldErrs, validateSQLMetaStore(path.Child("metaStore", "postgresql"), postgresql.Host, postgresql.Database, &postgresql.RisingWaveDBCredentials)...)
+	}
+
+	if isMetaMySQL {
+		mysql := metaStore.MySQL
+		fieldErrs = append(fieldErrs, validateSQLMetaStore(path.Child("metaStore", "mysql"), mysql.Host, mysql.Database, &mysql.RisingWaveDBCredentials)...)
+	}
+
+	if isMetaSQLite && !strings.HasPrefix(metaStore.SQLite.Path, "/") {
+		fieldErrs = append(fieldErrs, field.Invalid(path.Child("metaStore", "sqlite", "path"), metaStore.SQLite.Path, "must be an absolute path"))
 	}
 
 	isStateMemory := ptrValueNotZero(stateStore.Memory)
 	isStateMinIO := stateStore.MinIO != nil
 	isStateS3 := stateStore.S3 != nil
+	isStateS3Compatible := stateStore.S3Compatible != nil
 	isStateGCS := stateStore.GCS != nil
 	isStateAliyunOSS := stateStore.AliyunOSS != nil
 	isStateAzureBlob := stateStore.AzureBlob != nil
@@ -198,13 +380,21 @@ func (v *RisingWaveValidatingWebhook) validateMetaStoreAndStateStore(path *field
 		}
 	}
 
+	if isStateS3Compatible {
+		fieldErrs = append(fieldErrs, validateS3Compatible(path.Child("stateStore", "s3Compatible"), stateStore.S3Compatible)...)
+	}
+
+	if isStateMinIO {
+		fieldErrs = append(fieldErrs, validateMinIO(path.Child("stateStore", "minio"), stateStore.MinIO)...)
+	}
+
 	if isStateGCS {
 		if !pointer.BoolDeref(stateStore.GCS.UseWorkloadIdentity, false) && (stateStore.GCS.RisingWaveGCSCredentials.SecretName == "") {
 			fieldErrs = append(fieldErrs, field.Invalid(path.Child("stateStore", "gcs", "credentials"), stateStore.GCS.RisingWaveGCSCredentials.SecretName, "either secretName or useWorkloadIdentity must be specified"))
 		}
 	}
 
-	validStateStoreTypeCount := lo.CountBy([]bool{isStateMemory, isStateMinIO, isStateS3, isStateGCS, isStateAliyunOSS, isStateAzureBlob, isStateHDFS, isStateWebHDFS, isStateLocalDisk}, func(x bool) bool { return x })
+	validStateStoreTypeCount := lo.CountBy([]bool{isStateMemory, isStateMinIO, isStateS3, isStateS3Compatible, isStateGCS, isStateAliyunOSS, isStateAzureBlob, isStateHDFS, isStateWebHDFS, isStateLocalDisk}, func(x bool) bool { return x })
 	if validStateStoreTypeCount == 0 {
 		fieldErrs = append(fieldErrs, field.Invalid(path.Child("stateStore"), stateStore, "must configure the state store"))
 	} else if validStateStoreTypeCount > 1 {
@@ -221,7 +411,7 @@ func (v *RisingWaveValidatingWebhook) validateMetaStoreAndStateStore(path *field
 	return fieldErrs
 }
 
-func (v *RisingWaveValidatingWebhook) validateConfiguration(path *field.Path, configuration *risingwavev1alpha1.RisingWaveConfigurationSpec) field.ErrorList {
+func (v *RisingWaveValidatingWebhook) validateConfiguration(path *field.Path, configuration *risingwavev1alpha1.RisingWaveNodeConfiguration) field.ErrorList {
 	if configuration.ConfigMap != nil {
 		if configuration.ConfigMap.Name == "" {
 			return field.ErrorList{
@@ -233,9 +423,70 @@ func (v *RisingWaveValidatingWebhook) validateConfiguration(path *field.Path, co
 			}
 		}
 	}
+	if configuration.Secret != nil && configuration.Settings != nil {
+		return field.ErrorList{
+			field.Forbidden(path.Child("settings"), "must not be used together with a secret"),
+		}
+	}
 	return nil
 }
 
+// validateConfigurationContent fetches the referenced ConfigMap or Secret and validates the content of the
+// risingwave.toml, along with the typed settings. A missing ConfigMap or Secret only leads to a warning because
+// it could be created after the RisingWave.
+func (v *RisingWaveValidatingWebhook) validateConfigurationContent(ctx context.Context, path *field.Path, namespace string, configuration *risingwavev1alpha1.RisingWaveNodeConfiguration) (admission.Warnings, field.ErrorList) {
+	var warnings admission.Warnings
+	fieldErrs := field.ErrorList{}
+
+	validateContent := func(path *field.Path, kind, name, key string, optional *bool, getContent func(obj client.Object) (string, bool), obj client.Object) {
+		err := v.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, obj)
+		if err != nil && !apierrors.IsNotFound(err) {
+			fieldErrs = append(fieldErrs, field.InternalError(path, err))
+			return
+		}
+
+		content, found := "", false
+		if err == nil {
+			content, found = getContent(obj)
+		}
+		if !found {
+			if !pointer.BoolDeref(optional, false) {
+				warnings = append(warnings, fmt.Sprintf("%s: key %s not found in %s %s", path, key, kind, name))
+			}
+			return
+		}
+
+		w, errs := validateRisingWaveConfig(path, key, content)
+		warnings, fieldErrs = append(warnings, w...), append(fieldErrs, errs...)
+	}
+
+	if cm := configuration.ConfigMap; cm != nil && cm.Name != "" && cm.Key != "" {
+		validateContent(path.Child("configMap"), "configmap", cm.Name, cm.Key, cm.Optional, func(obj client.Object) (string, bool) {
+			val, ok := obj.(*corev1.ConfigMap).Data[cm.Key]
+			return val, ok
+		}, &corev1.ConfigMap{})
+	}
+
+	if secret := configuration.Secret; secret != nil && secret.Name != "" && secret.Key != "" {
+		validateContent(path.Child("secret"), "secret", secret.Name, secret.Key, secret.Optional, func(obj client.Object) (string, bool) {
+			val, ok := obj.(*corev1.Secret).Data[secret.Key]
+			return string(val), ok
+		}, &corev1.Secret{})
+	}
+
+	if configuration.Settings != nil {
+		settingsPath := path.Child("settings")
+		if doc, err := toml.Marshal(configuration.Settings); err != nil {
+			fieldErrs = append(fieldErrs, field.InternalError(settingsPath, err))
+		} else {
+			w, errs := validateRisingWaveConfig(settingsPath, "settings", string(doc))
+			warnings, fieldErrs = append(warnings, w...), append(fieldErrs, errs...)
+		}
+	}
+
+	return warnings, fieldErrs
+}
+
 func (v *RisingWaveValidatingWebhook) validateComponents(path *field.Path, components *risingwavev1alpha1.RisingWaveComponentsSpec, openKruiseEnabled bool) field.ErrorList {
 	fieldErrs := field.ErrorList{}
 
@@ -268,8 +519,8 @@ func (v *RisingWaveValidatingWebhook) validateComponents(path *field.Path, compo
 }
 
 func (v *RisingWaveValidatingWebhook) validateMetaReplicas(obj *risingwavev1alpha1.RisingWave) field.ErrorList {
-	// When the meta storage isn't memory, there's no limitation on the replicas.
-	if !pointer.BoolDeref(obj.Spec.MetaStore.Memory, false) {
+	// When the meta storage is neither memory nor sqlite, there's no limitation on the replicas.
+	if !pointer.BoolDeref(obj.Spec.MetaStore.Memory, false) && obj.Spec.MetaStore.SQLite == nil {
 		return nil
 	}
 
@@ -287,7 +538,78 @@ func (v *RisingWaveValidatingWebhook) validateMetaReplicas(obj *risingwavev1alph
 	return fieldErrs
 }
 
-func (v *RisingWaveValidatingWebhook) validateCreate(ctx context.Context, obj *risingwavev1alpha1.RisingWave) error {
+func (v *RisingWaveValidatingWebhook) validateRestoreFrom(path *field.Path, obj *risingwavev1alpha1.RisingWave) field.ErrorList {
+	restoreFrom := obj.Spec.RestoreFrom
+	if restoreFrom == nil {
+		return nil
+	}
+
+	fieldErrs := field.ErrorList{}
+
+	metaStore := &obj.Spec.MetaStore
+	if !ptrValueNotZero(metaStore.Etcd) && !ptrValueNotEmpty(metaStore.PostgreSQL) && !ptrValueNotEmpty(metaStore.MySQL) {
+		fieldErrs = append(fieldErrs, field.Forbidden(path, "meta store must be one of etcd, postgresql and mysql to restore"))
+	}
+
+	if ptrValueNotZero(restoreFrom.StateStore.Memory) {
+		fieldErrs = append(fieldErrs, field.Invalid(path.Child("stateStore", "memory"), restoreFrom.StateStore.Memory, "unable to restore from memory"))
+	}
+
+	return fieldErrs
+}
+
+func (v *RisingWaveValidatingWebhook) validateCanary(path *field.Path, obj *risingwavev1alpha1.RisingWave) field.ErrorList {
+	canary := obj.Spec.UpgradePolicy.Canary
+	if canary == nil {
+		return nil
+	}
+
+	fieldErrs := field.ErrorList{}
+
+	reader := object.NewRisingWaveReader(obj)
+	for _, c := range []struct {
+		component string
+		group     *string
+	}{
+		{consts.ComponentMeta, canary.Meta},
+		{consts.ComponentFrontend, canary.Frontend},
+		{consts.ComponentCompute, canary.Compute},
+		{consts.ComponentCompactor, canary.Compactor},
+		{consts.ComponentConnector, canary.Connector},
+	} {
+		component, group := c.component, c.group
+		if group == nil {
+			continue
+		}
+		if !lo.ContainsBy(reader.GetNodeGroups(component), func(g risingwavev1alpha1.RisingWaveNodeGroup) bool { return g.Name == *group }) {
+			fieldErrs = append(fieldErrs, field.NotFound(path.Child(component), *group))
+		}
+	}
+
+	return fieldErrs
+}
+
+// validateReservedLabels forbids the labels with the system reserved prefix 'risingwave/'.
+func validateReservedLabels(path *field.Path, labels map[string]string) field.ErrorList {
+	fieldErrs := field.ErrorList{}
+	for label := range labels {
+		if strings.HasPrefix(label, "risingwave/") {
+			fieldErrs = append(fieldErrs, field.Invalid(path, label, "Labels with the prefix 'risingwave/' are system reserved"))
+		}
+	}
+	return fieldErrs
+}
+
+// validateMetaDashboardIngressLabels validates the labels in the additional metadata of the meta dashboard Ingress.
+func validateMetaDashboardIngressLabels(obj *risingwavev1alpha1.RisingWave) field.ErrorList {
+	if obj.Spec.MetaLeaderService == nil || obj.Spec.MetaLeaderService.DashboardIngress == nil {
+		return nil
+	}
+	return validateReservedLabels(field.NewPath("spec", "metaLeaderService", "dashboardIngress", "additionalMetadata", "labels"),
+		obj.Spec.MetaLeaderService.DashboardIngress.AdditionalMetadata.Labels)
+}
+
+func (v *RisingWaveValidatingWebhook) validateCreate(ctx context.Context, obj *risingwavev1alpha1.RisingWave) (admission.Warnings, error) {
 	gvk := obj.GroupVersionKind()
 
 	fieldErrs := field.ErrorList{}
@@ -298,12 +620,11 @@ func (v *RisingWaveValidatingWebhook) validateCreate(ctx context.Context, obj *r
 	}
 
 	// Validate the additional frontend service metadata.
-	for label := range obj.Spec.AdditionalFrontendServiceMetadata.Labels {
-		if strings.HasPrefix(label, "risingwave/") {
-			fieldErrs = append(fieldErrs,
-				field.Invalid(field.NewPath("spec", "additionalFrontendServiceMetadata", "labels"), label, "Labels with the prefix 'risingwave/' are system reserved"))
-		}
-	}
+	fieldErrs = append(fieldErrs, validateReservedLabels(field.NewPath("spec", "additionalFrontendServiceMetadata", "labels"),
+		obj.Spec.AdditionalFrontendServiceMetadata.Labels)...)
+
+	// Validate the additional metadata of the meta dashboard Ingress.
+	fieldErrs = append(fieldErrs, validateMetaDashboardIngressLabels(obj)...)
 
 	// Validate to make sure open kruise cannot be set to true when it is disabled at operator level.
 	if !v.openKruiseAvailable && pointer.BoolDeref(obj.Spec.EnableOpenKruise, false) {
@@ -314,7 +635,29 @@ func (v *RisingWaveValidatingWebhook) validateCreate(ctx context.Context, obj *r
 	fieldErrs = append(fieldErrs, v.validateMetaStoreAndStateStore(field.NewPath("spec"), &obj.Spec.MetaStore, &obj.Spec.StateStore)...)
 
 	// Validate the configuration spec.
-	fieldErrs = append(fieldErrs, v.validateConfiguration(field.NewPath("spec", "configuration"), &obj.Spec.Configuration)...)
+	fieldErrs = append(fieldErrs, v.validateConfiguration(field.NewPath("spec", "configuration"), &obj.Spec.Configuration.RisingWaveNodeConfiguration)...)
+
+	// Validate the contents of the configurations, both the global one and the ones of the node groups.
+	warnings, errs := v.validateConfigurationContent(ctx, field.NewPath("spec", "configuration"), obj.Namespace, &obj.Spec.Configuration.RisingWaveNodeConfiguration)
+	fieldErrs = append(fieldErrs, errs...)
+	reader := object.NewRisingWaveReader(obj)
+	for _, component := range []string{
+		consts.ComponentMeta,
+		consts.ComponentFrontend,
+		consts.ComponentCompute,
+		consts.ComponentCompactor,
+		consts.ComponentConnector,
+	} {
+		for i, ng := range reader.GetNodeGroups(component) {
+			if ng.Configuration == nil {
+				continue
+			}
+			w, errs := v.validateConfigurationContent(ctx,
+				field.NewPath("spec", "components", component, "nodeGroups").Index(i).Child("configuration"),
+				obj.Namespace, ng.Configuration)
+			warnings, fieldErrs = append(warnings, w...), append(fieldErrs, errs...)
+		}
+	}
 
 	// Validate the components spec.
 	//   * If the global image is empty, then the image of all groups must not be empty.
@@ -327,20 +670,25 @@ func (v *RisingWaveValidatingWebhook) validateCreate(ctx context.Context, obj *r
 	// Validate the meta replicas.
 	fieldErrs = append(fieldErrs, v.validateMetaReplicas(obj)...)
 
+	// Validate the restore source.
+	fieldErrs = append(fieldErrs, v.validateRestoreFrom(field.NewPath("spec", "restoreFrom"), obj)...)
+
+	// Validate the canary node groups.
+	fieldErrs = append(fieldErrs, v.validateCanary(field.NewPath("spec", "upgradePolicy", "canary"), obj)...)
+
 	if len(fieldErrs) > 0 {
-		return apierrors.NewInvalid(gvk.GroupKind(), obj.Name, fieldErrs)
+		return warnings, apierrors.NewInvalid(gvk.GroupKind(), obj.Name, fieldErrs)
 	}
-	return nil
+	return warnings, nil
 }
 
 // ValidateCreate implements admission.CustomValidator.
 func (v *RisingWaveValidatingWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
-	if v.isBypassed(obj.(client.Object)) {
+	if isBypassed(obj.(client.Object)) {
 		return nil, nil
 	}
 
-	err = v.validateCreate(ctx, obj.(*risingwavev1alpha1.RisingWave))
-	return
+	return v.validateCreate(ctx, obj.(*risingwavev1alpha1.RisingWave))
 }
 
 // ValidateDelete implements admission.CustomValidator.
@@ -348,12 +696,70 @@ func (v *RisingWaveValidatingWebhook) ValidateDelete(ctx context.Context, obj ru
 	return nil, nil
 }
 
+// metaStoreWithoutCredentials returns a copy of the meta store with the credentials erased. The credentials are
+// allowed to be changed (e.g., rotated) while the others are not.
+func metaStoreWithoutCredentials(metaStore *risingwavev1alpha1.RisingWaveMetaStoreBackend) *risingwavev1alpha1.RisingWaveMetaStoreBackend {
+	metaStore = metaStore.DeepCopy()
+
+	if metaStore.Etcd != nil {
+		metaStore.Etcd.RisingWaveEtcdCredentials = nil
+		metaStore.Etcd.Secret = ""
+		if metaStore.Etcd.TLS != nil {
+			metaStore.Etcd.TLS.CA = nil
+			metaStore.Etcd.TLS.ClientCert = nil
+			metaStore.Etcd.TLS.ClientKey = nil
+		}
+	}
+	if metaStore.PostgreSQL != nil {
+		metaStore.PostgreSQL.RisingWaveDBCredentials = risingwavev1alpha1.RisingWaveDBCredentials{}
+	}
+	if metaStore.MySQL != nil {
+		metaStore.MySQL.RisingWaveDBCredentials = risingwavev1alpha1.RisingWaveDBCredentials{}
+	}
+
+	return metaStore
+}
+
+// stateStoreWithoutCredentials returns a copy of the state store with the credentials erased. The credentials are
+// allowed to be changed (e.g., rotated) while the others are not.
+func stateStoreWithoutCredentials(stateStore *risingwavev1alpha1.RisingWaveStateStoreBackend) *risingwavev1alpha1.RisingWaveStateStoreBackend {
+	stateStore = stateStore.DeepCopy()
+
+	if stateStore.MinIO != nil {
+		stateStore.MinIO.RisingWaveMinIOCredentials = risingwavev1alpha1.RisingWaveMinIOCredentials{}
+	}
+	if stateStore.S3 != nil {
+		stateStore.S3.RisingWaveS3Credentials = risingwavev1alpha1.RisingWaveS3Credentials{}
+	}
+	if stateStore.S3Compatible != nil {
+		stateStore.S3Compatible.RisingWaveS3Credentials = risingwavev1alpha1.RisingWaveS3Credentials{}
+		stateStore.S3Compatible.CA = nil
+	}
+	if stateStore.GCS != nil {
+		stateStore.GCS.RisingWaveGCSCredentials = risingwavev1alpha1.RisingWaveGCSCredentials{}
+	}
+	if stateStore.AzureBlob != nil {
+		stateStore.AzureBlob.RisingWaveAzureBlobCredentials = risingwavev1alpha1.RisingWaveAzureBlobCredentials{}
+	}
+	if stateStore.AliyunOSS != nil {
+		stateStore.AliyunOSS.RisingWaveAliyunOSSCredentials = risingwavev1alpha1.RisingWaveAliyunOSSCredentials{}
+	}
+
+	return stateStore
+}
+
 func (v *RisingWaveValidatingWebhook) isMetaStoresTheSame(oldObj, newObj *risingwavev1alpha1.RisingWave) bool {
-	return equality.Semantic.DeepEqual(oldObj.Spec.MetaStore, newObj.Spec.MetaStore)
+	return equality.Semantic.DeepEqual(
+		metaStoreWithoutCredentials(&oldObj.Spec.MetaStore),
+		metaStoreWithoutCredentials(&newObj.Spec.MetaStore),
+	)
 }
 
 func (v *RisingWaveValidatingWebhook) isStateStoresTheSame(oldObj, newObj *risingwavev1alpha1.RisingWave) bool {
-	return equality.Semantic.DeepEqual(oldObj.Spec.StateStore, newObj.Spec.StateStore)
+	return equality.Semantic.DeepEqual(
+		stateStoreWithoutCredentials(&oldObj.Spec.StateStore),
+		stateStoreWithoutCredentials(&newObj.Spec.StateStore),
+	)
 }
 
 func pathForGroupReplicas(obj *risingwavev1alpha1.RisingWave, component, group string) *field.Path {
@@ -364,12 +770,22 @@ func pathForGroupReplicas(obj *risingwavev1alpha1.RisingWave, component, group s
 func (v *RisingWaveValidatingWebhook) validateUpdate(ctx context.Context, oldObj, newObj *risingwavev1alpha1.RisingWave) error {
 	gvk := oldObj.GroupVersionKind()
 
-	// The meta store and state store must be kept consistent.
+	// The managed etcd members are bootstrapped with a static initial cluster, which can't be changed afterward.
+	if oldEtcd, newEtcd := oldObj.Spec.MetaStore.Etcd, newObj.Spec.MetaStore.Etcd; oldEtcd != nil && oldEtcd.Managed != nil &&
+		newEtcd != nil && newEtcd.Managed != nil && oldEtcd.Managed.Replicas != newEtcd.Managed.Replicas {
+		return apierrors.NewForbidden(
+			schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind},
+			oldObj.Name,
+			field.Forbidden(field.NewPath("spec", "metaStore", "etcd", "managed", "replicas"), "replicas of the managed etcd are immutable"),
+		)
+	}
+
+	// The meta store and state store must be kept consistent, except the credentials.
 	if !v.isMetaStoresTheSame(oldObj, newObj) {
 		return apierrors.NewForbidden(
 			schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind},
 			oldObj.Name,
-			field.Forbidden(field.NewPath("spec", "metaStore"), "meta store must be kept consistent"),
+			field.Forbidden(field.NewPath("spec", "metaStore"), "meta store must be kept consistent except the credentials"),
 		)
 	}
 
@@ -377,7 +793,15 @@ func (v *RisingWaveValidatingWebhook) validateUpdate(ctx context.Context, oldObj
 		return apierrors.NewForbidden(
 			schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind},
 			oldObj.Name,
-			field.Forbidden(field.NewPath("spec", "stateStore"), "state store must be kept consistent"),
+			field.Forbidden(field.NewPath("spec", "stateStore"), "state store must be kept consistent except the credentials"),
+		)
+	}
+
+	if !equality.Semantic.DeepEqual(oldObj.Spec.RestoreFrom, newObj.Spec.RestoreFrom) {
+		return apierrors.NewForbidden(
+			schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind},
+			oldObj.Name,
+			field.Forbidden(field.NewPath("spec", "restoreFrom"), "restore source is immutable"),
 		)
 	}
 
@@ -427,12 +851,12 @@ func (v *RisingWaveValidatingWebhook) validateUpdate(ctx context.Context, oldObj
 
 // ValidateUpdate implements admission.CustomValidator.
 func (v *RisingWaveValidatingWebhook) ValidateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) (warnings admission.Warnings, err error) {
-	if v.isBypassed(newObj.(client.Object)) {
+	if isBypassed(newObj.(client.Object)) {
 		return nil, nil
 	}
 
 	// Validate the new object first.
-	if warnings, err := v.ValidateCreate(ctx, newObj); err != nil {
+	if warnings, err = v.ValidateCreate(ctx, newObj); err != nil {
 		return warnings, err
 	}
 
@@ -451,7 +875,10 @@ func nodeGroupPartitionExistAndIsString(nodeGroup *risingwavev1alpha1.RisingWave
 }
 
 // NewRisingWaveValidatingWebhook returns a new validator for the RisingWave. The behavior differs on different values of the
-// openKruiseAvailable.
-func NewRisingWaveValidatingWebhook(openKruiseAvailable bool) webhook.CustomValidator {
-	return metrics.NewValidatingWebhookMetricsRecorder(&RisingWaveValidatingWebhook{openKruiseAvailable: openKruiseAvailable})
+// openKruiseAvailable. The client is used to read the referenced configurations.
+func NewRisingWaveValidatingWebhook(client client.Reader, openKruiseAvailable bool) webhook.CustomValidator {
+	return metrics.NewValidatingWebhookMetricsRecorder(&RisingWaveValidatingWebhook{
+		client:              client,
+		openKruiseAvailable: openKruiseAvailable,
+	})
 }
@@ -22,12 +22,15 @@ import (
 	"testing"
 
 	kruisepubs "github.com/openkruise/kruise-api/apps/pub"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
 	"github.com/risingwavelabs/risingwave-operator/pkg/consts"
@@ -35,7 +38,7 @@ import (
 )
 
 func Test_RisingWaveValidatingWebhook_ValidateDelete(t *testing.T) {
-	_, err := NewRisingWaveValidatingWebhook(false).ValidateDelete(context.Background(), &risingwavev1alpha1.RisingWave{})
+	_, err := NewRisingWaveValidatingWebhook(fake.NewClientBuilder().WithScheme(testutils.Scheme).Build(), false).ValidateDelete(context.Background(), &risingwavev1alpha1.RisingWave{})
 	assert.Nil(t, err)
 }
 
@@ -90,6 +93,20 @@ func Test_RisingWaveValidatingWebhook_ValidateCreate(t *testing.T) {
 			},
 			pass: false,
 		},
+		"meta-dashboard-ingress-labels-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaLeaderService = &risingwavev1alpha1.RisingWaveMetaLeaderService{
+					DashboardIngress: &risingwavev1alpha1.RisingWaveMetaDashboardIngress{
+						AdditionalMetadata: risingwavev1alpha1.PartialObjectMeta{
+							Labels: map[string]string{
+								"risingwave/key": "value",
+							},
+						},
+					},
+				}
+			},
+			pass: false,
+		},
 		"invalid-upgrade-strategy-type-InPlaceIfPossible-openKruise-disabled": {
 			patch: func(r *risingwavev1alpha1.RisingWave) {
 				r.Spec.Components.Meta.NodeGroups[0].UpgradeStrategy.Type = risingwavev1alpha1.RisingWaveUpgradeStrategyTypeInPlaceIfPossible
@@ -206,6 +223,23 @@ func Test_RisingWaveValidatingWebhook_ValidateCreate(t *testing.T) {
 			},
 			pass: false,
 		},
+		"pod-disruption-budget-min-available-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Components.Meta.NodeGroups[0].PodDisruptionBudget = &risingwavev1alpha1.RisingWaveNodeGroupPodDisruptionBudget{
+					MinAvailable: &intstr.IntOrString{Type: intstr.Int, IntVal: 2},
+				}
+			},
+			pass: true,
+		},
+		"pod-disruption-budget-both-set-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Components.Meta.NodeGroups[0].PodDisruptionBudget = &risingwavev1alpha1.RisingWaveNodeGroupPodDisruptionBudget{
+					MinAvailable:   &intstr.IntOrString{Type: intstr.Int, IntVal: 2},
+					MaxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "50%"},
+				}
+			},
+			pass: false,
+		},
 		"rolling-upgrade-nil-when-set-pass": {
 			patch: func(r *risingwavev1alpha1.RisingWave) {
 				r.Spec.Components.Meta.NodeGroups[0].UpgradeStrategy = risingwavev1alpha1.RisingWaveNodeGroupUpgradeStrategy{
@@ -317,6 +351,208 @@ func Test_RisingWaveValidatingWebhook_ValidateCreate(t *testing.T) {
 			},
 			pass: false,
 		},
+		"etcd-meta-mtls-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+						Endpoint: "etcd",
+						TLS: &risingwavev1alpha1.RisingWaveEtcdTLS{
+							CA: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "etcd-ca"},
+								Key:                  "ca.crt",
+							},
+							ClientCert: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "etcd-client"},
+								Key:                  "tls.crt",
+							},
+							ClientKey: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "etcd-client"},
+								Key:                  "tls.key",
+							},
+							ServerName: "etcd.example.com",
+						},
+					},
+				}
+			},
+			pass: true,
+		},
+		"etcd-meta-tls-without-client-key-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+						Endpoint: "etcd",
+						TLS: &risingwavev1alpha1.RisingWaveEtcdTLS{
+							ClientCert: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "etcd-client"},
+								Key:                  "tls.crt",
+							},
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"etcd-meta-tls-empty-ca-key-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+						Endpoint: "etcd",
+						TLS: &risingwavev1alpha1.RisingWaveEtcdTLS{
+							CA: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "etcd-ca"},
+							},
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"etcd-meta-managed-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+						Managed: &risingwavev1alpha1.RisingWaveEtcdManaged{
+							Image:       "quay.io/coreos/etcd:v3.5.10",
+							Replicas:    3,
+							StorageSize: resource.MustParse("10Gi"),
+						},
+					},
+				}
+			},
+			pass: true,
+		},
+		"etcd-meta-managed-with-endpoint-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+						Endpoint: "etcd",
+						Managed: &risingwavev1alpha1.RisingWaveEtcdManaged{
+							Image:       "quay.io/coreos/etcd:v3.5.10",
+							Replicas:    1,
+							StorageSize: resource.MustParse("10Gi"),
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"etcd-meta-managed-without-storage-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+						Managed: &risingwavev1alpha1.RisingWaveEtcdManaged{
+							Image:    "quay.io/coreos/etcd:v3.5.10",
+							Replicas: 1,
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"etcd-meta-without-endpoint-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+						RisingWaveEtcdCredentials: &risingwavev1alpha1.RisingWaveEtcdCredentials{
+							SecretName: "etcd-credentials",
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"postgresql-meta-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					PostgreSQL: &risingwavev1alpha1.RisingWaveMetaStoreBackendPostgreSQL{
+						Host:     "postgres",
+						Port:     5432,
+						Database: "risingwave",
+						RisingWaveDBCredentials: risingwavev1alpha1.RisingWaveDBCredentials{
+							SecretName: "postgres-credentials",
+						},
+						Options: map[string]string{
+							"sslmode": "require",
+						},
+					},
+				}
+			},
+			pass: true,
+		},
+		"postgresql-meta-without-credentials-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					PostgreSQL: &risingwavev1alpha1.RisingWaveMetaStoreBackendPostgreSQL{
+						Host:     "postgres",
+						Port:     5432,
+						Database: "risingwave",
+					},
+				}
+			},
+			pass: false,
+		},
+		"mysql-meta-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					MySQL: &risingwavev1alpha1.RisingWaveMetaStoreBackendMySQL{
+						Host:     "mysql",
+						Port:     3306,
+						Database: "risingwave",
+						RisingWaveDBCredentials: risingwavev1alpha1.RisingWaveDBCredentials{
+							SecretName: "mysql-credentials",
+						},
+					},
+				}
+			},
+			pass: true,
+		},
+		"mysql-meta-without-database-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					MySQL: &risingwavev1alpha1.RisingWaveMetaStoreBackendMySQL{
+						Host: "mysql",
+						Port: 3306,
+						RisingWaveDBCredentials: risingwavev1alpha1.RisingWaveDBCredentials{
+							SecretName: "mysql-credentials",
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"sqlite-meta-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					SQLite: &risingwavev1alpha1.RisingWaveMetaStoreBackendSQLite{
+						Path: "/data/meta.db",
+					},
+				}
+			},
+			pass: true,
+		},
+		"sqlite-meta-relative-path-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					SQLite: &risingwavev1alpha1.RisingWaveMetaStoreBackendSQLite{
+						Path: "meta.db",
+					},
+				}
+			},
+			pass: false,
+		},
+		"multiple-sql-meta-storages-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					SQLite: &risingwavev1alpha1.RisingWaveMetaStoreBackendSQLite{
+						Path: "/data/meta.db",
+					},
+					Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+						Endpoint: "etcd",
+					},
+				}
+			},
+			pass: false,
+		},
 		"minio-state-store-pass": {
 			patch: func(r *risingwavev1alpha1.RisingWave) {
 				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
@@ -331,6 +567,183 @@ func Test_RisingWaveValidatingWebhook_ValidateCreate(t *testing.T) {
 			},
 			pass: true,
 		},
+		"minio-state-store-without-endpoint-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+					MinIO: &risingwavev1alpha1.RisingWaveStateStoreBackendMinIO{
+						Bucket: "hummock",
+						RisingWaveMinIOCredentials: risingwavev1alpha1.RisingWaveMinIOCredentials{
+							SecretName: "minio-creds",
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"minio-state-store-without-credentials-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+					MinIO: &risingwavev1alpha1.RisingWaveStateStoreBackendMinIO{
+						Endpoint: "minio",
+						Bucket:   "hummock",
+					},
+				}
+			},
+			pass: false,
+		},
+		"minio-state-store-managed-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+					MinIO: &risingwavev1alpha1.RisingWaveStateStoreBackendMinIO{
+						Bucket: "hummock",
+						Managed: &risingwavev1alpha1.RisingWaveMinIOManaged{
+							Image:       "quay.io/minio/minio:latest",
+							ClientImage: "quay.io/minio/mc:latest",
+							Replicas:    4,
+							StorageSize: resource.MustParse("10Gi"),
+						},
+					},
+				}
+			},
+			pass: true,
+		},
+		"minio-state-store-managed-with-endpoint-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+					MinIO: &risingwavev1alpha1.RisingWaveStateStoreBackendMinIO{
+						Endpoint: "minio",
+						Bucket:   "hummock",
+						Managed: &risingwavev1alpha1.RisingWaveMinIOManaged{
+							Replicas:    1,
+							StorageSize: resource.MustParse("10Gi"),
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"minio-state-store-managed-with-credentials-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+					MinIO: &risingwavev1alpha1.RisingWaveStateStoreBackendMinIO{
+						Bucket: "hummock",
+						RisingWaveMinIOCredentials: risingwavev1alpha1.RisingWaveMinIOCredentials{
+							SecretName: "minio-creds",
+						},
+						Managed: &risingwavev1alpha1.RisingWaveMinIOManaged{
+							Replicas:    1,
+							StorageSize: resource.MustParse("10Gi"),
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"minio-state-store-managed-with-2-replicas-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+					MinIO: &risingwavev1alpha1.RisingWaveStateStoreBackendMinIO{
+						Bucket: "hummock",
+						Managed: &risingwavev1alpha1.RisingWaveMinIOManaged{
+							Replicas:    2,
+							StorageSize: resource.MustParse("10Gi"),
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"s3-compatible-state-store-with-provider-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+					S3Compatible: &risingwavev1alpha1.RisingWaveStateStoreBackendS3Compatible{
+						Provider: risingwavev1alpha1.RisingWaveS3CompatibleProviderHuaweiOBS,
+						Bucket:   "hummock",
+						Region:   "cn-north-4",
+						RisingWaveS3Credentials: risingwavev1alpha1.RisingWaveS3Credentials{
+							SecretName: "s3-creds",
+						},
+					},
+				}
+			},
+			pass: true,
+		},
+		"s3-compatible-state-store-with-endpoint-and-ca-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+					S3Compatible: &risingwavev1alpha1.RisingWaveStateStoreBackendS3Compatible{
+						Provider:        risingwavev1alpha1.RisingWaveS3CompatibleProviderCephRGW,
+						Bucket:          "hummock",
+						Endpoint:        "http://ceph-rgw.storage:7480",
+						AddressingStyle: risingwavev1alpha1.RisingWaveS3AddressingStylePath,
+						CA: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "ceph-ca"},
+							Key:                  "ca.crt",
+						},
+						RisingWaveS3Credentials: risingwavev1alpha1.RisingWaveS3Credentials{
+							SecretName: "s3-creds",
+						},
+					},
+				}
+			},
+			pass: true,
+		},
+		"s3-compatible-state-store-without-endpoint-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+					S3Compatible: &risingwavev1alpha1.RisingWaveStateStoreBackendS3Compatible{
+						Provider: risingwavev1alpha1.RisingWaveS3CompatibleProviderCloudflareR2,
+						Bucket:   "hummock",
+						RisingWaveS3Credentials: risingwavev1alpha1.RisingWaveS3Credentials{
+							SecretName: "s3-creds",
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"s3-compatible-state-store-without-credentials-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+					S3Compatible: &risingwavev1alpha1.RisingWaveStateStoreBackendS3Compatible{
+						Bucket:   "hummock",
+						Endpoint: "s3.example.com",
+					},
+				}
+			},
+			pass: false,
+		},
+		"s3-compatible-state-store-with-service-account-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+					S3Compatible: &risingwavev1alpha1.RisingWaveStateStoreBackendS3Compatible{
+						Bucket:   "hummock",
+						Endpoint: "s3.example.com",
+						RisingWaveS3Credentials: risingwavev1alpha1.RisingWaveS3Credentials{
+							UseServiceAccount: pointer.Bool(true),
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"s3-compatible-state-store-with-invalid-ca-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+					S3Compatible: &risingwavev1alpha1.RisingWaveStateStoreBackendS3Compatible{
+						Bucket:   "hummock",
+						Endpoint: "s3.example.com",
+						CA: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "ca"},
+						},
+						RisingWaveS3Credentials: risingwavev1alpha1.RisingWaveS3Credentials{
+							SecretName: "s3-creds",
+						},
+					},
+				}
+			},
+			pass: false,
+		},
 		"s3-state-store-pass": {
 			patch: func(r *risingwavev1alpha1.RisingWave) {
 				r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
@@ -612,6 +1025,42 @@ func Test_RisingWaveValidatingWebhook_ValidateCreate(t *testing.T) {
 			},
 			pass: false,
 		},
+		"configuration-settings-with-configmap-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.ConfigMap = &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{
+					Name: "a",
+					Key:  "b",
+				}
+				r.Spec.Configuration.Settings = &risingwavev1alpha1.RisingWaveConfigurationSettings{
+					Server: &risingwavev1alpha1.RisingWaveServerSettings{
+						HeartbeatIntervalMs: pointer.Int32(1000),
+					},
+				}
+			},
+			pass: true,
+		},
+		"configuration-settings-with-secret-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.Secret = &risingwavev1alpha1.RisingWaveNodeConfigurationSecretSource{
+					Name: "a",
+					Key:  "b",
+				}
+				r.Spec.Configuration.Settings = &risingwavev1alpha1.RisingWaveConfigurationSettings{}
+			},
+			pass: false,
+		},
+		"node-group-configuration-settings-with-secret-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Components.Compute.NodeGroups[0].Configuration = &risingwavev1alpha1.RisingWaveNodeConfiguration{
+					Secret: &risingwavev1alpha1.RisingWaveNodeConfigurationSecretSource{
+						Name: "a",
+						Key:  "b",
+					},
+					Settings: &risingwavev1alpha1.RisingWaveConfigurationSettings{},
+				}
+			},
+			pass: false,
+		},
 		"insufficient-resources-cpu-fail": {
 			patch: func(r *risingwavev1alpha1.RisingWave) {
 				r.Spec.Components.Meta.NodeGroups[0].Template.Spec.Resources = corev1.ResourceRequirements{
@@ -745,6 +1194,33 @@ func Test_RisingWaveValidatingWebhook_ValidateCreate(t *testing.T) {
 			},
 			pass: true,
 		},
+		"multi-postgresql-meta-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Components.Meta.NodeGroups[0].Replicas = 2
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					PostgreSQL: &risingwavev1alpha1.RisingWaveMetaStoreBackendPostgreSQL{
+						Host:     "postgres",
+						Port:     5432,
+						Database: "risingwave",
+						RisingWaveDBCredentials: risingwavev1alpha1.RisingWaveDBCredentials{
+							SecretName: "postgres-credentials",
+						},
+					},
+				}
+			},
+			pass: true,
+		},
+		"multi-sqlite-meta-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Components.Meta.NodeGroups[0].Replicas = 2
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					SQLite: &risingwavev1alpha1.RisingWaveMetaStoreBackendSQLite{
+						Path: "/data/meta.db",
+					},
+				}
+			},
+			pass: false,
+		},
 		"invalid-data-dir-1": {
 			patch: func(r *risingwavev1alpha1.RisingWave) {
 				r.Spec.StateStore.DataDirectory = "/"
@@ -787,6 +1263,71 @@ func Test_RisingWaveValidatingWebhook_ValidateCreate(t *testing.T) {
 			},
 			pass: true,
 		},
+		"restore-from-with-etcd-meta-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+						Endpoint: "etcd",
+					},
+				}
+				r.Spec.RestoreFrom = &risingwavev1alpha1.RisingWaveRestoreFrom{
+					SnapshotID: 1,
+					StateStore: risingwavev1alpha1.RisingWaveStateStoreBackend{
+						MinIO: &risingwavev1alpha1.RisingWaveStateStoreBackendMinIO{
+							Endpoint: "minio",
+							Bucket:   "hummock",
+						},
+					},
+				}
+			},
+			pass: true,
+		},
+		"restore-from-with-memory-meta-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.RestoreFrom = &risingwavev1alpha1.RisingWaveRestoreFrom{
+					SnapshotID: 1,
+					StateStore: risingwavev1alpha1.RisingWaveStateStoreBackend{
+						MinIO: &risingwavev1alpha1.RisingWaveStateStoreBackendMinIO{
+							Endpoint: "minio",
+							Bucket:   "hummock",
+						},
+					},
+				}
+			},
+			pass: false,
+		},
+		"restore-from-memory-state-store-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+					Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+						Endpoint: "etcd",
+					},
+				}
+				r.Spec.RestoreFrom = &risingwavev1alpha1.RisingWaveRestoreFrom{
+					SnapshotID: 1,
+					StateStore: risingwavev1alpha1.RisingWaveStateStoreBackend{
+						Memory: pointer.Bool(true),
+					},
+				}
+			},
+			pass: false,
+		},
+		"canary-with-existing-group-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.UpgradePolicy.Canary = &risingwavev1alpha1.RisingWaveCanaryUpgradePolicy{
+					Compute: pointer.String(""),
+				}
+			},
+			pass: true,
+		},
+		"canary-with-unknown-group-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.UpgradePolicy.Canary = &risingwavev1alpha1.RisingWaveCanaryUpgradePolicy{
+					Compute: pointer.String("canary"),
+				}
+			},
+			pass: false,
+		},
 	}
 
 	for name, tc := range testcases {
@@ -797,7 +1338,7 @@ func Test_RisingWaveValidatingWebhook_ValidateCreate(t *testing.T) {
 			}
 
 			// we let webhook take on open kruise availability specified in Test case.
-			webhook := NewRisingWaveValidatingWebhook(tc.openKruiseAvailable)
+			webhook := NewRisingWaveValidatingWebhook(fake.NewClientBuilder().WithScheme(testutils.Scheme).Build(), tc.openKruiseAvailable)
 
 			_, err := webhook.ValidateCreate(context.Background(), risingwave)
 			if tc.pass != (err == nil) {
@@ -826,6 +1367,44 @@ func Test_RisingWaveValidatingWebhook_ValidateUpdate(t *testing.T) {
 			},
 			pass: false,
 		},
+		"meta-dashboard-ingress-labels-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaLeaderService = &risingwavev1alpha1.RisingWaveMetaLeaderService{
+					DashboardIngress: &risingwavev1alpha1.RisingWaveMetaDashboardIngress{
+						AdditionalMetadata: risingwavev1alpha1.PartialObjectMeta{
+							Labels: map[string]string{
+								"key": "value",
+							},
+						},
+					},
+				}
+			},
+			oldObjMutation: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaLeaderService = &risingwavev1alpha1.RisingWaveMetaLeaderService{
+					DashboardIngress: &risingwavev1alpha1.RisingWaveMetaDashboardIngress{},
+				}
+			},
+			pass: true,
+		},
+		"meta-dashboard-ingress-labels-fail": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaLeaderService = &risingwavev1alpha1.RisingWaveMetaLeaderService{
+					DashboardIngress: &risingwavev1alpha1.RisingWaveMetaDashboardIngress{
+						AdditionalMetadata: risingwavev1alpha1.PartialObjectMeta{
+							Labels: map[string]string{
+								"risingwave/key": "value",
+							},
+						},
+					},
+				}
+			},
+			oldObjMutation: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.MetaLeaderService = &risingwavev1alpha1.RisingWaveMetaLeaderService{
+					DashboardIngress: &risingwavev1alpha1.RisingWaveMetaDashboardIngress{},
+				}
+			},
+			pass: false,
+		},
 		"enable-openKruise-when-enabled": {
 			patch: func(r *risingwavev1alpha1.RisingWave) {
 				r.Spec.EnableOpenKruise = pointer.Bool(true)
@@ -875,6 +1454,94 @@ func Test_RisingWaveValidatingWebhook_ValidateUpdate(t *testing.T) {
 			},
 			pass: false,
 		},
+		"meta-store-credentials-changed-pass": {
+			oldObjMutation: func(r *risingwavev1alpha1.RisingWave) {
+				setEtcdAndS3Stores(r)
+			},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				setEtcdAndS3Stores(r)
+				r.Spec.MetaStore.Etcd.RisingWaveEtcdCredentials.SecretName = "etcd-credentials-rotated"
+			},
+			pass: true,
+		},
+		"etcd-managed-replicas-changed-fail": {
+			oldObjMutation: func(r *risingwavev1alpha1.RisingWave) {
+				setManagedEtcdStore(r, 1)
+			},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				setManagedEtcdStore(r, 3)
+			},
+			pass: false,
+		},
+		"etcd-managed-replicas-unchanged-pass": {
+			oldObjMutation: func(r *risingwavev1alpha1.RisingWave) {
+				setManagedEtcdStore(r, 3)
+			},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				setManagedEtcdStore(r, 3)
+			},
+			pass: true,
+		},
+		"meta-store-endpoint-changed-fail": {
+			oldObjMutation: func(r *risingwavev1alpha1.RisingWave) {
+				setEtcdAndS3Stores(r)
+			},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				setEtcdAndS3Stores(r)
+				r.Spec.MetaStore.Etcd.Endpoint = "etcd-2"
+			},
+			pass: false,
+		},
+		"state-store-credentials-changed-pass": {
+			oldObjMutation: func(r *risingwavev1alpha1.RisingWave) {
+				setEtcdAndS3Stores(r)
+			},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				setEtcdAndS3Stores(r)
+				r.Spec.StateStore.S3.RisingWaveS3Credentials.SecretName = "s3-credentials-rotated"
+				r.Spec.StateStore.S3.RisingWaveS3Credentials.AccessKeyRef = "AccessKey"
+			},
+			pass: true,
+		},
+		"state-store-bucket-changed-fail": {
+			oldObjMutation: func(r *risingwavev1alpha1.RisingWave) {
+				setEtcdAndS3Stores(r)
+			},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				setEtcdAndS3Stores(r)
+				r.Spec.StateStore.S3.Bucket = "hummock-2"
+			},
+			pass: false,
+		},
+		"state-store-credentials-and-root-changed-fail": {
+			oldObjMutation: func(r *risingwavev1alpha1.RisingWave) {
+				setEtcdAndS3Stores(r)
+			},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				setEtcdAndS3Stores(r)
+				r.Spec.StateStore.S3.RisingWaveS3Credentials.SecretName = "s3-credentials-rotated"
+				r.Spec.StateStore.DataDirectory = "another"
+			},
+			pass: false,
+		},
+		"restore-from-changed-fail": {
+			oldObjMutation: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.RestoreFrom = &risingwavev1alpha1.RisingWaveRestoreFrom{SnapshotID: 1}
+			},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.RestoreFrom = &risingwavev1alpha1.RisingWaveRestoreFrom{SnapshotID: 2}
+			},
+			pass: false,
+		},
+		"restore-from-removed-fail": {
+			oldObjMutation: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.RestoreFrom = &risingwavev1alpha1.RisingWaveRestoreFrom{SnapshotID: 1}
+			},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.RestoreFrom = nil
+			},
+			pass: false,
+		},
 	}
 
 	for name, tc := range testcases {
@@ -889,7 +1556,7 @@ func Test_RisingWaveValidatingWebhook_ValidateUpdate(t *testing.T) {
 			}
 			tc.patch(risingwave)
 
-			webhook := NewRisingWaveValidatingWebhook(tc.openKruiseAvailable)
+			webhook := NewRisingWaveValidatingWebhook(fake.NewClientBuilder().WithScheme(testutils.Scheme).Build(), tc.openKruiseAvailable)
 
 			// test when operator is disabled and openKruise enabled -> disabled, risingwave should be set to default.
 			if name == "disabled-openKruise-when-not-available" {
@@ -905,6 +1572,51 @@ func Test_RisingWaveValidatingWebhook_ValidateUpdate(t *testing.T) {
 	}
 }
 
+func setEtcdAndS3Stores(r *risingwavev1alpha1.RisingWave) {
+	r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+		Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+			Endpoint: "etcd",
+			RisingWaveEtcdCredentials: &risingwavev1alpha1.RisingWaveEtcdCredentials{
+				SecretName: "etcd-credentials",
+			},
+		},
+	}
+	r.Spec.StateStore = risingwavev1alpha1.RisingWaveStateStoreBackend{
+		DataDirectory: "hummock",
+		S3: &risingwavev1alpha1.RisingWaveStateStoreBackendS3{
+			Bucket: "hummock",
+			RisingWaveS3Credentials: risingwavev1alpha1.RisingWaveS3Credentials{
+				SecretName: "s3-credentials",
+			},
+		},
+	}
+}
+
+func setManagedEtcdStore(r *risingwavev1alpha1.RisingWave, replicas int32) {
+	r.Spec.MetaStore = risingwavev1alpha1.RisingWaveMetaStoreBackend{
+		Etcd: &risingwavev1alpha1.RisingWaveMetaStoreBackendEtcd{
+			Managed: &risingwavev1alpha1.RisingWaveEtcdManaged{
+				Image:       "quay.io/coreos/etcd:v3.5.10",
+				Replicas:    replicas,
+				StorageSize: resource.MustParse("10Gi"),
+			},
+		},
+	}
+}
+
+func Test_RisingWaveValidatingWebhook_ValidateUpdate_EtcdManagedReplicas(t *testing.T) {
+	oldObj := testutils.FakeRisingWave()
+	setManagedEtcdStore(oldObj, 1)
+	newObj := oldObj.DeepCopy()
+	setManagedEtcdStore(newObj, 3)
+
+	webhook := NewRisingWaveValidatingWebhook(fake.NewClientBuilder().WithScheme(testutils.Scheme).Build(), false)
+	_, err := webhook.ValidateUpdate(context.Background(), oldObj, newObj)
+	if assert.True(t, apierrors.IsForbidden(err), err) {
+		assert.Contains(t, err.Error(), "spec.metaStore.etcd.managed.replicas")
+	}
+}
+
 func Test_RisingWaveValidatingWebhook_ValidateUpdate_ScaleViews(t *testing.T) {
 	testcases := map[string]struct {
 		origin      *risingwavev1alpha1.RisingWave
@@ -1027,7 +1739,7 @@ func Test_RisingWaveValidatingWebhook_ValidateUpdate_ScaleViews(t *testing.T) {
 			newObj := obj.DeepCopy()
 			tc.mutate(newObj)
 
-			_, err := NewRisingWaveValidatingWebhook(false).ValidateUpdate(context.Background(), obj, newObj)
+			_, err := NewRisingWaveValidatingWebhook(fake.NewClientBuilder().WithScheme(testutils.Scheme).Build(), false).ValidateUpdate(context.Background(), obj, newObj)
 			if tc.pass {
 				assert.Nil(t, err, "unexpected error")
 			} else {
@@ -1036,3 +1748,107 @@ func Test_RisingWaveValidatingWebhook_ValidateUpdate_ScaleViews(t *testing.T) {
 		})
 	}
 }
+
+func Test_RisingWaveValidatingWebhook_ValidateConfigurationContent(t *testing.T) {
+	testcases := map[string]struct {
+		configMapData map[string]string
+		secretData    map[string][]byte
+		patch         func(r *risingwavev1alpha1.RisingWave)
+		pass          bool
+		warnings      int
+	}{
+		"valid-configmap": {
+			configMapData: map[string]string{"risingwave.toml": "[server]\nheartbeat_interval_ms = 1000\n"},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.ConfigMap = &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{Name: "config", Key: "risingwave.toml"}
+			},
+			pass: true,
+		},
+		"syntax-error-in-configmap": {
+			configMapData: map[string]string{"risingwave.toml": "[server\n"},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.ConfigMap = &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{Name: "config", Key: "risingwave.toml"}
+			},
+			pass: false,
+		},
+		"unknown-section-in-secret": {
+			secretData: map[string][]byte{"risingwave.toml": []byte("[unknown]\na = 1\n")},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.Secret = &risingwavev1alpha1.RisingWaveNodeConfigurationSecretSource{Name: "config", Key: "risingwave.toml"}
+			},
+			pass: false,
+		},
+		"unknown-section-in-node-group-configmap": {
+			configMapData: map[string]string{"compute.toml": "unknown = 1\n"},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Components.Compute.NodeGroups[0].Configuration = &risingwavev1alpha1.RisingWaveNodeConfiguration{
+					ConfigMap: &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{Name: "config", Key: "compute.toml"},
+				}
+			},
+			pass: false,
+		},
+		"conflicting-keys-warned": {
+			configMapData: map[string]string{"risingwave.toml": "[storage]\nblock_cache_capacity_mb = 512\n[streaming]\nactor_runtime_worker_threads_num = 4\n"},
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.ConfigMap = &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{Name: "config", Key: "risingwave.toml"}
+			},
+			pass:     true,
+			warnings: 2,
+		},
+		"conflicting-settings-warned": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.Settings = &risingwavev1alpha1.RisingWaveConfigurationSettings{
+					Storage: &risingwavev1alpha1.RisingWaveStorageSettings{
+						MetaCacheCapacityMB: pointer.Int32(128),
+					},
+				}
+			},
+			pass:     true,
+			warnings: 1,
+		},
+		"missing-configmap-warned": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.ConfigMap = &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{Name: "config", Key: "risingwave.toml"}
+			},
+			pass:     true,
+			warnings: 1,
+		},
+		"missing-optional-configmap-pass": {
+			patch: func(r *risingwavev1alpha1.RisingWave) {
+				r.Spec.Configuration.ConfigMap = &risingwavev1alpha1.RisingWaveNodeConfigurationConfigMapSource{
+					Name:     "config",
+					Key:      "risingwave.toml",
+					Optional: pointer.Bool(true),
+				}
+			},
+			pass: true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			risingwave := testutils.FakeRisingWave()
+			tc.patch(risingwave)
+
+			clientBuilder := fake.NewClientBuilder().WithScheme(testutils.Scheme)
+			if tc.configMapData != nil {
+				clientBuilder.WithObjects(&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: risingwave.Namespace, Name: "config"},
+					Data:       tc.configMapData,
+				})
+			}
+			if tc.secretData != nil {
+				clientBuilder.WithObjects(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: risingwave.Namespace, Name: "config"},
+					Data:       tc.secretData,
+				})
+			}
+
+			warnings, err := NewRisingWaveValidatingWebhook(clientBuilder.Build(), false).ValidateCreate(context.Background(), risingwave)
+			if tc.pass != (err == nil) {
+				t.Fatal(tc.pass, err)
+			}
+			assert.Len(t, warnings, tc.warnings, "unexpected warnings: %v", warnings)
+		})
+	}
+}
@@ -19,6 +19,7 @@ package webhook
 import (
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	risingwavev1alpha1 "github.com/risingwavelabs/risingwave-operator/apis/risingwave/v1alpha1"
@@ -29,7 +30,7 @@ func SetupWebhooksWithManager(mgr ctrl.Manager, openKruiseAvailable bool) error
 	if err := ctrl.NewWebhookManagedBy(mgr).
 		For(&risingwavev1alpha1.RisingWave{}).
 		WithDefaulter(NewRisingWaveMutatingWebhook()).
-		WithValidator(NewRisingWaveValidatingWebhook(openKruiseAvailable)).
+		WithValidator(NewRisingWaveValidatingWebhook(mgr.GetAPIReader(), openKruiseAvailable)).
 		Complete(); err != nil {
 		return fmt.Errorf("unable to setup webhooks for risingwave: %w", err)
 	}
@@ -42,5 +43,12 @@ func SetupWebhooksWithManager(mgr ctrl.Manager, openKruiseAvailable bool) error
 		return fmt.Errorf("unable to setup webhooks for risingwave scale view: %w", err)
 	}
 
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&corev1.ConfigMap{}).
+		WithValidator(NewConfigMapValidatingWebhook()).
+		Complete(); err != nil {
+		return fmt.Errorf("unable to setup webhooks for configmap: %w", err)
+	}
+
 	return nil
 }